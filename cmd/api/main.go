@@ -1,13 +1,17 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"github.com/aetheris-lab/aetheris-id/api/configs"
 	"github.com/aetheris-lab/aetheris-id/api/infra/database"
 	"github.com/aetheris-lab/aetheris-id/api/internal/bootstrap"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
 	"github.com/aetheris-lab/aetheris-id/api/internal/server"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
 	"github.com/aetheris-lab/aetheris-id/api/pkg/injector"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/dig"
 )
 
@@ -21,6 +25,19 @@ func main() {
 
 	bootstrap.BuildContainer(container)
 
+	ctx := context.Background()
+
+	db := injector.Resolve[*mongo.Database](container)
+	if err := repositories.EnsureIndexes(ctx, db); err != nil {
+		log.Fatal(err)
+	}
+
+	keyService := injector.Resolve[services.KeyService](container)
+	if err := keyService.EnsureSigningKeys(ctx); err != nil {
+		log.Fatal(err)
+	}
+	go keyService.WatchSigningKeys(ctx)
+
 	server := injector.Resolve[*server.Server](container)
 	if err := server.Start(); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/infra/database"
+	"github.com/aetheris-lab/aetheris-id/api/internal/bootstrap"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/injector"
+	"go.uber.org/dig"
+)
+
+const usage = `uso: go run ./cmd/clients <comando> <client_id> [argumentos]
+
+comandos:
+  first-party <client_id>                    marca o cliente como aplicativo da plataforma, que dispensa a tela de consentimento
+  third-party <client_id>                    volta a exigir o consentimento do usuário para o cliente
+  grant-scope <client_id> <scope>            concede um escopo ao cliente, ex.: token:introspect ou resource_servers:create
+  revoke-scope <client_id> <scope>           remove um escopo do cliente
+  token-exchange-policy <client_id> <json>   define a política de token exchange do cliente, ex.:
+                                             '{"audiences":["https://payments.internal"],"scopes":["payments:read"]}'`
+
+func main() {
+	if len(os.Args) < 3 || len(os.Args) > 4 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	container := dig.New()
+
+	injector.Provide(container, configs.NewConfig)
+
+	injector.Provide(container, database.NewMongoClient)
+	injector.Provide(container, database.NewMongoDatabase)
+
+	bootstrap.BuildContainer(container)
+
+	clientService := injector.Resolve[services.ClientService](container)
+	ctx := context.Background()
+	clientID := os.Args[2]
+
+	switch os.Args[1] {
+	case "first-party", "third-party":
+		firstParty := os.Args[1] == "first-party"
+		if err := clientService.SetFirstParty(ctx, clientID, firstParty); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("cliente %s: first_party=%t\n", clientID, firstParty)
+	case "grant-scope", "revoke-scope":
+		if len(os.Args) != 4 {
+			fmt.Println(usage)
+			os.Exit(2)
+		}
+
+		scope := os.Args[3]
+		if os.Args[1] == "grant-scope" {
+			if err := clientService.GrantScope(ctx, clientID, scope); err != nil {
+				log.Fatal(err)
+			}
+
+			fmt.Printf("cliente %s: escopo %s concedido\n", clientID, scope)
+			return
+		}
+
+		if err := clientService.RevokeScope(ctx, clientID, scope); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("cliente %s: escopo %s removido\n", clientID, scope)
+	case "token-exchange-policy":
+		if len(os.Args) != 4 {
+			fmt.Println(usage)
+			os.Exit(2)
+		}
+
+		var payload models.TokenExchangePolicyPayload
+		if err := json.Unmarshal([]byte(os.Args[3]), &payload); err != nil {
+			log.Fatalf("token exchange policy: %v", err)
+		}
+
+		if err := clientService.SetTokenExchangePolicy(ctx, clientID, models.NewTokenExchangePolicy(&payload)); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("cliente %s: política de token exchange atualizada\n", clientID)
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/infra/database"
+	"github.com/aetheris-lab/aetheris-id/api/internal/bootstrap"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/injector"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"go.uber.org/dig"
+)
+
+const usage = `uso: go run ./cmd/keys <comando> [algoritmo]
+
+comandos:
+  rotate [alg]  promove a chave pendente a ativa, aposenta a atual e gera uma nova pendente;
+                sem algoritmo, rotaciona ES256, RS256, PS256 e EdDSA
+  list          lista as chaves publicadas no JWKS`
+
+func main() {
+	if len(os.Args) < 2 || len(os.Args) > 3 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	container := dig.New()
+
+	injector.Provide(container, configs.NewConfig)
+
+	injector.Provide(container, database.NewMongoClient)
+	injector.Provide(container, database.NewMongoDatabase)
+
+	bootstrap.BuildContainer(container)
+
+	keyService := injector.Resolve[services.KeyService](container)
+	ctx := context.Background()
+
+	switch os.Args[1] {
+	case "rotate":
+		algorithms := signer.SupportedAlgorithms
+		if len(os.Args) == 3 {
+			algorithms = []string{os.Args[2]}
+		}
+
+		for _, algorithm := range algorithms {
+			signingKey, err := keyService.RotateSigningKeys(ctx, algorithm)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fmt.Printf("chave ativa %s: %s\n", algorithm, signingKey.KID)
+		}
+	case "list":
+		signingKeys, err := keyService.ListVerificationKeys(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, signingKey := range signingKeys {
+			fmt.Printf("%s\t%s\t%s\n", signingKey.KID, signingKey.Algorithm, signingKey.Status)
+		}
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+}
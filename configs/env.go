@@ -19,6 +19,11 @@ type Environment struct {
 	URLs      URLs
 	Key       Key
 	OTP       OTP
+	Device    Device
+	PAR       PAR
+	Consent   Consent
+	DPoP      DPoP
+	TLS       TLS
 }
 
 type Server struct {
@@ -43,6 +48,7 @@ type Security struct {
 	AccessTokenExpirationHours   time.Duration `env:"ACCESS_TOKEN_EXPIRATION_HOURS,default=1h"`
 	AccessTokenExpirationMinutes time.Duration `env:"ACCESS_TOKEN_EXPIRATION_MINUTES,default=15m"`
 	IDTokenExpirationMinutes     time.Duration `env:"ID_TOKEN_EXPIRATION_MINUTES,default=15m"`
+	SigningKeyReloadInterval     time.Duration `env:"SIGNING_KEY_RELOAD_INTERVAL,default=5m"`
 }
 
 type Cors struct {
@@ -64,8 +70,9 @@ type SMTP struct {
 }
 
 type URLs struct {
-	APIBaseURL     string `env:"API_BASE_URL,required"`
-	ClientLoginURL string `env:"CLIENT_LOGIN_URL,required"`
+	APIBaseURL       string `env:"API_BASE_URL,required"`
+	ClientLoginURL   string `env:"CLIENT_LOGIN_URL,required"`
+	ClientConsentURL string `env:"CLIENT_CONSENT_URL,required"`
 }
 
 type OTP struct {
@@ -73,6 +80,45 @@ type OTP struct {
 	JWTExpirationMinutes time.Duration `env:"JWT_EXPIRATION_MINUTES,default=10m"`
 }
 
+// Device configura o device authorization grant (RFC 8628).
+type Device struct {
+	CodeExpirationMinutes time.Duration `env:"DEVICE_CODE_EXPIRATION_MINUTES,default=10m"`
+	PollingInterval       time.Duration `env:"DEVICE_CODE_POLLING_INTERVAL,default=5s"`
+}
+
+// PAR configura os pushed authorization requests (RFC 9126).
+type PAR struct {
+	RequestURIExpiration time.Duration `env:"PAR_REQUEST_URI_EXPIRATION,default=60s"`
+	// AuthorizationExpiration é a validade do request_uri depois do primeiro uso no
+	// /authorize, o tempo que o usuário tem para o login e o consentimento.
+	AuthorizationExpiration time.Duration `env:"PAR_AUTHORIZATION_EXPIRATION,default=10m"`
+}
+
+// Consent configura a tela de consentimento do /authorize.
+type Consent struct {
+	RequestExpiration time.Duration `env:"CONSENT_REQUEST_EXPIRATION,default=10m"`
+}
+
+// DPoP configura a validação das provas de posse (RFC 9449). ProofLifetime limita a
+// distância entre o iat da prova e o relógio do servidor.
+type DPoP struct {
+	ProofLifetime time.Duration `env:"DPOP_PROOF_LIFETIME,default=5m"`
+}
+
+// TLS habilita o listener HTTPS quando CertFile e KeyFile são informados. ClientCAFile
+// lista as CAs aceitas para clientes com tls_client_auth (RFC 8705).
+type TLS struct {
+	CertFile     string `env:"TLS_CERT_FILE"`
+	KeyFile      string `env:"TLS_KEY_FILE"`
+	ClientCAFile string `env:"TLS_CLIENT_CA_FILE"`
+}
+
+// MutualTLS indica se o servidor termina o TLS e valida certificados de cliente,
+// condição para vincular tokens ao certificado (RFC 8705, seção 3).
+func (t TLS) MutualTLS() bool {
+	return t.CertFile != "" && t.KeyFile != "" && t.ClientCAFile != ""
+}
+
 type Key struct {
 	PrivateKey string
 	PublicKey  string
@@ -43,6 +43,17 @@ func CustomHTTPErrorHandler(err error, ectx echo.Context) {
 		return
 	}
 
+	var oauthErr *OAuthError
+	if errors.As(err, &oauthErr) {
+		ectx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+		ectx.Response().Header().Set("Pragma", "no-cache")
+
+		if err := ectx.JSON(oauthErr.Status, oauthErr); err != nil {
+			slog.Error("Failed to write OAuth error response", "error", err)
+		}
+		return
+	}
+
 	var httpErr *echo.HTTPError
 	if errors.As(err, &httpErr) {
 		response := APIErrorResponse{
@@ -0,0 +1,58 @@
+package api
+
+import "net/http"
+
+// Códigos de erro definidos pela RFC 6749 §4.1.2.1 e §5.2, pela RFC 8628 §3.5, pela RFC 8693 §2.2.2
+// pela RFC 9101 §6.3, pela RFC 9449 §5 e pelo OIDC Core §3.1.2.6.
+const (
+	OAuthErrorInvalidRequest          = "invalid_request"
+	OAuthErrorInvalidClient           = "invalid_client"
+	OAuthErrorInvalidGrant            = "invalid_grant"
+	OAuthErrorUnauthorizedClient      = "unauthorized_client"
+	OAuthErrorUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthErrorUnsupportedResponseType = "unsupported_response_type"
+	OAuthErrorInvalidScope            = "invalid_scope"
+	OAuthErrorAccessDenied            = "access_denied"
+	OAuthErrorServerError             = "server_error"
+	OAuthErrorAuthorizationPending    = "authorization_pending"
+	OAuthErrorSlowDown                = "slow_down"
+	OAuthErrorExpiredToken            = "expired_token"
+	OAuthErrorInvalidTarget           = "invalid_target"
+	OAuthErrorInvalidRequestObject    = "invalid_request_object"
+	OAuthErrorInvalidDPoPProof        = "invalid_dpop_proof"
+	OAuthErrorLoginRequired           = "login_required"
+	OAuthErrorConsentRequired         = "consent_required"
+)
+
+// OAuthError é renderizado no formato {error, error_description, error_uri}
+// esperado pelas bibliotecas cliente de OAuth 2.0.
+type OAuthError struct {
+	Status      int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	URI         string `json:"error_uri,omitempty"`
+}
+
+func NewOAuthError(status int, code, description string) *OAuthError {
+	return &OAuthError{
+		Status:      status,
+		Code:        code,
+		Description: description,
+	}
+}
+
+func NewInvalidRequestError(description string) *OAuthError {
+	return NewOAuthError(http.StatusBadRequest, OAuthErrorInvalidRequest, description)
+}
+
+func NewServerError() *OAuthError {
+	return NewOAuthError(http.StatusInternalServerError, OAuthErrorServerError, "")
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+
+	return e.Code + ": " + e.Description
+}
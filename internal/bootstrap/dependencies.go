@@ -8,6 +8,7 @@ import (
 	"github.com/aetheris-lab/aetheris-id/api/internal/services"
 	"github.com/aetheris-lab/aetheris-id/api/pkg/ecdsa"
 	"github.com/aetheris-lab/aetheris-id/api/pkg/injector"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/mtls"
 	"go.uber.org/dig"
 )
 
@@ -15,26 +16,51 @@ func BuildContainer(container *dig.Container) {
 
 	// Crypto
 	injector.Provide(container, ecdsa.NewEcdsaKeyPair)
+	injector.Provide(container, mtls.NewClientCAs)
 
 	// Handlers
 	injector.Provide(container, handlers.NewAuthHandler)
 	injector.Provide(container, handlers.NewClientHandler)
+	injector.Provide(container, handlers.NewDeviceHandler)
+	injector.Provide(container, handlers.NewGrantHandler)
+	injector.Provide(container, handlers.NewConsentHandler)
 	injector.Provide(container, handlers.NewOAuthHandler)
+	injector.Provide(container, handlers.NewResourceServerHandler)
+	injector.Provide(container, handlers.NewUserInfoHandler)
+	injector.Provide(container, handlers.NewWellKnownHandler)
 
 	// Services
 	injector.Provide(container, services.NewAuthService)
 	injector.Provide(container, services.NewAuthorizationCodeService)
+	injector.Provide(container, services.NewClientAuthenticator)
 	injector.Provide(container, services.NewClientService)
+	injector.Provide(container, services.NewDeviceAuthorizationService)
+	injector.Provide(container, services.NewConsentService)
 	injector.Provide(container, services.NewJWTService)
+	injector.Provide(container, services.NewKeyService)
 	injector.Provide(container, services.NewOAuthService)
+	injector.Provide(container, services.NewDPoPService)
 	injector.Provide(container, services.NewOTPService)
 	injector.Provide(container, services.NewRefreshTokenService)
+	injector.Provide(container, services.NewResourceServerService)
+	injector.Provide(container, services.NewUserInfoService)
+	injector.Provide(container, services.NewTokenExchangeService)
+	injector.Provide(container, services.NewTokenIntrospectionService)
+	injector.Provide(container, services.NewTokenRevocationService)
 
 	// Repositories
 	injector.Provide(container, repositories.NewAuthorizationCodeRepository)
+	injector.Provide(container, repositories.NewClientAssertionRepository)
 	injector.Provide(container, repositories.NewClientRepository)
+	injector.Provide(container, repositories.NewDeviceCodeRepository)
+	injector.Provide(container, repositories.NewConsentRepository)
+	injector.Provide(container, repositories.NewConsentRequestRepository)
 	injector.Provide(container, repositories.NewOTPRepository)
+	injector.Provide(container, repositories.NewPushedAuthorizationRequestRepository)
 	injector.Provide(container, repositories.NewRefreshTokenRepository)
+	injector.Provide(container, repositories.NewResourceServerRepository)
+	injector.Provide(container, repositories.NewRevokedTokenRepository)
+	injector.Provide(container, repositories.NewSigningKeyRepository)
 	injector.Provide(container, repositories.NewUserRepository)
 
 	// Server
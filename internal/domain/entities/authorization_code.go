@@ -8,22 +8,32 @@ import (
 )
 
 type AuthorizationCode struct {
-	ID                  primitive.ObjectID `bson:"_id"`
-	Code                string             `bson:"code"`
-	UserID              string             `bson:"user_id"`
-	ClientID            string             `bson:"client_id"`
-	RedirectURI         string             `bson:"redirect_uri"`
-	CodeChallenge       string             `bson:"code_challenge"`
-	CodeChallengeMethod string             `bson:"code_challenge_method"`
-	ExpiresAt           time.Time          `bson:"expires_at"`
-	Scopes              []string           `bson:"scopes"`
-	CreatedAt           time.Time          `bson:"created_at"`
+	ID                   primitive.ObjectID `bson:"_id"`
+	Code                 string             `bson:"code"`
+	UserID               string             `bson:"user_id"`
+	ClientID             string             `bson:"client_id"`
+	RedirectURI          string             `bson:"redirect_uri"`
+	CodeChallenge        string             `bson:"code_challenge"`
+	CodeChallengeMethod  string             `bson:"code_challenge_method"`
+	ExpiresAt            time.Time          `bson:"expires_at"`
+	Scopes               []string           `bson:"scopes"`
+	Resources            []string           `bson:"resources,omitempty"`
+	Nonce                string             `bson:"nonce,omitempty"`
+	AuthTime             time.Time          `bson:"auth_time,omitempty"`
+	AccessTokenID        string             `bson:"access_token_id,omitempty"`
+	RefreshTokenFamilyID string             `bson:"refresh_token_family_id,omitempty"`
+	ConsumedAt           *time.Time         `bson:"consumed_at,omitempty"`
+	CreatedAt            time.Time          `bson:"created_at"`
 }
 
 func (a *AuthorizationCode) IsExpired() bool {
 	return a.ExpiresAt.Before(time.Now().UTC())
 }
 
+func (a *AuthorizationCode) IsConsumed() bool {
+	return a.ConsumedAt != nil
+}
+
 func (a *AuthorizationCode) HasScope(scope string) bool {
 	return slices.Contains(a.Scopes, scope)
 }
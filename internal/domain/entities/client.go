@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -17,8 +18,93 @@ type Client struct {
 	GrantTypes   []string           `bson:"grant_types" json:"grant_types"`
 	RedirectURIs []string           `bson:"redirect_uris" json:"redirect_uris"`
 	Scopes       []string           `bson:"scopes" json:"scopes"`
-	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt    *time.Time         `bson:"updated_at,omitempty" json:"updated_at,omitempty"`
+	// IDTokenSignedResponseAlg é o algoritmo JWS dos ID tokens emitidos ao cliente
+	// (OIDC Dynamic Client Registration); vazio usa o algoritmo padrão.
+	IDTokenSignedResponseAlg string               `bson:"id_token_signed_response_alg,omitempty" json:"id_token_signed_response_alg,omitempty"`
+	SecretHash               string               `bson:"secret_hash,omitempty" json:"-"`
+	TokenEndpointAuthMethod  string               `bson:"token_endpoint_auth_method,omitempty" json:"token_endpoint_auth_method,omitempty"`
+	JWKS                     []signer.JWK         `bson:"jwks,omitempty" json:"jwks,omitempty"`
+	TokenExchangePolicy      *TokenExchangePolicy `bson:"token_exchange_policy,omitempty" json:"token_exchange_policy,omitempty"`
+	// UserinfoSignedResponseAlg faz o UserInfo responder um JWT assinado com esse
+	// algoritmo; vazio responde JSON.
+	UserinfoSignedResponseAlg string `bson:"userinfo_signed_response_alg,omitempty" json:"userinfo_signed_response_alg,omitempty"`
+	// RequirePushedAuthorizationRequests obriga o cliente a iniciar o /authorize com
+	// um request_uri obtido no endpoint de PAR (RFC 9126, seção 6).
+	RequirePushedAuthorizationRequests bool `bson:"require_pushed_authorization_requests,omitempty" json:"require_pushed_authorization_requests,omitempty"`
+	// FirstParty identifica os aplicativos da própria plataforma, que dispensam a
+	// tela de consentimento quando prompt=consent não é pedido.
+	FirstParty bool `bson:"first_party,omitempty" json:"first_party,omitempty"`
+	// TLSClientAuthSubjectDN é o subject DN esperado no certificado dos clientes com
+	// tls_client_auth (RFC 8705, seção 2.1.2).
+	TLSClientAuthSubjectDN string `bson:"tls_client_auth_subject_dn,omitempty" json:"tls_client_auth_subject_dn,omitempty"`
+	// TLSClientCertificateThumbprint é o x5t#S256 do certificado dos clientes com
+	// self_signed_tls_client_auth.
+	TLSClientCertificateThumbprint string     `bson:"tls_client_certificate_thumbprint,omitempty" json:"tls_client_certificate_thumbprint,omitempty"`
+	CreatedAt                      time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt                      *time.Time `bson:"updated_at,omitempty" json:"updated_at,omitempty"`
+}
+
+// TokenExchangePolicy restringe os tokens que o cliente pode obter por token exchange
+// (RFC 8693): audiências permitidas, escopos que podem ser repassados e se o cliente
+// pode apresentar um actor_token para agir em nome do usuário.
+type TokenExchangePolicy struct {
+	Audiences       []string `bson:"audiences" json:"audiences"`
+	Scopes          []string `bson:"scopes,omitempty" json:"scopes,omitempty"`
+	AllowDelegation bool     `bson:"allow_delegation" json:"allow_delegation"`
+	// SubjectAudiences libera a troca de tokens emitidos a outros clientes quando o
+	// aud do subject token é uma delas (ex.: o identificador da API que o cliente serve).
+	SubjectAudiences []string `bson:"subject_audiences,omitempty" json:"subject_audiences,omitempty"`
+}
+
+func (p *TokenExchangePolicy) IsAllowedAudience(audience string) bool {
+	return slices.Contains(p.Audiences, audience)
+}
+
+// IsAllowedSubjectAudience indica se algum aud de um subject token de outro cliente
+// foi liberado pela política.
+func (p *TokenExchangePolicy) IsAllowedSubjectAudience(audiences []string) bool {
+	return slices.ContainsFunc(audiences, func(audience string) bool {
+		return slices.Contains(p.SubjectAudiences, audience)
+	})
+}
+
+// AllowedScopes restringe os escopos do subject token aos escopos da política;
+// uma política sem escopos permite repassar todos os escopos do subject token.
+func (p *TokenExchangePolicy) AllowedScopes(subjectScopes []string) []string {
+	if len(p.Scopes) == 0 {
+		return subjectScopes
+	}
+
+	var allowed []string
+	for _, scope := range subjectScopes {
+		if slices.Contains(p.Scopes, scope) {
+			allowed = append(allowed, scope)
+		}
+	}
+
+	return allowed
+}
+
+// AuthMethod retorna o token_endpoint_auth_method do cliente (RFC 7591). Clientes com
+// secret e sem método registrado foram criados antes do campo e usam client_secret_post.
+func (c *Client) AuthMethod() string {
+	switch {
+	case c.TokenEndpointAuthMethod != "":
+		return c.TokenEndpointAuthMethod
+	case c.SecretHash != "":
+		return "client_secret_post"
+	default:
+		return "none"
+	}
+}
+
+// UsesTLSClientAuth indica se o cliente se autentica pelo certificado do mTLS.
+func (c *Client) UsesTLSClientAuth() bool {
+	return c.AuthMethod() == "tls_client_auth" || c.AuthMethod() == "self_signed_tls_client_auth"
+}
+
+func (c *Client) IsConfidential() bool {
+	return c.AuthMethod() != "none"
 }
 
 func (c *Client) IsValidRedirectURI(redirectURI string) bool {
@@ -37,6 +123,15 @@ func (c *Client) HasScope(scope string) bool {
 	return c.IsValidScope(scope)
 }
 
+// IDTokenSigningAlgorithm retorna o algoritmo usado para assinar os ID tokens do cliente.
+func (c *Client) IDTokenSigningAlgorithm() string {
+	if c.IDTokenSignedResponseAlg == "" {
+		return signer.ES256
+	}
+
+	return c.IDTokenSignedResponseAlg
+}
+
 func (c *Client) GetValidGrantTypes() []string {
 	return c.GrantTypes
 }
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ClientAssertion registra o jti de uma asserção private_key_jwt já usada, até a
+// asserção expirar (RFC 7523, seção 3).
+type ClientAssertion struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	ClientID  string             `bson:"client_id"`
+	JTI       string             `bson:"jti"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Estados de uma requisição de consentimento.
+const (
+	ConsentRequestStatusPending  = "pending"
+	ConsentRequestStatusApproved = "approved"
+	ConsentRequestStatusDenied   = "denied"
+)
+
+// Consent guarda os escopos já autorizados por um usuário a um cliente; novas
+// autorizações dentro desses escopos não voltam à tela de consentimento. É também
+// o registro dos aplicativos conectados que o usuário pode desconectar.
+type Consent struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	UserID   string             `bson:"user_id"`
+	ClientID string             `bson:"client_id"`
+	Scopes   []string           `bson:"scopes"`
+	// LastUsedAt é a última emissão de tokens ao cliente em nome do usuário.
+	LastUsedAt *time.Time `bson:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `bson:"created_at"`
+	UpdatedAt  time.Time  `bson:"updated_at"`
+}
+
+// ConsentRequest é a autorização aguardando a decisão do usuário na tela de
+// consentimento, identificada pelo consent_challenge.
+type ConsentRequest struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	ChallengeHash string             `bson:"challenge_hash"`
+	UserID        string             `bson:"user_id"`
+	ClientID      string             `bson:"client_id"`
+	Scopes        []string           `bson:"scopes"`
+	RedirectURI   string             `bson:"redirect_uri"`
+	State         string             `bson:"state"`
+	ContinueURL   string             `bson:"continue_url"`
+	Status        string             `bson:"status"`
+	ExpiresAt     time.Time          `bson:"expires_at"`
+	CreatedAt     time.Time          `bson:"created_at"`
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Estados de uma autorização de dispositivo (RFC 8628).
+const (
+	DeviceCodeStatusPending  = "pending"
+	DeviceCodeStatusApproved = "approved"
+	DeviceCodeStatusDenied   = "denied"
+	DeviceCodeStatusConsumed = "consumed"
+)
+
+type DeviceCode struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	DeviceCode     string             `bson:"-"`
+	DeviceCodeHash string             `bson:"device_code_hash"`
+	UserCode       string             `bson:"user_code"`
+	ClientID       string             `bson:"client_id"`
+	Scopes         []string           `bson:"scopes"`
+	Status         string             `bson:"status"`
+	UserID         string             `bson:"user_id,omitempty"`
+	Interval       time.Duration      `bson:"interval"`
+	LastPolledAt   *time.Time         `bson:"last_polled_at,omitempty"`
+	ExpiresAt      time.Time          `bson:"expires_at"`
+	CreatedAt      time.Time          `bson:"created_at"`
+}
+
+func (d *DeviceCode) IsExpired() bool {
+	return d.ExpiresAt.Before(time.Now().UTC())
+}
+
+func (d *DeviceCode) IsPending() bool {
+	return d.Status == DeviceCodeStatusPending
+}
+
+// IsPollingTooFast indica se o cliente consultou antes do intervalo acordado.
+func (d *DeviceCode) IsPollingTooFast(now time.Time) bool {
+	return d.LastPolledAt != nil && now.Sub(*d.LastPolledAt) < d.Interval
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PushedAuthorizationRequest guarda os parâmetros de autorização enviados pelo cliente
+// no endpoint de PAR (RFC 9126) até serem usados pelo request_uri.
+type PushedAuthorizationRequest struct {
+	ID                  primitive.ObjectID `bson:"_id"`
+	RequestURIHash      string             `bson:"request_uri_hash"`
+	ClientID            string             `bson:"client_id"`
+	RedirectURI         string             `bson:"redirect_uri"`
+	ResponseType        string             `bson:"response_type"`
+	Scopes              []string           `bson:"scopes"`
+	State               string             `bson:"state"`
+	CodeChallenge       string             `bson:"code_challenge"`
+	CodeChallengeMethod string             `bson:"code_challenge_method"`
+	Resources           []string           `bson:"resources,omitempty"`
+	Nonce               string             `bson:"nonce,omitempty"`
+	Prompt              []string           `bson:"prompt,omitempty"`
+	MaxAge              *int64             `bson:"max_age,omitempty"`
+	LoginHint           string             `bson:"login_hint,omitempty"`
+	IDTokenHint         string             `bson:"id_token_hint,omitempty"`
+	ExpiresAt           time.Time          `bson:"expires_at"`
+	// RedeemedAt marca o primeiro uso no /authorize, quando a validade é estendida.
+	RedeemedAt *time.Time `bson:"redeemed_at,omitempty"`
+	CreatedAt  time.Time  `bson:"created_at"`
+}
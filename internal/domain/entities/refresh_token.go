@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"slices"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -8,13 +9,21 @@ import (
 
 type RefreshToken struct {
 	ID        primitive.ObjectID `bson:"_id"`
+	Token     string             `bson:"-"`
 	TokenHash string             `bson:"token_hash"`
 	UserID    string             `bson:"user_id"`
 	ClientID  string             `bson:"client_id"`
+	FamilyID  string             `bson:"family_id"`
+	ParentID  string             `bson:"parent_id,omitempty"`
 	Scopes    []string           `bson:"scopes"`
-	ExpiresAt time.Time          `bson:"expires_at"`
-	RevokedAt time.Time          `bson:"revoked_at"`
-	CreatedAt time.Time          `bson:"created_at"`
+	Resources []string           `bson:"resources,omitempty"`
+	// AuthTime é o login original, mantido nos ID tokens emitidos na renovação.
+	AuthTime time.Time `bson:"auth_time,omitempty"`
+	// DPoPJKT vincula refresh tokens de clientes públicos à chave DPoP (RFC 9449, seção 5).
+	DPoPJKT   string     `bson:"dpop_jkt,omitempty"`
+	ExpiresAt time.Time  `bson:"expires_at"`
+	RevokedAt *time.Time `bson:"revoked_at,omitempty"`
+	CreatedAt time.Time  `bson:"created_at"`
 }
 
 func (r *RefreshToken) IsExpired() bool {
@@ -22,9 +31,13 @@ func (r *RefreshToken) IsExpired() bool {
 }
 
 func (r *RefreshToken) IsRevoked() bool {
-	return r.RevokedAt.Before(time.Now().UTC())
+	return r.RevokedAt != nil
 }
 
 func (r *RefreshToken) IsValidClientID(clientID string) bool {
 	return r.ClientID == clientID
 }
+
+func (r *RefreshToken) HasScope(scope string) bool {
+	return slices.Contains(r.Scopes, scope)
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ResourceServer é uma API protegida pelos access tokens. Identifier é o valor do
+// parâmetro resource (RFC 8707) e vira o aud dos tokens emitidos para ela; Scopes
+// são os escopos que pertencem à API.
+type ResourceServer struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	Identifier string             `bson:"identifier" json:"identifier"`
+	Name       string             `bson:"name" json:"name"`
+	Scopes     []string           `bson:"scopes" json:"scopes"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// OwnedScopes filtra os escopos que pertencem ao resource server.
+func (r *ResourceServer) OwnedScopes(scopes []string) []string {
+	var owned []string
+	for _, scope := range scopes {
+		if slices.Contains(r.Scopes, scope) {
+			owned = append(owned, scope)
+		}
+	}
+
+	return owned
+}
@@ -0,0 +1,14 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RevokedToken struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	JTI       string             `bson:"jti"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	RevokedAt time.Time          `bson:"revoked_at"`
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SigningKeyStatus string
+
+const (
+	// SigningKeyStatusPending é publicada no JWKS antes de assinar, para que os
+	// resource servers já a tenham em cache quando ela for ativada.
+	SigningKeyStatusPending SigningKeyStatus = "pending"
+	SigningKeyStatusActive  SigningKeyStatus = "active"
+	// SigningKeyStatusRetired não assina mais, mas verifica até VerifyUntil.
+	SigningKeyStatusRetired SigningKeyStatus = "retired"
+)
+
+type SigningKey struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	KID         string             `bson:"kid"`
+	Algorithm   string             `bson:"algorithm"`
+	PrivateKey  string             `bson:"private_key"`
+	PublicKey   string             `bson:"public_key"`
+	Status      SigningKeyStatus   `bson:"status"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	ActivatedAt *time.Time         `bson:"activated_at,omitempty"`
+	RetiredAt   *time.Time         `bson:"retired_at,omitempty"`
+	VerifyUntil *time.Time         `bson:"verify_until,omitempty"`
+}
+
+func (k *SigningKey) IsActive() bool {
+	return k.Status == SigningKeyStatusActive
+}
+
+func (k *SigningKey) CanVerify() bool {
+	if k.Status != SigningKeyStatusRetired {
+		return true
+	}
+
+	return k.VerifyUntil != nil && k.VerifyUntil.After(time.Now().UTC())
+}
@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigningKey_CanVerify(t *testing.T) {
+	t.Run("should verify with pending and active keys", func(t *testing.T) {
+		// Arrange
+		pending := &SigningKey{Status: SigningKeyStatusPending}
+		active := &SigningKey{Status: SigningKeyStatusActive}
+
+		// Act & Assert
+		assert.True(t, pending.CanVerify())
+		assert.True(t, active.CanVerify())
+	})
+
+	t.Run("should verify with retired key until its tokens expire", func(t *testing.T) {
+		// Arrange
+		verifyUntil := time.Now().Add(time.Hour)
+		key := &SigningKey{Status: SigningKeyStatusRetired, VerifyUntil: &verifyUntil}
+
+		// Act
+		result := key.CanVerify()
+
+		// Assert
+		assert.True(t, result)
+	})
+
+	t.Run("should not verify with retired key after its tokens expire", func(t *testing.T) {
+		// Arrange
+		verifyUntil := time.Now().Add(-time.Minute)
+		key := &SigningKey{Status: SigningKeyStatusRetired, VerifyUntil: &verifyUntil}
+
+		// Act
+		result := key.CanVerify()
+
+		// Assert
+		assert.False(t, result)
+	})
+}
@@ -19,6 +19,15 @@ var (
 	ErrInvalidRedirectURI  = errors.New("invalid redirect uri")
 	ErrInvalidGrantType    = errors.New("invalid grant type")
 	ErrInvalidScope        = errors.New("invalid scope")
+	ErrInvalidClientSecret = errors.New("invalid client credentials")
+
+	// Client Authentication
+	ErrInvalidClientAssertion    = errors.New("invalid client assertion")
+	ErrClientAuthMethodMismatch  = errors.New("client authentication method not allowed")
+	ErrMultipleClientAuthMethods = errors.New("multiple client authentication methods")
+	ErrInvalidClientMetadata     = errors.New("invalid client metadata")
+	ErrInvalidClientCertificate  = errors.New("invalid client certificate")
+	ErrClientAssertionReplayed   = errors.New("client assertion already used")
 
 	// User
 	ErrUserAlreadyExists = errors.New("user already exists")
@@ -28,10 +37,12 @@ var (
 	ErrAuthorizationCodeNotFound = errors.New("authorization code not found")
 	ErrAuthorizationCodeExpired  = errors.New("authorization code expired")
 	ErrAuthorizationCodeInvalid  = errors.New("authorization code invalid")
+	ErrAuthorizationCodeReused   = errors.New("authorization code reused")
 
 	// OAuth
 	ErrInvalidResponseType     = errors.New("invalid response type")
 	ErrUnauthorizedClient      = errors.New("unauthorized client")
+	ErrClientMismatch          = errors.New("grant issued to another client")
 	ErrUnauthorizedRedirectURI = errors.New("unauthorized redirect uri")
 	ErrUserAlreadyRegistered   = errors.New("user already registered")
 
@@ -39,6 +50,56 @@ var (
 	ErrRefreshTokenNotFound = errors.New("refresh token not found")
 	ErrRefreshTokenExpired  = errors.New("refresh token expired")
 	ErrRefreshTokenRevoked  = errors.New("refresh token revoked")
+	ErrRefreshTokenReused   = errors.New("refresh token reused")
+
+	// Access Token
+	ErrAccessTokenRevoked  = errors.New("access token revoked")
+	ErrInvalidSessionToken = errors.New("not a session token")
+
+	// Device Code
+	ErrDeviceCodeNotFound   = errors.New("device code not found")
+	ErrDeviceCodeExpired    = errors.New("device code expired")
+	ErrDeviceAccessDenied   = errors.New("device authorization denied")
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("polling too frequently")
+	ErrUserCodeNotFound     = errors.New("user code not found")
+
+	// Pushed Authorization Request
+	ErrInvalidRequestURI = errors.New("invalid or expired request_uri")
+	ErrPARRequired       = errors.New("client requires pushed authorization requests")
+
+	// Authentication Request (OIDC)
+	ErrInvalidPrompt      = errors.New("invalid prompt value")
+	ErrInvalidIDTokenHint = errors.New("invalid id_token_hint")
+	ErrLoginRequired      = errors.New("end-user authentication is required")
+
+	// Consent
+	ErrConsentNotFound        = errors.New("consent not found")
+	ErrConsentRequestNotFound = errors.New("consent request not found or expired")
+	ErrConsentRequired        = errors.New("end-user consent is required")
+	ErrConsentDenied          = errors.New("the user denied the authorization request")
+
+	// Request Object
+	ErrInvalidRequestObject = errors.New("invalid request object")
+
+	// DPoP
+	ErrInvalidDPoPProof = errors.New("invalid DPoP proof")
+	ErrDPoPKeyMismatch  = errors.New("DPoP proof key does not match the bound key")
+
+	// Token Exchange
+	ErrInvalidSubjectToken  = errors.New("invalid subject token")
+	ErrInvalidActorToken    = errors.New("invalid actor token")
+	ErrUnsupportedTokenType = errors.New("unsupported token type")
+	ErrInvalidTarget        = errors.New("audience not allowed for this client")
+
+	// Resource Server
+	ErrResourceServerNotFound      = errors.New("resource server not found")
+	ErrResourceServerAlreadyExists = errors.New("resource server already exists")
+	ErrInvalidResourceServer       = errors.New("invalid resource server metadata")
+	ErrInvalidResource             = errors.New("resource not registered or not granted")
+
+	// Signing Key
+	ErrSigningKeyNotFound = errors.New("signing key not found")
 
 	// ObjectID
 	ErrInvalidObjectID = errors.New("invalid object id")
@@ -51,3 +112,19 @@ type ErrOTPNotResendable struct {
 func (e *ErrOTPNotResendable) Error() string {
 	return fmt.Sprintf("otp not resendable, time remaining: %s", e.TimeRemaining)
 }
+
+// ErrAuthorizationRedirect indica um erro do /authorize que pode ser devolvido
+// ao cliente via redirect, pois o redirect_uri já foi validado.
+type ErrAuthorizationRedirect struct {
+	RedirectURI string
+	State       string
+	Err         error
+}
+
+func (e *ErrAuthorizationRedirect) Error() string {
+	return fmt.Sprintf("authorization redirect: %v", e.Err)
+}
+
+func (e *ErrAuthorizationRedirect) Unwrap() error {
+	return e.Err
+}
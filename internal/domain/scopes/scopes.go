@@ -25,6 +25,8 @@ var AllScopes = []Scope{
 
 	// OpenID Connect
 	{Name: "openid", Description: "Sinaliza uma requisição de autenticação OpenID Connect", Category: "OpenID"},
+	{Name: "profile", Description: "Ler nome e sobrenome no UserInfo", Category: "OpenID"},
+	{Name: "email", Description: "Ler o email no UserInfo", Category: "OpenID"},
 
 	// Scopes Administrativos
 	{Name: "users:read", Description: "Visualizar qualquer usuário do sistema", Category: "Admin - Usuários"},
@@ -35,6 +37,23 @@ var AllScopes = []Scope{
 	{Name: "clients:create", Description: "Criar novos clientes OAuth", Category: "Admin - Clientes"},
 	{Name: "clients:update", Description: "Modificar clientes OAuth", Category: "Admin - Clientes"},
 	{Name: "clients:delete", Description: "Remover clientes OAuth", Category: "Admin - Clientes"},
+
+	{Name: "resource_servers:create", Description: "Registrar resource servers", Category: "Admin - Resource Servers"},
+
+	// Scopes de Resource Servers
+	{Name: "token:introspect", Description: "Consultar o estado de tokens emitidos", Category: "Resource Servers"},
+}
+
+// explicitConsentScopes alteram dados da conta; clientes de terceiros precisam do
+// consentimento do usuário a cada autorização que os peça.
+var explicitConsentScopes = []string{"profile:write", "password:write", "account:delete:self"}
+
+func Names() []string {
+	names := make([]string, len(AllScopes))
+	for i, scope := range AllScopes {
+		names[i] = scope.Name
+	}
+	return names
 }
 
 func GetScopeByName(name string) (Scope, bool) {
@@ -73,6 +92,10 @@ func HasAllScopes(userScopes []string, requiredScopes []string) bool {
 	return true
 }
 
+func RequiresExplicitConsent(scopeName string) bool {
+	return slices.Contains(explicitConsentScopes, scopeName)
+}
+
 func ParseScopes(scopesString string) []string {
 	if scopesString == "" {
 		return []string{}
@@ -88,6 +111,7 @@ func GetDefaultFirstPartyScopes() []string {
 	return []string{
 		"openid",
 		"profile:read",
+		"email",
 	}
 }
 
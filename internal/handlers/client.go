@@ -43,13 +43,18 @@ func (h *clientHandler) CreateClient(ectx echo.Context) error {
 		return err
 	}
 
-	response, err := h.clientService.CreateClient(ectx.Request().Context(), payload.Name, payload.Description, payload.RedirectURIs, payload.GrantTypes)
+	response, err := h.clientService.CreateClient(ectx.Request().Context(), models.NewCreateClientInput(payload))
 	if err != nil {
 		if errors.Is(err, domain.ErrClientAlreadyExists) {
 			logger.Error(err.Error())
 			return echo.ErrConflict
 		}
 
+		if errors.Is(err, domain.ErrInvalidClientMetadata) {
+			logger.Warn(err.Error())
+			return echo.ErrBadRequest
+		}
+
 		logger.Error("create client", "error", err)
 		return echo.ErrInternalServerError
 	}
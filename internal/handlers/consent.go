@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type ConsentHandler interface {
+	GetConsentRequest(ectx echo.Context) error
+	DecideConsentRequest(ectx echo.Context) error
+}
+
+type consentHandler struct {
+	consentService services.ConsentService
+}
+
+func NewConsentHandler(consentService services.ConsentService) ConsentHandler {
+	return &consentHandler{
+		consentService: consentService,
+	}
+}
+
+// GetConsentRequest mostra à tela de consentimento o cliente e os escopos pedidos.
+func (h *consentHandler) GetConsentRequest(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "consent"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	userID := middlewares.GetUserID(ectx)
+	if userID == "" {
+		return echo.ErrUnauthorized
+	}
+
+	var payload models.ConsentRequestPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("bind payload", "error", err)
+		return echo.ErrBadRequest
+	}
+
+	if err := ectx.Validate(&payload); err != nil {
+		logger.Error("validate payload", "error", err)
+		return err
+	}
+
+	response, err := h.consentService.GetConsentRequest(ectx.Request().Context(), userID, payload.ConsentChallenge)
+	if err != nil {
+		if errors.Is(err, domain.ErrConsentRequestNotFound) {
+			logger.Warn(err.Error())
+			return echo.ErrNotFound
+		}
+
+		logger.Error("get consent request", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.JSON(http.StatusOK, response)
+}
+
+// DecideConsentRequest registra a decisão do usuário e responde com a URL para onde a
+// tela deve levar o navegador, inclusive quando o cliente deve receber access_denied.
+func (h *consentHandler) DecideConsentRequest(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "consent"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	userID := middlewares.GetUserID(ectx)
+	if userID == "" {
+		return echo.ErrUnauthorized
+	}
+
+	var payload models.ConsentDecisionPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("bind payload", "error", err)
+		return echo.ErrBadRequest
+	}
+
+	if err := ectx.Validate(&payload); err != nil {
+		logger.Error("validate payload", "error", err)
+		return err
+	}
+
+	response, err := h.consentService.DecideConsentRequest(ectx.Request().Context(), models.NewConsentDecisionInput(payload, userID))
+	if err != nil {
+		var redirectErr *domain.ErrAuthorizationRedirect
+		if errors.As(err, &redirectErr) {
+			redirectURL, err := authorizationErrorRedirectURL(redirectErr, mapOAuthError(err, authorizeErrorMappings))
+			if err != nil {
+				logger.Error("failed to build error redirect url", "error", err)
+				return echo.ErrInternalServerError
+			}
+
+			return ectx.JSON(http.StatusOK, models.ConsentDecisionResponse{RedirectTo: redirectURL})
+		}
+
+		if errors.Is(err, domain.ErrConsentRequestNotFound) {
+			logger.Warn(err.Error())
+			return echo.ErrNotFound
+		}
+
+		logger.Error("decide consent request", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.JSON(http.StatusOK, response)
+}
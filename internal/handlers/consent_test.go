@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConsentRequest(t *testing.T) {
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/oauth/consent?consent_challenge=challenge", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middlewares.SetUserClaims(c, &models.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"}})
+
+		return c, rec
+	}
+
+	t.Run("should describe the pending consent request", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext()
+
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		handler := NewConsentHandler(mockConsentService)
+
+		mockConsentService.EXPECT().
+			GetConsentRequest(mock.Anything, "user-id", "challenge").
+			Return(&models.ConsentRequestResponse{ClientID: "partner-app", ClientName: "Partner", GrantedScopes: []string{}}, nil).Once()
+
+		// Act
+		err := handler.GetConsentRequest(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"client_name":"Partner"`)
+	})
+
+	t.Run("should return not found when consent request is unknown", func(t *testing.T) {
+		// Arrange
+		c, _ := newContext()
+
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		handler := NewConsentHandler(mockConsentService)
+
+		mockConsentService.EXPECT().
+			GetConsentRequest(mock.Anything, "user-id", "challenge").
+			Return(nil, domain.ErrConsentRequestNotFound).Once()
+
+		// Act
+		err := handler.GetConsentRequest(c)
+
+		// Assert
+		assert.Equal(t, echo.ErrNotFound, err)
+	})
+}
+
+func TestDecideConsentRequest(t *testing.T) {
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/oauth/consent", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middlewares.SetUserClaims(c, &models.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"}})
+
+		return c, rec
+	}
+
+	t.Run("should return the authorize url when the user approves", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"consent_challenge":"challenge","approve":true}`)
+
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		handler := NewConsentHandler(mockConsentService)
+
+		mockConsentService.EXPECT().
+			DecideConsentRequest(mock.Anything, models.ConsentDecisionInput{ConsentChallenge: "challenge", UserID: "user-id", Approve: true}).
+			Return(&models.ConsentDecisionResponse{RedirectTo: "https://id.example.com/api/v1/oauth/authorize?consent_challenge=challenge"}, nil).Once()
+
+		// Act
+		err := handler.DecideConsentRequest(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response models.ConsentDecisionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/authorize?consent_challenge=challenge", response.RedirectTo)
+	})
+
+	t.Run("should return the client redirect with access_denied when the user denies", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"consent_challenge":"challenge","approve":false}`)
+
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		handler := NewConsentHandler(mockConsentService)
+
+		mockConsentService.EXPECT().
+			DecideConsentRequest(mock.Anything, models.ConsentDecisionInput{ConsentChallenge: "challenge", UserID: "user-id"}).
+			Return(nil, &domain.ErrAuthorizationRedirect{
+				RedirectURI: "https://partner.example.com/callback",
+				State:       "state",
+				Err:         domain.ErrConsentDenied,
+			}).Once()
+
+		// Act
+		err := handler.DecideConsentRequest(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response models.ConsentDecisionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		redirectTo, err := url.Parse(response.RedirectTo)
+		require.NoError(t, err)
+		assert.Equal(t, "partner.example.com", redirectTo.Host)
+		assert.Equal(t, "access_denied", redirectTo.Query().Get("error"))
+		assert.Equal(t, "state", redirectTo.Query().Get("state"))
+	})
+}
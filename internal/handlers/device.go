@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/api"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type DeviceHandler interface {
+	DeviceAuthorization(ectx echo.Context) error
+	DeviceVerification(ectx echo.Context) error
+	DeviceDecision(ectx echo.Context) error
+}
+
+type deviceHandler struct {
+	deviceService       services.DeviceAuthorizationService
+	clientAuthenticator services.ClientAuthenticator
+	config              *configs.Environment
+}
+
+func NewDeviceHandler(
+	deviceService services.DeviceAuthorizationService,
+	clientAuthenticator services.ClientAuthenticator,
+	config *configs.Environment,
+) DeviceHandler {
+	return &deviceHandler{
+		deviceService:       deviceService,
+		clientAuthenticator: clientAuthenticator,
+		config:              config,
+	}
+}
+
+// DeviceAuthorization inicia o fluxo de dispositivo (RFC 8628, seção 3.1), emitindo o
+// device_code consultado pelo dispositivo e o user_code digitado pelo usuário.
+func (h *deviceHandler) DeviceAuthorization(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "device"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	ectx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	var payload models.DeviceAuthorizationPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("failed to bind input", "error", err)
+		return api.NewInvalidRequestError("malformed device authorization request")
+	}
+
+	client, err := authenticateClient(ectx, logger, h.clientAuthenticator, payload.ClientAuthenticationPayload, RouteOAuthDeviceAuthorization)
+	if err != nil {
+		return err
+	}
+
+	payload.ClientID = client.ClientID
+	verificationURI := services.Issuer(h.config) + ectx.Echo().Reverse(RouteOAuthDevice)
+
+	response, err := h.deviceService.CreateDeviceAuthorization(ectx.Request().Context(), models.NewDeviceAuthorizationInput(payload, verificationURI))
+	if err != nil {
+		oauthErr := mapOAuthError(err, tokenErrorMappings)
+		if oauthErr.Code == api.OAuthErrorServerError {
+			logger.Error("failed to create device authorization", "error", err)
+		} else {
+			logger.Warn(err.Error())
+		}
+
+		return oauthErr
+	}
+
+	return ectx.JSON(http.StatusOK, response)
+}
+
+// DeviceVerification mostra ao usuário logado qual cliente pediu acesso pelo user_code;
+// sem sessão, o usuário é enviado ao login e volta para esta mesma URL.
+func (h *deviceHandler) DeviceVerification(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "device"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	if middlewares.GetUserID(ectx) == "" {
+		loginURL, err := h.deviceService.LoginRedirectURL(services.Issuer(h.config) + ectx.Request().URL.RequestURI())
+		if err != nil {
+			logger.Error("failed to build login url", "error", err)
+			return echo.ErrInternalServerError
+		}
+
+		return ectx.Redirect(http.StatusFound, loginURL)
+	}
+
+	var payload models.DeviceVerificationPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("bind payload", "error", err)
+		return echo.ErrBadRequest
+	}
+
+	if payload.UserCode == "" {
+		return echo.ErrBadRequest
+	}
+
+	response, err := h.deviceService.GetDeviceVerification(ectx.Request().Context(), payload.UserCode)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserCodeNotFound) {
+			logger.Warn(err.Error())
+			return echo.ErrNotFound
+		}
+
+		logger.Error("get device verification", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.JSON(http.StatusOK, response)
+}
+
+// DeviceDecision registra a aprovação ou recusa do usuário para o user_code.
+func (h *deviceHandler) DeviceDecision(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "device"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	userID := middlewares.GetUserID(ectx)
+	if userID == "" {
+		return echo.ErrUnauthorized
+	}
+
+	var payload models.DeviceDecisionPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("bind payload", "error", err)
+		return echo.ErrBadRequest
+	}
+
+	if err := ectx.Validate(&payload); err != nil {
+		logger.Error("validate payload", "error", err)
+		return err
+	}
+
+	if err := h.deviceService.DecideDeviceAuthorization(ectx.Request().Context(), models.NewDeviceDecisionInput(payload, userID)); err != nil {
+		if errors.Is(err, domain.ErrUserCodeNotFound) {
+			logger.Warn(err.Error())
+			return echo.ErrNotFound
+		}
+
+		logger.Error("decide device authorization", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.NoContent(http.StatusNoContent)
+}
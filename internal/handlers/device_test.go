@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/api"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceAuthorization(t *testing.T) {
+	config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+
+	t.Run("should issue device and user codes pointing to the verification endpoint", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("client_id", "tv-app")
+		form.Set("scope", "openid profile")
+		c, rec := newFormContext(form)
+		c.Echo().GET("/api/v1/oauth/device", func(echo.Context) error { return nil }).Name = RouteOAuthDevice
+
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		handler := NewDeviceHandler(mockDeviceService, newClientAuthenticatorMock(t, "tv-app"), config)
+
+		expectedResponse := &models.DeviceAuthorizationResponse{
+			DeviceCode:      "device-code",
+			UserCode:        "BCDF-GHJK",
+			VerificationURI: "https://id.example.com/api/v1/oauth/device",
+			ExpiresIn:       600,
+			Interval:        5,
+		}
+
+		mockDeviceService.EXPECT().
+			CreateDeviceAuthorization(mock.Anything, models.DeviceAuthorizationInput{
+				ClientID:        "tv-app",
+				Scope:           []string{"openid", "profile"},
+				VerificationURI: "https://id.example.com/api/v1/oauth/device",
+			}).
+			Return(expectedResponse, nil).Once()
+
+		// Act
+		err := handler.DeviceAuthorization(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response models.DeviceAuthorizationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, *expectedResponse, response)
+	})
+
+	t.Run("should return unauthorized_client when client cannot use the device grant", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("client_id", "web-app")
+		c, _ := newFormContext(form)
+
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		handler := NewDeviceHandler(mockDeviceService, newClientAuthenticatorMock(t, "web-app"), config)
+
+		mockDeviceService.EXPECT().
+			CreateDeviceAuthorization(mock.Anything, mock.AnythingOfType("models.DeviceAuthorizationInput")).
+			Return(nil, domain.ErrUnauthorizedClient).Once()
+
+		// Act
+		err := handler.DeviceAuthorization(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorUnauthorizedClient, oauthErr.Code)
+	})
+}
+
+func TestDeviceVerification(t *testing.T) {
+	config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+
+	newContext := func(userID string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/oauth/device?user_code=bcdf-ghjk", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			middlewares.SetUserClaims(c, &models.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}})
+		}
+
+		return c, rec
+	}
+
+	t.Run("should redirect to login when user is not authenticated", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext("")
+
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		handler := NewDeviceHandler(mockDeviceService, nil, config)
+
+		mockDeviceService.EXPECT().
+			LoginRedirectURL("https://id.example.com/api/v1/oauth/device?user_code=bcdf-ghjk").
+			Return("https://app.example.com/login?continue=x", nil).Once()
+
+		// Act
+		err := handler.DeviceVerification(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "https://app.example.com/login?continue=x", rec.Header().Get(echo.HeaderLocation))
+	})
+
+	t.Run("should describe the pending authorization for the user code", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext("user-id")
+
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		handler := NewDeviceHandler(mockDeviceService, nil, config)
+
+		mockDeviceService.EXPECT().
+			GetDeviceVerification(mock.Anything, "bcdf-ghjk").
+			Return(&models.DeviceVerificationResponse{UserCode: "BCDF-GHJK", ClientID: "tv-app", ClientName: "TV"}, nil).Once()
+
+		// Act
+		err := handler.DeviceVerification(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"client_name":"TV"`)
+	})
+
+	t.Run("should return not found when user code is unknown", func(t *testing.T) {
+		// Arrange
+		c, _ := newContext("user-id")
+
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		handler := NewDeviceHandler(mockDeviceService, nil, config)
+
+		mockDeviceService.EXPECT().
+			GetDeviceVerification(mock.Anything, "bcdf-ghjk").
+			Return(nil, domain.ErrUserCodeNotFound).Once()
+
+		// Act
+		err := handler.DeviceVerification(c)
+
+		// Assert
+		assert.Equal(t, echo.ErrNotFound, err)
+	})
+}
+
+func TestDeviceDecision(t *testing.T) {
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/oauth/device", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middlewares.SetUserClaims(c, &models.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"}})
+
+		return c, rec
+	}
+
+	t.Run("should approve the device for the authenticated user", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"user_code":"BCDF-GHJK","approve":true}`)
+
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		handler := NewDeviceHandler(mockDeviceService, nil, nil)
+
+		mockDeviceService.EXPECT().
+			DecideDeviceAuthorization(mock.Anything, models.DeviceDecisionInput{UserCode: "BCDF-GHJK", UserID: "user-id", Approve: true}).
+			Return(nil).Once()
+
+		// Act
+		err := handler.DeviceDecision(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("should return not found when user code was already decided", func(t *testing.T) {
+		// Arrange
+		c, _ := newContext(`{"user_code":"BCDF-GHJK","approve":false}`)
+
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		handler := NewDeviceHandler(mockDeviceService, nil, nil)
+
+		mockDeviceService.EXPECT().
+			DecideDeviceAuthorization(mock.Anything, mock.AnythingOfType("models.DeviceDecisionInput")).
+			Return(domain.ErrUserCodeNotFound).Once()
+
+		// Act
+		err := handler.DeviceDecision(c)
+
+		// Assert
+		assert.Equal(t, echo.ErrNotFound, err)
+	})
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type GrantHandler interface {
+	ListGrants(ectx echo.Context) error
+	RevokeGrant(ectx echo.Context) error
+}
+
+type grantHandler struct {
+	consentService services.ConsentService
+}
+
+func NewGrantHandler(consentService services.ConsentService) GrantHandler {
+	return &grantHandler{
+		consentService: consentService,
+	}
+}
+
+// ListGrants lista os aplicativos conectados à conta do usuário logado.
+func (h *grantHandler) ListGrants(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "grant"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	userID := middlewares.GetUserID(ectx)
+	if userID == "" {
+		return echo.ErrUnauthorized
+	}
+
+	grants, err := h.consentService.ListGrants(ectx.Request().Context(), userID)
+	if err != nil {
+		logger.Error("list grants", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.JSON(http.StatusOK, grants)
+}
+
+// RevokeGrant desconecta um aplicativo da conta do usuário logado.
+func (h *grantHandler) RevokeGrant(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "grant"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	userID := middlewares.GetUserID(ectx)
+	if userID == "" {
+		return echo.ErrUnauthorized
+	}
+
+	var payload models.RevokeGrantPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("bind payload", "error", err)
+		return echo.ErrBadRequest
+	}
+
+	if err := ectx.Validate(&payload); err != nil {
+		logger.Error("validate payload", "error", err)
+		return err
+	}
+
+	if err := h.consentService.RevokeGrant(ectx.Request().Context(), userID, payload.ClientID); err != nil {
+		logger.Error("revoke grant", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.NoContent(http.StatusNoContent)
+}
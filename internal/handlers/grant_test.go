@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListGrants(t *testing.T) {
+	t.Run("should list the clients connected to the authenticated user", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/me/grants", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middlewares.SetUserClaims(c, &models.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"}})
+
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		handler := NewGrantHandler(mockConsentService)
+
+		mockConsentService.EXPECT().
+			ListGrants(mock.Anything, "user-id").
+			Return([]models.GrantResponse{{ClientID: "partner-app", ClientName: "Partner", Scopes: []string{"openid"}}}, nil).Once()
+
+		// Act
+		err := handler.ListGrants(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"client_name":"Partner"`)
+	})
+}
+
+func TestRevokeGrant(t *testing.T) {
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/me/grants/partner-app", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("client_id")
+		c.SetParamValues("partner-app")
+		middlewares.SetUserClaims(c, &models.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"}})
+
+		return c, rec
+	}
+
+	t.Run("should disconnect the client from the authenticated user", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext()
+
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		handler := NewGrantHandler(mockConsentService)
+
+		mockConsentService.EXPECT().RevokeGrant(mock.Anything, "user-id", "partner-app").Return(nil).Once()
+
+		// Act
+		err := handler.RevokeGrant(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("should return internal server error when the revocation fails", func(t *testing.T) {
+		// Arrange
+		c, _ := newContext()
+
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		handler := NewGrantHandler(mockConsentService)
+
+		mockConsentService.EXPECT().RevokeGrant(mock.Anything, "user-id", "partner-app").Return(errors.New("database error")).Once()
+
+		// Act
+		err := handler.RevokeGrant(c)
+
+		// Assert
+		assert.Equal(t, echo.ErrInternalServerError, err)
+	})
+}
@@ -2,28 +2,53 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 
+	"github.com/aetheris-lab/aetheris-id/api/internal/api"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
 	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/mtls"
 	"github.com/labstack/echo/v4"
 )
 
 type OAuthHandler interface {
 	Authorize(ectx echo.Context) error
 	Token(ectx echo.Context) error
+	Introspect(ectx echo.Context) error
+	Revoke(ectx echo.Context) error
+	PushedAuthorization(ectx echo.Context) error
 }
 
 type oauthHandler struct {
-	oauthService services.OAuthService
+	oauthService              services.OAuthService
+	tokenIntrospectionService services.TokenIntrospectionService
+	tokenRevocationService    services.TokenRevocationService
+	clientAuthenticator       services.ClientAuthenticator
+	tokenExchangeService      services.TokenExchangeService
+	dpopService               services.DPoPService
 }
 
-func NewOAuthHandler(oauthService services.OAuthService) OAuthHandler {
+func NewOAuthHandler(
+	oauthService services.OAuthService,
+	tokenIntrospectionService services.TokenIntrospectionService,
+	tokenRevocationService services.TokenRevocationService,
+	clientAuthenticator services.ClientAuthenticator,
+	tokenExchangeService services.TokenExchangeService,
+	dpopService services.DPoPService,
+) OAuthHandler {
 	return &oauthHandler{
-		oauthService: oauthService,
+		oauthService:              oauthService,
+		tokenIntrospectionService: tokenIntrospectionService,
+		tokenRevocationService:    tokenRevocationService,
+		clientAuthenticator:       clientAuthenticator,
+		tokenExchangeService:      tokenExchangeService,
+		dpopService:               dpopService,
 	}
 }
 
@@ -37,70 +62,302 @@ func (h *oauthHandler) Authorize(ectx echo.Context) error {
 	var payload models.AuthorizePayload
 	if err := ectx.Bind(&payload); err != nil {
 		logger.Error("failed to bind input", "error", err)
-		return echo.ErrBadRequest
+		return api.NewInvalidRequestError("malformed authorization request")
 	}
 
 	if err := ectx.Validate(payload); err != nil {
-		logger.Error("failed to validate payload", "error", err)
-		return err
+		logger.Warn("failed to validate payload", "error", err)
+		return newInvalidRequestError(payload, err)
 	}
 
-	input := models.NewAuthorizeInput(payload, middlewares.GetUserID(ectx))
+	input := models.NewAuthorizeInput(payload, middlewares.GetUserID(ectx), middlewares.GetAuthTime(ectx))
 
 	response, err := h.oauthService.Authorize(ectx.Request().Context(), input)
 	if err != nil {
-		if errors.Is(err, domain.ErrClientNotFound) {
+		oauthErr := mapOAuthError(err, authorizeErrorMappings)
+		if oauthErr.Code == api.OAuthErrorServerError {
+			logger.Error("authorize", "error", err)
+		} else {
 			logger.Warn(err.Error())
-			return echo.ErrBadRequest
 		}
 
-		if errors.Is(err, domain.ErrInvalidRedirectURI) {
-			logger.Warn(err.Error())
-			return echo.ErrBadRequest
+		var redirectErr *domain.ErrAuthorizationRedirect
+		if !errors.As(err, &redirectErr) {
+			return oauthErr
 		}
 
-		if errors.Is(err, domain.ErrInvalidGrantType) || errors.Is(err, domain.ErrInvalidResponseType) {
-			logger.Warn(err.Error())
-			return echo.ErrBadRequest
+		redirectURL, err := authorizationErrorRedirectURL(redirectErr, oauthErr)
+		if err != nil {
+			logger.Error("failed to build error redirect url", "error", err)
+			return oauthErr
 		}
 
-		if errors.Is(err, domain.ErrInvalidScope) {
+		return ectx.Redirect(http.StatusFound, redirectURL)
+	}
+
+	return ectx.Redirect(http.StatusFound, response.RedirectURL)
+}
+
+func (h *oauthHandler) Token(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "oauth"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	ectx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	ectx.Response().Header().Set("Pragma", "no-cache")
+
+	var payload models.TokenPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("failed to bind input", "error", err)
+		return api.NewInvalidRequestError("malformed token request")
+	}
+
+	if err := ectx.Validate(payload); err != nil {
+		logger.Warn("failed to validate payload", "error", err)
+		return newInvalidRequestError(payload, err)
+	}
+
+	if !slices.Contains(models.SupportedGrantTypes, payload.GrantType) {
+		logger.Warn("unsupported grant type", "grant_type", payload.GrantType)
+		return api.NewOAuthError(http.StatusBadRequest, api.OAuthErrorUnsupportedGrantType, fmt.Sprintf("grant type %q is not supported", payload.GrantType))
+	}
+
+	client, err := authenticateClient(ectx, logger, h.clientAuthenticator, payload.ClientAuthenticationPayload, RouteOAuthToken)
+	if err != nil {
+		return err
+	}
+
+	// Os fluxos passam a usar o client_id autenticado, que pode ter vindo do header
+	// Authorization ou da asserção em vez do formulário.
+	payload.ClientID = client.ClientID
+
+	// Tokens de clientes autenticados por mTLS ficam vinculados ao certificado usado.
+	if client.UsesTLSClientAuth() {
+		payload.CertificateThumbprint = clientCertificateThumbprint(ectx)
+	}
+
+	payload.DPoPJKT, err = validateDPoPProof(ectx, logger, h.dpopService, RouteOAuthToken)
+	if err != nil {
+		return err
+	}
+
+	var response *models.TokenResponse
+	switch payload.GrantType {
+	case models.GrantTypeAuthorizationCode:
+		response, err = h.oauthService.ExchangeCodeForToken(ectx.Request().Context(), models.NewExchangeAuthorizationCodeInput(payload))
+	case models.GrantTypeRefreshToken:
+		response, err = h.oauthService.RefreshAccessToken(ectx.Request().Context(), models.NewRefreshTokenInput(payload))
+	case models.GrantTypeClientCredentials:
+		response, err = h.oauthService.ClientCredentials(ectx.Request().Context(), models.NewClientCredentialsInput(payload))
+	case models.GrantTypeDeviceCode:
+		response, err = h.oauthService.ExchangeDeviceCode(ectx.Request().Context(), models.NewDeviceCodeInput(payload))
+	case models.GrantTypeTokenExchange:
+		input := models.NewTokenExchangeInput(payload)
+		input.PresentedCertificateThumbprint = clientCertificateThumbprint(ectx)
+		response, err = h.tokenExchangeService.Exchange(ectx.Request().Context(), input)
+	}
+
+	if err != nil {
+		oauthErr := mapOAuthError(err, tokenErrorMappings)
+		if oauthErr.Code == api.OAuthErrorServerError {
+			logger.Error("failed to issue token", "grant_type", payload.GrantType, "error", err)
+		} else {
 			logger.Warn(err.Error())
-			return echo.ErrBadRequest
 		}
 
-		logger.Error("authorize", "error", err)
+		return oauthErr
+	}
+
+	return ectx.JSON(http.StatusOK, response)
+}
+
+func (h *oauthHandler) Introspect(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "oauth"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	ectx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	ectx.Response().Header().Set("Pragma", "no-cache")
+
+	var payload models.IntrospectPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("failed to bind input", "error", err)
+		return api.NewInvalidRequestError("malformed introspection request")
+	}
+
+	if err := ectx.Validate(payload); err != nil {
+		logger.Warn("failed to validate payload", "error", err)
+		return newInvalidRequestError(payload, err)
+	}
+
+	response, err := h.tokenIntrospectionService.Introspect(ectx.Request().Context(), models.NewIntrospectTokenInput(payload))
+	if err != nil {
+		logger.Error("failed to introspect token", "error", err)
+		return api.NewServerError()
+	}
+
+	return ectx.JSON(http.StatusOK, response)
+}
+
+func (h *oauthHandler) Revoke(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "oauth"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	var payload models.RevokePayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("failed to bind input", "error", err)
+		return api.NewInvalidRequestError("malformed revocation request")
+	}
+
+	if err := ectx.Validate(payload); err != nil {
+		logger.Warn("failed to validate payload", "error", err)
+		return newInvalidRequestError(payload, err)
+	}
+
+	client, err := authenticateClient(ectx, logger, h.clientAuthenticator, payload.ClientAuthenticationPayload, RouteOAuthRevoke)
+	if err != nil {
 		return err
 	}
 
-	return ectx.Redirect(http.StatusFound, response.RedirectURL)
+	// Só o cliente autenticado pode revogar os próprios tokens.
+	payload.ClientID = client.ClientID
+
+	if err := h.tokenRevocationService.RevokeToken(ectx.Request().Context(), models.NewRevokeTokenInput(payload)); err != nil {
+		oauthErr := mapOAuthError(err, tokenErrorMappings)
+		if oauthErr.Code == api.OAuthErrorServerError {
+			logger.Error("failed to revoke token", "error", err)
+		} else {
+			logger.Warn(err.Error())
+		}
+
+		return oauthErr
+	}
+
+	return ectx.NoContent(http.StatusOK)
 }
 
-func (h *oauthHandler) Token(ectx echo.Context) error {
+// PushedAuthorization recebe os parâmetros do /authorize de um cliente autenticado e
+// devolve o request_uri que os substitui no redirecionamento (RFC 9126).
+func (h *oauthHandler) PushedAuthorization(ectx echo.Context) error {
 	logger := slog.With(
 		slog.String("handler", "oauth"),
 		slog.String("method", ectx.Request().Method),
 		slog.String("path", ectx.Request().URL.Path),
 	)
 
-	var payload models.ExchangeAuthorizationCodePayload
+	ectx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	var payload models.PushedAuthorizationPayload
 	if err := ectx.Bind(&payload); err != nil {
 		logger.Error("failed to bind input", "error", err)
-		return echo.ErrBadRequest
+		return api.NewInvalidRequestError("malformed pushed authorization request")
 	}
 
 	if err := ectx.Validate(payload); err != nil {
-		logger.Error("failed to validate payload", "error", err)
+		logger.Warn("failed to validate payload", "error", err)
+		return newInvalidRequestError(payload, err)
+	}
+
+	client, err := authenticateClient(ectx, logger, h.clientAuthenticator, payload.ClientAuthenticationPayload, RouteOAuthPushedAuthorization)
+	if err != nil {
 		return err
 	}
 
-	input := models.NewExchangeAuthorizationCodeInput(payload)
+	payload.ClientID = client.ClientID
 
-	response, err := h.oauthService.ExchangeCodeForToken(ectx.Request().Context(), input)
+	response, err := h.oauthService.PushAuthorizationRequest(ectx.Request().Context(), models.NewPushedAuthorizationInput(payload))
 	if err != nil {
-		logger.Error("failed to exchange code for token", "error", err)
-		return echo.ErrInternalServerError
+		oauthErr := mapOAuthError(err, pushedAuthorizationErrorMappings)
+		if oauthErr.Code == api.OAuthErrorServerError {
+			logger.Error("failed to push authorization request", "error", err)
+		} else {
+			logger.Warn(err.Error())
+		}
+
+		return oauthErr
 	}
 
-	return ectx.JSON(http.StatusOK, response)
+	return ectx.JSON(http.StatusCreated, response)
+}
+
+// validateDPoPProof valida a prova do header DPoP, quando enviada, e retorna o
+// thumbprint da chave a que os tokens emitidos serão vinculados.
+func validateDPoPProof(ectx echo.Context, logger *slog.Logger, dpopService services.DPoPService, route string) (string, error) {
+	proofs := ectx.Request().Header.Values(models.HeaderDPoP)
+	if len(proofs) == 0 {
+		return "", nil
+	}
+
+	if len(proofs) > 1 {
+		logger.Warn("multiple DPoP proofs")
+		return "", api.NewOAuthError(http.StatusBadRequest, api.OAuthErrorInvalidDPoPProof, "multiple DPoP proofs")
+	}
+
+	jkt, err := dpopService.ValidateProof(ectx.Request().Context(), models.DPoPProofInput{
+		Proof:    proofs[0],
+		Method:   ectx.Request().Method,
+		Endpoint: ectx.Echo().Reverse(route),
+	})
+	if err != nil {
+		oauthErr := mapOAuthError(err, tokenErrorMappings)
+		if oauthErr.Code == api.OAuthErrorServerError {
+			logger.Error("failed to validate DPoP proof", "error", err)
+		} else {
+			logger.Warn(err.Error())
+		}
+
+		return "", oauthErr
+	}
+
+	return jkt, nil
+}
+
+func clientCertificateThumbprint(ectx echo.Context) string {
+	if ectx.Request().TLS == nil || len(ectx.Request().TLS.PeerCertificates) == 0 {
+		return ""
+	}
+
+	return mtls.Thumbprint(ectx.Request().TLS.PeerCertificates[0])
+}
+
+// authenticateClient autentica o cliente com as credenciais do formulário ou do header
+// Authorization, retornando o erro OAuth já pronto para a resposta.
+func authenticateClient(
+	ectx echo.Context,
+	logger *slog.Logger,
+	clientAuthenticator services.ClientAuthenticator,
+	payload models.ClientAuthenticationPayload,
+	route string,
+) (*entities.Client, error) {
+	authInput := models.NewClientAuthenticationInput(payload, ectx.Echo().Reverse(route))
+	authInput.BasicClientID, authInput.BasicClientSecret, authInput.HasBasicAuth = ectx.Request().BasicAuth()
+	if ectx.Request().TLS != nil {
+		authInput.ClientCertificates = ectx.Request().TLS.PeerCertificates
+	}
+
+	client, err := clientAuthenticator.Authenticate(ectx.Request().Context(), authInput)
+	if err != nil {
+		oauthErr := mapOAuthError(err, tokenErrorMappings)
+		if oauthErr.Code == api.OAuthErrorServerError {
+			logger.Error("failed to authenticate client", "error", err)
+		} else {
+			logger.Warn(err.Error())
+		}
+
+		// RFC 6749, seção 5.2: falhas com o esquema Basic devem indicar o esquema esperado.
+		if authInput.HasBasicAuth && oauthErr.Status == http.StatusUnauthorized {
+			ectx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+		}
+
+		return nil, oauthErr
+	}
+
+	return client, nil
 }
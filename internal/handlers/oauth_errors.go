@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/api"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/go-playground/validator/v10"
+)
+
+type oauthErrorMapping struct {
+	err    error
+	status int
+	code   string
+}
+
+var tokenErrorMappings = []oauthErrorMapping{
+	{domain.ErrClientNotFound, http.StatusUnauthorized, api.OAuthErrorInvalidClient},
+	{domain.ErrInvalidClientSecret, http.StatusUnauthorized, api.OAuthErrorInvalidClient},
+	{domain.ErrInvalidClientAssertion, http.StatusUnauthorized, api.OAuthErrorInvalidClient},
+	{domain.ErrClientAuthMethodMismatch, http.StatusUnauthorized, api.OAuthErrorInvalidClient},
+	{domain.ErrInvalidClientCertificate, http.StatusUnauthorized, api.OAuthErrorInvalidClient},
+	{domain.ErrMultipleClientAuthMethods, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrAuthorizationCodeNotFound, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrAuthorizationCodeExpired, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrAuthorizationCodeInvalid, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrAuthorizationCodeReused, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrRefreshTokenNotFound, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrRefreshTokenExpired, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrRefreshTokenRevoked, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrRefreshTokenReused, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrAuthorizationPending, http.StatusBadRequest, api.OAuthErrorAuthorizationPending},
+	{domain.ErrSlowDown, http.StatusBadRequest, api.OAuthErrorSlowDown},
+	{domain.ErrDeviceCodeExpired, http.StatusBadRequest, api.OAuthErrorExpiredToken},
+	{domain.ErrDeviceAccessDenied, http.StatusBadRequest, api.OAuthErrorAccessDenied},
+	{domain.ErrDeviceCodeNotFound, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrInvalidSubjectToken, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrInvalidActorToken, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrUnsupportedTokenType, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrInvalidTarget, http.StatusBadRequest, api.OAuthErrorInvalidTarget},
+	{domain.ErrInvalidResource, http.StatusBadRequest, api.OAuthErrorInvalidTarget},
+	{domain.ErrInvalidDPoPProof, http.StatusBadRequest, api.OAuthErrorInvalidDPoPProof},
+	{domain.ErrDPoPKeyMismatch, http.StatusBadRequest, api.OAuthErrorInvalidDPoPProof},
+	{domain.ErrClientMismatch, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrUnauthorizedRedirectURI, http.StatusBadRequest, api.OAuthErrorInvalidGrant},
+	{domain.ErrUnauthorizedClient, http.StatusBadRequest, api.OAuthErrorUnauthorizedClient},
+	{domain.ErrInvalidScope, http.StatusBadRequest, api.OAuthErrorInvalidScope},
+}
+
+var authorizeErrorMappings = []oauthErrorMapping{
+	{domain.ErrClientNotFound, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrInvalidRedirectURI, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrInvalidGrantType, http.StatusBadRequest, api.OAuthErrorUnauthorizedClient},
+	{domain.ErrInvalidResponseType, http.StatusBadRequest, api.OAuthErrorUnsupportedResponseType},
+	{domain.ErrInvalidScope, http.StatusBadRequest, api.OAuthErrorInvalidScope},
+	{domain.ErrInvalidRequestURI, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrPARRequired, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrInvalidRequestObject, http.StatusBadRequest, api.OAuthErrorInvalidRequestObject},
+	{domain.ErrInvalidResource, http.StatusBadRequest, api.OAuthErrorInvalidTarget},
+	{domain.ErrInvalidPrompt, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrInvalidIDTokenHint, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrLoginRequired, http.StatusBadRequest, api.OAuthErrorLoginRequired},
+	{domain.ErrConsentRequired, http.StatusBadRequest, api.OAuthErrorConsentRequired},
+	{domain.ErrConsentRequestNotFound, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrConsentDenied, http.StatusForbidden, api.OAuthErrorAccessDenied},
+}
+
+// pushedAuthorizationErrorMappings usa os códigos do /authorize; o cliente já foi
+// autenticado antes da validação (RFC 9126, seção 2.3).
+var pushedAuthorizationErrorMappings = []oauthErrorMapping{
+	{domain.ErrInvalidRedirectURI, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+	{domain.ErrInvalidGrantType, http.StatusBadRequest, api.OAuthErrorUnauthorizedClient},
+	{domain.ErrInvalidResponseType, http.StatusBadRequest, api.OAuthErrorUnsupportedResponseType},
+	{domain.ErrInvalidScope, http.StatusBadRequest, api.OAuthErrorInvalidScope},
+	{domain.ErrInvalidResource, http.StatusBadRequest, api.OAuthErrorInvalidTarget},
+	{domain.ErrInvalidPrompt, http.StatusBadRequest, api.OAuthErrorInvalidRequest},
+}
+
+// mapOAuthError converte um erro de domínio no erro OAuth correspondente.
+// Erros sem mapeamento viram server_error, sem expor detalhes internos.
+func mapOAuthError(err error, mappings []oauthErrorMapping) *api.OAuthError {
+	for _, mapping := range mappings {
+		if errors.Is(err, mapping.err) {
+			return api.NewOAuthError(mapping.status, mapping.code, mapping.err.Error())
+		}
+	}
+
+	return api.NewServerError()
+}
+
+// newInvalidRequestError descreve o primeiro parâmetro inválido usando o nome
+// dele na requisição (tag query ou form) em vez do nome do campo Go.
+func newInvalidRequestError(payload any, err error) *api.OAuthError {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) == 0 {
+		return api.NewInvalidRequestError("malformed request")
+	}
+
+	name := validationErrs[0].StructField()
+	if field, ok := reflect.TypeOf(payload).FieldByName(name); ok {
+		for _, tag := range []string{"query", "form"} {
+			if value := field.Tag.Get(tag); value != "" {
+				name = value
+				break
+			}
+		}
+	}
+
+	return api.NewInvalidRequestError(fmt.Sprintf("missing or invalid parameter: %s", name))
+}
+
+func authorizationErrorRedirectURL(redirectErr *domain.ErrAuthorizationRedirect, oauthErr *api.OAuthError) (string, error) {
+	redirectURL, err := url.Parse(redirectErr.RedirectURI)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect uri: %w", err)
+	}
+
+	query := redirectURL.Query()
+	query.Set("error", oauthErr.Code)
+	if oauthErr.Description != "" {
+		query.Set("error_description", oauthErr.Description)
+	}
+	if redirectErr.State != "" {
+		query.Set("state", redirectErr.State)
+	}
+
+	redirectURL.RawQuery = query.Encode()
+
+	return redirectURL.String(), nil
+}
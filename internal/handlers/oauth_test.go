@@ -1,16 +1,22 @@
 package handlers
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/aetheris-lab/aetheris-id/api/internal/api"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/mtls"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -52,7 +58,7 @@ func TestAuthorize(t *testing.T) {
 		c.Set(userIDKey, userID)
 
 		mockOAuthService := mocks.NewOAuthServiceMock(t)
-		handler := NewOAuthHandler(mockOAuthService)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
 
 		expectedResponse := &models.AuthorizeResponse{
 			RedirectURL: "http://localhost/callback?code=123456&state=xyz",
@@ -80,16 +86,17 @@ func TestAuthorize(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		mockOAuthService := mocks.NewOAuthServiceMock(t)
-		handler := NewOAuthHandler(mockOAuthService)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
 
 		// Act
 		err := handler.Authorize(c)
 
 		// Assert
 		require.Error(t, err)
-		httpErr, ok := err.(*echo.HTTPError)
+		oauthErr, ok := err.(*api.OAuthError)
 		require.True(t, ok)
-		assert.Equal(t, echo.ErrBadRequest.Code, httpErr.Code)
+		assert.Equal(t, http.StatusBadRequest, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorInvalidRequest, oauthErr.Code)
 	})
 
 	t.Run("should return bad request when validation fails", func(t *testing.T) {
@@ -105,25 +112,32 @@ func TestAuthorize(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		mockOAuthService := mocks.NewOAuthServiceMock(t)
-		handler := NewOAuthHandler(mockOAuthService)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
 
 		// Act
 		err := handler.Authorize(c)
 
 		// Assert
 		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidRequest, oauthErr.Code)
+		assert.Equal(t, "missing or invalid parameter: client_id", oauthErr.Description)
 	})
 
 	testCases := []struct {
-		name          string
-		serviceError  error
-		expectedError *echo.HTTPError
+		name         string
+		serviceError error
+		expectedCode string
 	}{
-		{"should return bad request when client is not found", domain.ErrClientNotFound, echo.ErrBadRequest},
-		{"should return bad request when redirect uri is invalid", domain.ErrInvalidRedirectURI, echo.ErrBadRequest},
-		{"should return bad request when grant type is invalid", domain.ErrInvalidGrantType, echo.ErrBadRequest},
-		{"should return bad request when response type is invalid", domain.ErrInvalidResponseType, echo.ErrBadRequest},
-		{"should return bad request when scope is invalid", domain.ErrInvalidScope, echo.ErrBadRequest},
+		{"should return invalid_request when client is not found", domain.ErrClientNotFound, api.OAuthErrorInvalidRequest},
+		{"should return invalid_request when redirect uri is invalid", domain.ErrInvalidRedirectURI, api.OAuthErrorInvalidRequest},
+		{"should return unauthorized_client when grant type is invalid", domain.ErrInvalidGrantType, api.OAuthErrorUnauthorizedClient},
+		{"should return unsupported_response_type when response type is invalid", domain.ErrInvalidResponseType, api.OAuthErrorUnsupportedResponseType},
+		{"should return invalid_scope when scope is invalid", domain.ErrInvalidScope, api.OAuthErrorInvalidScope},
+		{"should return invalid_request when request_uri is invalid", domain.ErrInvalidRequestURI, api.OAuthErrorInvalidRequest},
+		{"should return invalid_request_object when request object is invalid", domain.ErrInvalidRequestObject, api.OAuthErrorInvalidRequestObject},
+		{"should return invalid_target when resource is not registered", domain.ErrInvalidResource, api.OAuthErrorInvalidTarget},
 	}
 
 	for _, tc := range testCases {
@@ -149,7 +163,7 @@ func TestAuthorize(t *testing.T) {
 			c.Set(userIDKey, userID)
 
 			mockOAuthService := mocks.NewOAuthServiceMock(t)
-			handler := NewOAuthHandler(mockOAuthService)
+			handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
 
 			mockOAuthService.EXPECT().
 				Authorize(mock.Anything, mock.AnythingOfType("models.AuthorizeInput")).
@@ -160,13 +174,188 @@ func TestAuthorize(t *testing.T) {
 
 			// Assert
 			require.Error(t, err)
-			httpErr, ok := err.(*echo.HTTPError)
+			oauthErr, ok := err.(*api.OAuthError)
 			require.True(t, ok)
-			assert.Equal(t, tc.expectedError.Code, httpErr.Code)
+			assert.Equal(t, http.StatusBadRequest, oauthErr.Status)
+			assert.Equal(t, tc.expectedCode, oauthErr.Code)
 		})
 	}
 
-	t.Run("should return internal server error for other service errors", func(t *testing.T) {
+	t.Run("should accept only client_id and request_uri from a pushed authorization request", func(t *testing.T) {
+		// Arrange
+		query := url.Values{}
+		query.Set("client_id", "test-client-id")
+		query.Set("request_uri", models.RequestURIPrefix+"reference")
+
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
+
+		mockOAuthService.EXPECT().
+			Authorize(mock.Anything, mock.MatchedBy(func(input models.AuthorizeInput) bool {
+				return input.ClientID == "test-client-id" && input.RequestURI == models.RequestURIPrefix+"reference"
+			})).
+			Return(&models.AuthorizeResponse{RedirectURL: "https://example.com/login"}, nil).Once()
+
+		// Act
+		err := handler.Authorize(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, rec.Code)
+	})
+
+	t.Run("should accept only client_id and a signed request object", func(t *testing.T) {
+		// Arrange
+		query := url.Values{}
+		query.Set("client_id", "test-client-id")
+		query.Set("request", "signed.request.object")
+
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
+
+		mockOAuthService.EXPECT().
+			Authorize(mock.Anything, mock.MatchedBy(func(input models.AuthorizeInput) bool {
+				return input.RequestObject == "signed.request.object"
+			})).
+			Return(&models.AuthorizeResponse{RedirectURL: "https://example.com/login"}, nil).Once()
+
+		// Act
+		err := handler.Authorize(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, rec.Code)
+	})
+
+	t.Run("should return invalid_request when request and request_uri are both sent", func(t *testing.T) {
+		// Arrange
+		query := url.Values{}
+		query.Set("client_id", "test-client-id")
+		query.Set("request", "signed.request.object")
+		query.Set("request_uri", models.RequestURIPrefix+"reference")
+
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		handler := NewOAuthHandler(nil, nil, nil, nil, nil, nil)
+
+		// Act
+		err := handler.Authorize(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, "missing or invalid parameter: request", oauthErr.Description)
+	})
+
+	t.Run("should redirect error to client when redirect uri is trusted", func(t *testing.T) {
+		// Arrange
+		payload := models.AuthorizePayload{
+			ClientID:            "test-client-id",
+			RedirectURI:         "http://localhost/callback",
+			ResponseType:        "code",
+			Scope:               "admin",
+			State:               "xyz",
+			CodeChallenge:       "test-challenge",
+			CodeChallengeMethod: "S256",
+		}
+		jsonPayload, _ := json.Marshal(payload)
+
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(jsonPayload)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set(userIDKey, userID)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
+
+		mockOAuthService.EXPECT().
+			Authorize(mock.Anything, mock.AnythingOfType("models.AuthorizeInput")).
+			Return(nil, &domain.ErrAuthorizationRedirect{
+				RedirectURI: "http://localhost/callback",
+				State:       "xyz",
+				Err:         domain.ErrInvalidScope,
+			}).Once()
+
+		// Act
+		err := handler.Authorize(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, rec.Code)
+
+		location, err := url.Parse(rec.Header().Get(echo.HeaderLocation))
+		require.NoError(t, err)
+		assert.Equal(t, "localhost", location.Host)
+		assert.Equal(t, "/callback", location.Path)
+		assert.Equal(t, api.OAuthErrorInvalidScope, location.Query().Get("error"))
+		assert.Equal(t, "xyz", location.Query().Get("state"))
+	})
+
+	t.Run("should redirect login_required to client on silent authentication", func(t *testing.T) {
+		// Arrange
+		query := url.Values{}
+		query.Set("client_id", "test-client-id")
+		query.Set("redirect_uri", "http://localhost/callback")
+		query.Set("response_type", "code")
+		query.Set("scope", "openid")
+		query.Set("state", "xyz")
+		query.Set("code_challenge", "test-challenge")
+		query.Set("code_challenge_method", "S256")
+		query.Set("prompt", "none")
+		query.Set("max_age", "300")
+
+		e := echo.New()
+		e.Validator = &customValidator{validator: validator.New()}
+		req := httptest.NewRequest(http.MethodGet, "/?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
+
+		mockOAuthService.EXPECT().
+			Authorize(mock.Anything, mock.MatchedBy(func(input models.AuthorizeInput) bool {
+				return input.HasPrompt(models.PromptNone) && input.MaxAge != nil && *input.MaxAge == 300
+			})).
+			Return(nil, &domain.ErrAuthorizationRedirect{
+				RedirectURI: "http://localhost/callback",
+				State:       "xyz",
+				Err:         domain.ErrLoginRequired,
+			}).Once()
+
+		// Act
+		err := handler.Authorize(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, rec.Code)
+
+		location, err := url.Parse(rec.Header().Get(echo.HeaderLocation))
+		require.NoError(t, err)
+		assert.Equal(t, api.OAuthErrorLoginRequired, location.Query().Get("error"))
+		assert.Equal(t, "xyz", location.Query().Get("state"))
+	})
+
+	t.Run("should return server_error for other service errors", func(t *testing.T) {
 		// Arrange
 		payload := models.AuthorizePayload{
 			ClientID:            "test-client-id",
@@ -188,7 +377,7 @@ func TestAuthorize(t *testing.T) {
 		c.Set(userIDKey, userID)
 
 		mockOAuthService := mocks.NewOAuthServiceMock(t)
-		handler := NewOAuthHandler(mockOAuthService)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
 
 		expectedErr := errors.New("some unexpected error")
 		mockOAuthService.EXPECT().
@@ -200,6 +389,760 @@ func TestAuthorize(t *testing.T) {
 
 		// Assert
 		require.Error(t, err)
-		assert.Equal(t, err, expectedErr)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorServerError, oauthErr.Code)
+		assert.Empty(t, oauthErr.Description)
+	})
+}
+
+func newFormContext(form url.Values) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	e.Validator = &customValidator{validator: validator.New()}
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+// newClientAuthenticatorMock simula a autenticação bem-sucedida do cliente informado.
+func newClientAuthenticatorMock(t *testing.T, clientID string) *mocks.ClientAuthenticatorMock {
+	mockClientAuthenticator := mocks.NewClientAuthenticatorMock(t)
+	mockClientAuthenticator.EXPECT().
+		Authenticate(mock.Anything, mock.MatchedBy(func(input models.ClientAuthenticationInput) bool {
+			return input.ClientID == clientID
+		})).
+		Return(&entities.Client{ClientID: clientID}, nil).Once()
+
+	return mockClientAuthenticator
+}
+
+func TestToken(t *testing.T) {
+	t.Run("should exchange authorization code when grant type is authorization_code", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "authorization_code")
+		form.Set("code", "auth-code")
+		form.Set("code_verifier", "verifier")
+		form.Set("client_id", "client-id")
+		form.Set("redirect_uri", "http://localhost/callback")
+		c, rec := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			ExchangeCodeForToken(mock.Anything, models.ExchangeAuthorizationCodeInput{
+				Code:         "auth-code",
+				CodeVerifier: "verifier",
+				ClientID:     "client-id",
+				RedirectURI:  "http://localhost/callback",
+			}).
+			Return(&models.TokenResponse{AccessToken: "access-token", TokenType: "Bearer"}, nil).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "access-token")
+		assert.Equal(t, "no-store", rec.Header().Get(echo.HeaderCacheControl))
+	})
+
+	t.Run("should refresh access token when grant type is refresh_token", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", "refresh-token")
+		form.Set("client_id", "client-id")
+		form.Set("scope", "openid profile:read")
+		c, rec := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			RefreshAccessToken(mock.Anything, models.RefreshTokenInput{
+				RefreshToken: "refresh-token",
+				ClientID:     "client-id",
+				Scope:        []string{"openid", "profile:read"},
+			}).
+			Return(&models.TokenResponse{AccessToken: "access-token", TokenType: "Bearer"}, nil).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should issue client token when grant type is client_credentials", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", "batch-job")
+		form.Set("client_secret", "s3cret")
+		form.Set("scope", "users:read")
+		c, rec := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			ClientCredentials(mock.Anything, models.ClientCredentialsInput{
+				ClientID: "batch-job",
+				Scope:    []string{"users:read"},
+			}).
+			Return(&models.TokenResponse{AccessToken: "access-token", TokenType: "Bearer"}, nil).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should return invalid_target when requested resource is not registered", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", "batch-job")
+		form.Add("resource", "https://users.aetheris-lab.com")
+		form.Add("resource", "https://unknown.aetheris-lab.com")
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			ClientCredentials(mock.Anything, models.ClientCredentialsInput{
+				ClientID: "batch-job",
+				Scope:    []string{},
+				Resource: []string{"https://users.aetheris-lab.com", "https://unknown.aetheris-lab.com"},
+			}).
+			Return(nil, domain.ErrInvalidResource).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorInvalidTarget, oauthErr.Code)
+	})
+
+	t.Run("should return invalid_client with Basic challenge when client secret is wrong", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		c, rec := newFormContext(form)
+		c.Request().SetBasicAuth("batch-job", "wrong")
+
+		mockClientAuthenticator := mocks.NewClientAuthenticatorMock(t)
+		handler := NewOAuthHandler(mocks.NewOAuthServiceMock(t), nil, nil, mockClientAuthenticator, nil, nil)
+
+		mockClientAuthenticator.EXPECT().
+			Authenticate(mock.Anything, mock.MatchedBy(func(input models.ClientAuthenticationInput) bool {
+				return input.HasBasicAuth && input.BasicClientID == "batch-job" && input.BasicClientSecret == "wrong"
+			})).
+			Return(nil, domain.ErrInvalidClientSecret).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorInvalidClient, oauthErr.Code)
+		assert.Equal(t, `Basic realm="oauth"`, rec.Header().Get(echo.HeaderWWWAuthenticate))
+	})
+
+	t.Run("should use the client authenticated by the authorization header", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		c, _ := newFormContext(form)
+		c.Request().SetBasicAuth("batch-job", "s3cret")
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		mockClientAuthenticator := mocks.NewClientAuthenticatorMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, mockClientAuthenticator, nil, nil)
+
+		mockClientAuthenticator.EXPECT().
+			Authenticate(mock.Anything, mock.AnythingOfType("models.ClientAuthenticationInput")).
+			Return(&entities.Client{ClientID: "batch-job"}, nil).Once()
+		mockOAuthService.EXPECT().
+			ClientCredentials(mock.Anything, models.ClientCredentialsInput{ClientID: "batch-job", Scope: []string{}}).
+			Return(&models.TokenResponse{AccessToken: "access-token", TokenType: "Bearer"}, nil).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should bind the token to the certificate of a client authenticated by mTLS", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", "orders")
+		c, _ := newFormContext(form)
+		certificate := &x509.Certificate{Raw: []byte("client-certificate")}
+		c.Request().TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}}
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		mockClientAuthenticator := mocks.NewClientAuthenticatorMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, mockClientAuthenticator, nil, nil)
+
+		mockClientAuthenticator.EXPECT().
+			Authenticate(mock.Anything, mock.MatchedBy(func(input models.ClientAuthenticationInput) bool {
+				return input.ClientID == "orders" && len(input.ClientCertificates) == 1
+			})).
+			Return(&entities.Client{ClientID: "orders", TokenEndpointAuthMethod: "tls_client_auth"}, nil).Once()
+		mockOAuthService.EXPECT().
+			ClientCredentials(mock.Anything, models.ClientCredentialsInput{ClientID: "orders", Scope: []string{}, CertificateThumbprint: mtls.Thumbprint(certificate)}).
+			Return(&models.TokenResponse{AccessToken: "access-token", TokenType: "Bearer"}, nil).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should bind the token to the key of a valid DPoP proof", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", "batch-job")
+		c, _ := newFormContext(form)
+		c.Request().Header.Set(models.HeaderDPoP, "dpop-proof")
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		mockDPoPService := mocks.NewDPoPServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, mockDPoPService)
+
+		mockDPoPService.EXPECT().
+			ValidateProof(mock.Anything, mock.MatchedBy(func(input models.DPoPProofInput) bool {
+				return input.Proof == "dpop-proof" && input.Method == http.MethodPost && input.AccessToken == ""
+			})).
+			Return("key-thumbprint", nil).Once()
+		mockOAuthService.EXPECT().
+			ClientCredentials(mock.Anything, models.ClientCredentialsInput{ClientID: "batch-job", Scope: []string{}, DPoPJKT: "key-thumbprint"}).
+			Return(&models.TokenResponse{AccessToken: "access-token", TokenType: models.TokenTypeDPoP}, nil).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should return invalid_dpop_proof when the DPoP proof is rejected", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", "batch-job")
+		c, _ := newFormContext(form)
+		c.Request().Header.Set(models.HeaderDPoP, "dpop-proof")
+
+		mockDPoPService := mocks.NewDPoPServiceMock(t)
+		handler := NewOAuthHandler(mocks.NewOAuthServiceMock(t), nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, mockDPoPService)
+
+		mockDPoPService.EXPECT().
+			ValidateProof(mock.Anything, mock.AnythingOfType("models.DPoPProofInput")).
+			Return("", domain.ErrInvalidDPoPProof).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorInvalidDPoPProof, oauthErr.Code)
+	})
+
+	t.Run("should return invalid_grant when refresh token is reused", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", "refresh-token")
+		form.Set("client_id", "client-id")
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			RefreshAccessToken(mock.Anything, mock.AnythingOfType("models.RefreshTokenInput")).
+			Return(nil, domain.ErrRefreshTokenReused).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorInvalidGrant, oauthErr.Code)
+	})
+
+	t.Run("should return invalid_grant when authorization code is reused", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "authorization_code")
+		form.Set("code", "auth-code")
+		form.Set("code_verifier", "verifier")
+		form.Set("client_id", "client-id")
+		form.Set("redirect_uri", "http://localhost/callback")
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			ExchangeCodeForToken(mock.Anything, mock.AnythingOfType("models.ExchangeAuthorizationCodeInput")).
+			Return(nil, domain.ErrAuthorizationCodeReused).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidGrant, oauthErr.Code)
+	})
+
+	t.Run("should return invalid_request when required parameter is missing", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "refresh_token")
+		form.Set("client_id", "client-id")
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidRequest, oauthErr.Code)
+		assert.Equal(t, "missing or invalid parameter: refresh_token", oauthErr.Description)
+	})
+
+	t.Run("should return invalid_client when client is not found", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", "refresh-token")
+		form.Set("client_id", "unknown-client")
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			RefreshAccessToken(mock.Anything, mock.AnythingOfType("models.RefreshTokenInput")).
+			Return(nil, domain.ErrClientNotFound).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorInvalidClient, oauthErr.Code)
+	})
+
+	t.Run("should return authorization_pending while the device code awaits approval", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
+		form.Set("device_code", "device-code")
+		form.Set("client_id", "tv-app")
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), nil, nil)
+
+		mockOAuthService.EXPECT().
+			ExchangeDeviceCode(mock.Anything, models.DeviceCodeInput{DeviceCode: "device-code", ClientID: "tv-app"}).
+			Return(nil, domain.ErrAuthorizationPending).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorAuthorizationPending, oauthErr.Code)
+	})
+
+	t.Run("should exchange the subject token when grant type is token-exchange", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "urn:ietf:params:oauth:grant-type:token-exchange")
+		form.Set("subject_token", "subject-token")
+		form.Set("subject_token_type", "urn:ietf:params:oauth:token-type:access_token")
+		form.Add("audience", "https://payments.internal")
+		form.Add("audience", "https://ledger.internal")
+		form.Set("client_id", "orders-api")
+		c, rec := newFormContext(form)
+
+		mockTokenExchangeService := mocks.NewTokenExchangeServiceMock(t)
+		handler := NewOAuthHandler(nil, nil, nil, newClientAuthenticatorMock(t, form.Get("client_id")), mockTokenExchangeService, nil)
+
+		mockTokenExchangeService.EXPECT().
+			Exchange(mock.Anything, models.TokenExchangeInput{
+				ClientID:         "orders-api",
+				SubjectToken:     "subject-token",
+				SubjectTokenType: "urn:ietf:params:oauth:token-type:access_token",
+				Audience:         []string{"https://payments.internal", "https://ledger.internal"},
+				Scope:            []string{},
+			}).
+			Return(&models.TokenResponse{AccessToken: "exchanged-token", TokenType: "Bearer", IssuedTokenType: models.TokenTypeAccessToken}, nil).Once()
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"issued_token_type":"urn:ietf:params:oauth:token-type:access_token"`)
+	})
+
+	t.Run("should return invalid_request when subject token is missing", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "urn:ietf:params:oauth:grant-type:token-exchange")
+		form.Set("subject_token_type", "urn:ietf:params:oauth:token-type:access_token")
+		c, _ := newFormContext(form)
+
+		handler := NewOAuthHandler(nil, nil, nil, nil, nil, nil)
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, "missing or invalid parameter: subject_token", oauthErr.Description)
+	})
+
+	t.Run("should return unsupported_grant_type when grant type is not supported", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("grant_type", "password")
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, nil, nil, nil)
+
+		// Act
+		err := handler.Token(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorUnsupportedGrantType, oauthErr.Code)
+	})
+}
+
+func TestIntrospect(t *testing.T) {
+	t.Run("should return token metadata from the introspection service", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("token", "access-token")
+		form.Set("token_type_hint", "access_token")
+		c, rec := newFormContext(form)
+
+		mockIntrospectionService := mocks.NewTokenIntrospectionServiceMock(t)
+		handler := NewOAuthHandler(nil, mockIntrospectionService, nil, nil, nil, nil)
+
+		mockIntrospectionService.EXPECT().
+			Introspect(mock.Anything, models.IntrospectTokenInput{Token: "access-token", TokenTypeHint: "access_token"}).
+			Return(&models.IntrospectionResponse{Active: true, ClientID: "client-id", Scope: "openid"}, nil).Once()
+
+		// Act
+		err := handler.Introspect(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "no-store", rec.Header().Get(echo.HeaderCacheControl))
+
+		var response models.IntrospectionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.True(t, response.Active)
+		assert.Equal(t, "client-id", response.ClientID)
+	})
+
+	t.Run("should return invalid_request when token is missing", func(t *testing.T) {
+		// Arrange
+		c, _ := newFormContext(url.Values{})
+		handler := NewOAuthHandler(nil, mocks.NewTokenIntrospectionServiceMock(t), nil, nil, nil, nil)
+
+		// Act
+		err := handler.Introspect(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidRequest, oauthErr.Code)
+		assert.Contains(t, oauthErr.Description, "token")
+	})
+
+	t.Run("should return server_error when introspection fails", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("token", "access-token")
+		c, _ := newFormContext(form)
+
+		mockIntrospectionService := mocks.NewTokenIntrospectionServiceMock(t)
+		handler := NewOAuthHandler(nil, mockIntrospectionService, nil, nil, nil, nil)
+
+		mockIntrospectionService.EXPECT().
+			Introspect(mock.Anything, mock.AnythingOfType("models.IntrospectTokenInput")).
+			Return(nil, errors.New("database error")).Once()
+
+		// Act
+		err := handler.Introspect(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorServerError, oauthErr.Code)
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	t.Run("should return ok when token is revoked", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("token", "refresh-token")
+		form.Set("token_type_hint", "refresh_token")
+		form.Set("client_id", "client-id")
+		form.Set("client_secret", "secret")
+		c, rec := newFormContext(form)
+
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		handler := NewOAuthHandler(nil, nil, mockRevocationService, newClientAuthenticatorMock(t, "client-id"), nil, nil)
+
+		mockRevocationService.EXPECT().
+			RevokeToken(mock.Anything, models.RevokeTokenInput{Token: "refresh-token", TokenTypeHint: "refresh_token", ClientID: "client-id"}).
+			Return(nil).Once()
+
+		// Act
+		err := handler.Revoke(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("should revoke on behalf of the client authenticated with Basic", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("token", "refresh-token")
+		form.Set("client_id", "victim-client")
+		c, rec := newFormContext(form)
+		c.Request().SetBasicAuth("client-id", "secret")
+
+		mockClientAuthenticator := mocks.NewClientAuthenticatorMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		handler := NewOAuthHandler(nil, nil, mockRevocationService, mockClientAuthenticator, nil, nil)
+
+		mockClientAuthenticator.EXPECT().
+			Authenticate(mock.Anything, mock.AnythingOfType("models.ClientAuthenticationInput")).
+			Return(&entities.Client{ClientID: "client-id"}, nil).Once()
+		mockRevocationService.EXPECT().
+			RevokeToken(mock.Anything, models.RevokeTokenInput{Token: "refresh-token", ClientID: "client-id"}).
+			Return(nil).Once()
+
+		// Act
+		err := handler.Revoke(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should return invalid_client when client authentication fails", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("token", "refresh-token")
+		form.Set("client_id", "client-id")
+		form.Set("client_secret", "wrong-secret")
+		c, _ := newFormContext(form)
+
+		mockClientAuthenticator := mocks.NewClientAuthenticatorMock(t)
+		handler := NewOAuthHandler(nil, nil, mocks.NewTokenRevocationServiceMock(t), mockClientAuthenticator, nil, nil)
+
+		mockClientAuthenticator.EXPECT().
+			Authenticate(mock.Anything, mock.AnythingOfType("models.ClientAuthenticationInput")).
+			Return(nil, domain.ErrInvalidClientSecret).Once()
+
+		// Act
+		err := handler.Revoke(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidClient, oauthErr.Code)
+	})
+
+	t.Run("should return invalid_request when token is missing", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("client_id", "client-id")
+		c, _ := newFormContext(form)
+
+		handler := NewOAuthHandler(nil, nil, mocks.NewTokenRevocationServiceMock(t), mocks.NewClientAuthenticatorMock(t), nil, nil)
+
+		// Act
+		err := handler.Revoke(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidRequest, oauthErr.Code)
+		assert.Contains(t, oauthErr.Description, "token")
+	})
+
+	t.Run("should return invalid_grant when token belongs to another client", func(t *testing.T) {
+		// Arrange
+		form := url.Values{}
+		form.Set("token", "refresh-token")
+		form.Set("client_id", "client-id")
+		c, _ := newFormContext(form)
+
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		handler := NewOAuthHandler(nil, nil, mockRevocationService, newClientAuthenticatorMock(t, "client-id"), nil, nil)
+
+		mockRevocationService.EXPECT().
+			RevokeToken(mock.Anything, mock.AnythingOfType("models.RevokeTokenInput")).
+			Return(domain.ErrClientMismatch).Once()
+
+		// Act
+		err := handler.Revoke(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidGrant, oauthErr.Code)
+	})
+}
+
+func TestPushedAuthorization(t *testing.T) {
+	newPushedForm := func() url.Values {
+		form := url.Values{}
+		form.Set("client_id", "web-app")
+		form.Set("redirect_uri", "https://example.com/callback")
+		form.Set("response_type", "code")
+		form.Set("scope", "openid profile")
+		form.Set("state", "xyz")
+		form.Set("code_challenge", "test-challenge")
+		form.Set("code_challenge_method", "S256")
+		return form
+	}
+
+	t.Run("should return created with the request_uri", func(t *testing.T) {
+		// Arrange
+		form := newPushedForm()
+		c, rec := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, "web-app"), nil, nil)
+
+		mockOAuthService.EXPECT().
+			PushAuthorizationRequest(mock.Anything, models.AuthorizeInput{
+				ClientID:            "web-app",
+				RedirectURI:         "https://example.com/callback",
+				ResponseType:        "code",
+				CodeChallenge:       "test-challenge",
+				CodeChallengeMethod: "S256",
+				Scope:               []string{"openid", "profile"},
+				State:               "xyz",
+			}).
+			Return(&models.PushedAuthorizationResponse{RequestURI: models.RequestURIPrefix + "reference", ExpiresIn: 60}, nil).Once()
+
+		// Act
+		err := handler.PushedAuthorization(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"request_uri":"urn:ietf:params:oauth:request_uri:reference","expires_in":60}`, rec.Body.String())
+	})
+
+	t.Run("should return invalid_request when request_uri is sent", func(t *testing.T) {
+		// Arrange
+		form := newPushedForm()
+		form.Set("request_uri", models.RequestURIPrefix+"reference")
+		c, _ := newFormContext(form)
+
+		handler := NewOAuthHandler(nil, nil, nil, nil, nil, nil)
+
+		// Act
+		err := handler.PushedAuthorization(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, api.OAuthErrorInvalidRequest, oauthErr.Code)
+		assert.Equal(t, "missing or invalid parameter: request_uri", oauthErr.Description)
+	})
+
+	t.Run("should return invalid_scope when scope is not allowed", func(t *testing.T) {
+		// Arrange
+		form := newPushedForm()
+		c, _ := newFormContext(form)
+
+		mockOAuthService := mocks.NewOAuthServiceMock(t)
+		handler := NewOAuthHandler(mockOAuthService, nil, nil, newClientAuthenticatorMock(t, "web-app"), nil, nil)
+
+		mockOAuthService.EXPECT().
+			PushAuthorizationRequest(mock.Anything, mock.AnythingOfType("models.AuthorizeInput")).
+			Return(nil, domain.ErrInvalidScope).Once()
+
+		// Act
+		err := handler.PushedAuthorization(c)
+
+		// Assert
+		require.Error(t, err)
+		oauthErr, ok := err.(*api.OAuthError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, oauthErr.Status)
+		assert.Equal(t, api.OAuthErrorInvalidScope, oauthErr.Code)
 	})
 }
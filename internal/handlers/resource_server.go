@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type ResourceServerHandler interface {
+	CreateResourceServer(ectx echo.Context) error
+}
+
+type resourceServerHandler struct {
+	resourceServerService services.ResourceServerService
+}
+
+func NewResourceServerHandler(resourceServerService services.ResourceServerService) ResourceServerHandler {
+	return &resourceServerHandler{
+		resourceServerService: resourceServerService,
+	}
+}
+
+func (h *resourceServerHandler) CreateResourceServer(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "resource_server"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	var payload models.CreateResourceServerPayload
+	if err := ectx.Bind(&payload); err != nil {
+		logger.Error("bind payload", "error", err)
+		return echo.ErrBadRequest
+	}
+
+	if err := ectx.Validate(&payload); err != nil {
+		logger.Error("validate payload", "error", err)
+		return err
+	}
+
+	response, err := h.resourceServerService.CreateResourceServer(ectx.Request().Context(), models.NewCreateResourceServerInput(payload))
+	if err != nil {
+		if errors.Is(err, domain.ErrResourceServerAlreadyExists) {
+			logger.Error(err.Error())
+			return echo.ErrConflict
+		}
+
+		if errors.Is(err, domain.ErrInvalidResourceServer) {
+			logger.Warn(err.Error())
+			return echo.ErrBadRequest
+		}
+
+		logger.Error("create resource server", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.JSON(http.StatusCreated, response)
+}
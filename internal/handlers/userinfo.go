@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+type UserInfoHandler interface {
+	UserInfo(ectx echo.Context) error
+}
+
+type userInfoHandler struct {
+	userInfoService services.UserInfoService
+}
+
+func NewUserInfoHandler(userInfoService services.UserInfoService) UserInfoHandler {
+	return &userInfoHandler{
+		userInfoService: userInfoService,
+	}
+}
+
+// UserInfo atende GET e POST (OIDC Core, seção 5.3.1); o access token já foi
+// validado por EnsureBearerScope("openid").
+func (h *userInfoHandler) UserInfo(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "userinfo"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	claims, err := middlewares.GetUserClaims(ectx)
+	if err != nil {
+		return echo.ErrUnauthorized
+	}
+
+	if !claims.HasUser() {
+		logger.Warn("access token does not identify a user", "client_id", claims.ClientID)
+		ectx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+		return echo.ErrUnauthorized
+	}
+
+	response, err := h.userInfoService.GetUserInfo(ectx.Request().Context(), models.UserInfoInput{
+		UserID:   claims.Subject,
+		ClientID: claims.ClientID,
+		Scopes:   scopes.ParseScopes(claims.Scope),
+	})
+	if err != nil {
+		// Tokens de usuários e clientes removidos não identificam mais um usuário.
+		if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrInvalidObjectID) || errors.Is(err, domain.ErrClientNotFound) {
+			logger.Warn(err.Error())
+			ectx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+			return echo.ErrUnauthorized
+		}
+
+		logger.Error("get userinfo", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	if response.JWT != "" {
+		return ectx.Blob(http.StatusOK, models.ContentTypeJWT, []byte(response.JWT))
+	}
+
+	return ectx.JSON(http.StatusOK, response.Claims)
+}
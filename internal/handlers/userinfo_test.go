@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newUserInfoContext(claims *models.AccessTokenClaims) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/oauth/userinfo", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	middlewares.SetUserClaims(c, claims)
+
+	return c, rec
+}
+
+func TestUserInfo(t *testing.T) {
+	claims := &models.AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"},
+		ClientID:         "web-app",
+		Scope:            "openid profile",
+	}
+	input := models.UserInfoInput{UserID: "user-id", ClientID: "web-app", Scopes: []string{"openid", "profile"}}
+
+	t.Run("should return the claims as json", func(t *testing.T) {
+		// Arrange
+		c, rec := newUserInfoContext(claims)
+		mockUserInfoService := mocks.NewUserInfoServiceMock(t)
+		handler := NewUserInfoHandler(mockUserInfoService)
+
+		mockUserInfoService.EXPECT().GetUserInfo(c.Request().Context(), input).Return(&models.UserInfoResponse{
+			Claims: &models.UserInfoClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id"}, Name: "Ada Lovelace"},
+		}, nil)
+
+		// Act
+		err := handler.UserInfo(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, map[string]any{"sub": "user-id", "name": "Ada Lovelace"}, body)
+	})
+
+	t.Run("should return a signed jwt when the client registered an algorithm", func(t *testing.T) {
+		// Arrange
+		c, rec := newUserInfoContext(claims)
+		mockUserInfoService := mocks.NewUserInfoServiceMock(t)
+		handler := NewUserInfoHandler(mockUserInfoService)
+
+		mockUserInfoService.EXPECT().GetUserInfo(c.Request().Context(), input).Return(&models.UserInfoResponse{JWT: "signed-userinfo"}, nil)
+
+		// Act
+		err := handler.UserInfo(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, models.ContentTypeJWT, rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "signed-userinfo", rec.Body.String())
+	})
+
+	t.Run("should return invalid_token when the token does not identify a user", func(t *testing.T) {
+		// Arrange
+		c, rec := newUserInfoContext(claims)
+		mockUserInfoService := mocks.NewUserInfoServiceMock(t)
+		handler := NewUserInfoHandler(mockUserInfoService)
+
+		mockUserInfoService.EXPECT().GetUserInfo(c.Request().Context(), input).Return(nil, domain.ErrUserNotFound)
+
+		// Act
+		err := handler.UserInfo(c)
+
+		// Assert
+		assert.Equal(t, echo.ErrUnauthorized, err)
+		assert.Equal(t, `Bearer error="invalid_token"`, rec.Header().Get(echo.HeaderWWWAuthenticate))
+	})
+
+	t.Run("should return invalid_token for a client_credentials token", func(t *testing.T) {
+		// Arrange
+		c, rec := newUserInfoContext(&models.AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "batch-job"},
+			ClientID:         "batch-job",
+			Scope:            "openid",
+			SubjectType:      models.SubjectTypeClient,
+		})
+		handler := NewUserInfoHandler(mocks.NewUserInfoServiceMock(t))
+
+		// Act
+		err := handler.UserInfo(c)
+
+		// Assert
+		assert.Equal(t, echo.ErrUnauthorized, err)
+		assert.Equal(t, `Bearer error="invalid_token"`, rec.Header().Get(echo.HeaderWWWAuthenticate))
+	})
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+// Nomes das rotas usadas para montar os endpoints anunciados no discovery.
+const (
+	RouteOAuthAuthorize           = "oauth.authorize"
+	RouteOAuthToken               = "oauth.token"
+	RouteOAuthIntrospect          = "oauth.introspect"
+	RouteOAuthRevoke              = "oauth.revoke"
+	RouteOAuthDeviceAuthorization = "oauth.device_authorization"
+	RouteOAuthDevice              = "oauth.device"
+	RouteOAuthPushedAuthorization = "oauth.par"
+	RouteOAuthUserInfo            = "oauth.userinfo"
+	RouteJWKS                     = "well_known.jwks"
+)
+
+type WellKnownHandler interface {
+	OpenIDConfiguration(ectx echo.Context) error
+	OAuthAuthorizationServer(ectx echo.Context) error
+	JWKS(ectx echo.Context) error
+}
+
+type wellKnownHandler struct {
+	jwtService services.JWTService
+	config     *configs.Environment
+}
+
+func NewWellKnownHandler(jwtService services.JWTService, config *configs.Environment) WellKnownHandler {
+	return &wellKnownHandler{
+		jwtService: jwtService,
+		config:     config,
+	}
+}
+
+func (h *wellKnownHandler) OpenIDConfiguration(ectx echo.Context) error {
+	return ectx.JSON(http.StatusOK, h.serverMetadata(ectx))
+}
+
+func (h *wellKnownHandler) OAuthAuthorizationServer(ectx echo.Context) error {
+	return ectx.JSON(http.StatusOK, h.serverMetadata(ectx))
+}
+
+func (h *wellKnownHandler) JWKS(ectx echo.Context) error {
+	logger := slog.With(
+		slog.String("handler", "well_known"),
+		slog.String("method", ectx.Request().Method),
+		slog.String("path", ectx.Request().URL.Path),
+	)
+
+	response, err := h.jwtService.GetJWKS(ectx.Request().Context())
+	if err != nil {
+		logger.Error("failed to get jwks", "error", err)
+		return echo.ErrInternalServerError
+	}
+
+	return ectx.JSON(http.StatusOK, response)
+}
+
+func (h *wellKnownHandler) serverMetadata(ectx echo.Context) models.ServerMetadata {
+	issuer := services.Issuer(h.config)
+
+	return models.ServerMetadata{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + ectx.Echo().Reverse(RouteOAuthAuthorize),
+		TokenEndpoint:                     issuer + ectx.Echo().Reverse(RouteOAuthToken),
+		IntrospectionEndpoint:             issuer + ectx.Echo().Reverse(RouteOAuthIntrospect),
+		RevocationEndpoint:                issuer + ectx.Echo().Reverse(RouteOAuthRevoke),
+		DeviceAuthorizationEndpoint:       issuer + ectx.Echo().Reverse(RouteOAuthDeviceAuthorization),
+		PushedAuthorizationEndpoint:       issuer + ectx.Echo().Reverse(RouteOAuthPushedAuthorization),
+		UserInfoEndpoint:                  issuer + ectx.Echo().Reverse(RouteOAuthUserInfo),
+		JWKSURI:                           issuer + ectx.Echo().Reverse(RouteJWKS),
+		ScopesSupported:                   scopes.Names(),
+		ResponseTypesSupported:            models.SupportedResponseTypes,
+		ResponseModesSupported:            []string{"query"},
+		PromptValuesSupported:             models.SupportedPrompts,
+		GrantTypesSupported:               models.SupportedGrantTypes,
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  services.SigningAlgorithms(),
+		UserInfoSigningAlgValuesSupported: services.SigningAlgorithms(),
+		TokenEndpointAuthMethodsSupported: models.SupportedTokenEndpointAuthMethods,
+		TokenEndpointAuthSigningAlgValues: services.SigningAlgorithms(),
+		RevocationEndpointAuthMethods:     models.SupportedTokenEndpointAuthMethods,
+		RevocationEndpointAuthSigningAlgs: services.SigningAlgorithms(),
+		CodeChallengeMethodsSupported:     services.SupportedCodeChallengeMethods,
+		RequestParameterSupported:         true,
+		RequestObjectSigningAlgValues:     services.SigningAlgorithms(),
+		DPoPSigningAlgValuesSupported:     services.SigningAlgorithms(),
+		TLSClientCertificateBoundTokens:   h.config.TLS.MutualTLS(),
+		ClaimsSupported:                   []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "azp", "at_hash", "c_hash", "name", "given_name", "family_name", "email", "email_verified"},
+	}
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenIDConfiguration(t *testing.T) {
+	t.Run("should build metadata from base url and registered routes", func(t *testing.T) {
+		// Arrange
+		config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com/"}}
+		handler := NewWellKnownHandler(nil, config)
+
+		e := echo.New()
+		noop := func(echo.Context) error { return nil }
+		e.GET("/api/v1/oauth/authorize", noop).Name = RouteOAuthAuthorize
+		e.POST("/api/v1/oauth/token", noop).Name = RouteOAuthToken
+		e.POST("/api/v1/oauth/introspect", noop).Name = RouteOAuthIntrospect
+		e.POST("/api/v1/oauth/revoke", noop).Name = RouteOAuthRevoke
+		e.POST("/api/v1/oauth/device_authorization", noop).Name = RouteOAuthDeviceAuthorization
+		e.POST("/api/v1/oauth/par", noop).Name = RouteOAuthPushedAuthorization
+		e.GET("/api/v1/oauth/userinfo", noop).Name = RouteOAuthUserInfo
+		e.GET("/.well-known/jwks.json", noop).Name = RouteJWKS
+
+		req := httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.OpenIDConfiguration(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var metadata models.ServerMetadata
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &metadata))
+		assert.Equal(t, "https://id.example.com", metadata.Issuer)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/authorize", metadata.AuthorizationEndpoint)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/token", metadata.TokenEndpoint)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/introspect", metadata.IntrospectionEndpoint)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/revoke", metadata.RevocationEndpoint)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/device_authorization", metadata.DeviceAuthorizationEndpoint)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/par", metadata.PushedAuthorizationEndpoint)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/userinfo", metadata.UserInfoEndpoint)
+		assert.Equal(t, "https://id.example.com/.well-known/jwks.json", metadata.JWKSURI)
+		assert.Equal(t, []string{"authorization_code", "refresh_token", "client_credentials", "urn:ietf:params:oauth:grant-type:device_code", "urn:ietf:params:oauth:grant-type:token-exchange"}, metadata.GrantTypesSupported)
+		assert.Equal(t, []string{"S256", "plain"}, metadata.CodeChallengeMethodsSupported)
+		assert.Equal(t, []string{"none", "login", "consent", "select_account"}, metadata.PromptValuesSupported)
+		assert.Equal(t, []string{"ES256", "RS256", "PS256", "EdDSA"}, metadata.IDTokenSigningAlgValuesSupported)
+		assert.Equal(t, []string{"ES256", "RS256", "PS256", "EdDSA"}, metadata.UserInfoSigningAlgValuesSupported)
+		assert.True(t, metadata.RequestParameterSupported)
+		assert.Equal(t, []string{"ES256", "RS256", "PS256", "EdDSA"}, metadata.RequestObjectSigningAlgValues)
+		assert.Equal(t, []string{"ES256", "RS256", "PS256", "EdDSA"}, metadata.DPoPSigningAlgValuesSupported)
+		assert.False(t, metadata.TLSClientCertificateBoundTokens)
+		assert.Equal(t, models.SupportedTokenEndpointAuthMethods, metadata.RevocationEndpointAuthMethods)
+		assert.Contains(t, metadata.ScopesSupported, "openid")
+	})
+}
+
+func TestOpenIDConfigurationMutualTLS(t *testing.T) {
+	t.Run("should advertise certificate-bound tokens only when mTLS is configured", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			tls      configs.TLS
+			expected bool
+		}{
+			{name: "without tls", expected: false},
+			{name: "without client ca", tls: configs.TLS{CertFile: "server.pem", KeyFile: "server.key"}, expected: false},
+			{name: "with mtls", tls: configs.TLS{CertFile: "server.pem", KeyFile: "server.key", ClientCAFile: "ca.pem"}, expected: true},
+		}
+
+		for _, tt := range tests {
+			// Arrange
+			config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}, TLS: tt.tls}
+			handler := NewWellKnownHandler(nil, config)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil), rec)
+
+			// Act
+			err := handler.OpenIDConfiguration(c)
+
+			// Assert
+			require.NoError(t, err, tt.name)
+
+			var metadata models.ServerMetadata
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &metadata), tt.name)
+			assert.Equal(t, tt.expected, metadata.TLSClientCertificateBoundTokens, tt.name)
+		}
+	})
+}
+
+func TestOAuthAuthorizationServer(t *testing.T) {
+	t.Run("should serve the same metadata as the openid configuration", func(t *testing.T) {
+		// Arrange
+		config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+		handler := NewWellKnownHandler(nil, config)
+
+		e := echo.New()
+		oidcRec := httptest.NewRecorder()
+		oauthRec := httptest.NewRecorder()
+
+		// Act
+		oidcErr := handler.OpenIDConfiguration(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), oidcRec))
+		oauthErr := handler.OAuthAuthorizationServer(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), oauthRec))
+
+		// Assert
+		require.NoError(t, oidcErr)
+		require.NoError(t, oauthErr)
+		assert.JSONEq(t, oidcRec.Body.String(), oauthRec.Body.String())
+	})
+}
+
+func TestJWKS(t *testing.T) {
+	t.Run("should return the public keys", func(t *testing.T) {
+		// Arrange
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		handler := NewWellKnownHandler(mockJWTService, &configs.Environment{})
+
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil), rec)
+
+		mockJWTService.EXPECT().
+			GetJWKS(mock.Anything).
+			Return(&models.JWKSResponse{Keys: []signer.JWK{{Kty: "EC", Crv: "P-256", Kid: "kid"}}}, nil).Once()
+
+		// Act
+		err := handler.JWKS(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"kid":"kid"`)
+	})
+
+	t.Run("should return internal server error when keys cannot be loaded", func(t *testing.T) {
+		// Arrange
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		handler := NewWellKnownHandler(mockJWTService, &configs.Environment{})
+
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil), httptest.NewRecorder())
+
+		mockJWTService.EXPECT().
+			GetJWKS(mock.Anything).
+			Return(nil, errors.New("invalid key")).Once()
+
+		// Act
+		err := handler.JWKS(c)
+
+		// Assert
+		assert.Equal(t, echo.ErrInternalServerError, err)
+	})
+}
@@ -1,7 +1,16 @@
 package middlewares
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/internal/services"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/mtls"
 	"github.com/labstack/echo/v4"
 )
 
@@ -9,20 +18,28 @@ type AuthMiddleware interface {
 	EnsureAuthenticated() echo.MiddlewareFunc
 	EnsureOTPAuthenticated() echo.MiddlewareFunc
 	AttachUserClaimsIfAuthenticated() echo.MiddlewareFunc
+	EnsureBearerScope(scope string) echo.MiddlewareFunc
+	RequireDPoP() echo.MiddlewareFunc
 }
 
 type authMiddleware struct {
-	jwtService       services.JWTService
-	cookieMiddleware CookieMiddleware
+	jwtService             services.JWTService
+	tokenRevocationService services.TokenRevocationService
+	cookieMiddleware       CookieMiddleware
+	dpopService            services.DPoPService
 }
 
 func NewAuthMiddleware(
 	jwtService services.JWTService,
+	tokenRevocationService services.TokenRevocationService,
 	cookieMiddleware CookieMiddleware,
+	dpopService services.DPoPService,
 ) AuthMiddleware {
 	return &authMiddleware{
-		jwtService:       jwtService,
-		cookieMiddleware: cookieMiddleware,
+		jwtService:             jwtService,
+		tokenRevocationService: tokenRevocationService,
+		cookieMiddleware:       cookieMiddleware,
+		dpopService:            dpopService,
 	}
 }
 
@@ -34,7 +51,7 @@ func (m *authMiddleware) EnsureAuthenticated() echo.MiddlewareFunc {
 				return echo.ErrUnauthorized
 			}
 
-			claims, err := m.jwtService.ValidateAccessTokenJWT(ectx.Request().Context(), token)
+			claims, err := m.validateSessionToken(ectx.Request().Context(), token)
 			if err != nil {
 				return echo.ErrUnauthorized
 			}
@@ -74,14 +91,178 @@ func (m *authMiddleware) AttachUserClaimsIfAuthenticated() echo.MiddlewareFunc {
 				return next(ectx)
 			}
 
-			claims, err := m.jwtService.ValidateAccessTokenJWT(ectx.Request().Context(), token)
+			claims, err := m.validateSessionToken(ectx.Request().Context(), token)
+			if err != nil {
+				return echo.ErrUnauthorized
+			}
+
+			SetUserClaims(ectx, &claims)
+
+			return next(ectx)
+		}
+	}
+}
+
+// EnsureBearerScope autentica chamadas servidor a servidor (ex.: resource servers)
+// pelo access token no header Authorization, exigindo o scope informado. Tokens
+// vinculados a uma chave DPoP sempre exigem o esquema DPoP e uma prova válida, e os
+// vinculados a um certificado exigem o mesmo certificado na conexão mTLS.
+func (m *authMiddleware) EnsureBearerScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ectx echo.Context) error {
+			scheme, token, ok := authorizationToken(ectx)
+			if !ok {
+				return echo.ErrUnauthorized
+			}
+
+			claims, err := m.validateAccessToken(ectx.Request().Context(), token)
 			if err != nil {
 				return echo.ErrUnauthorized
 			}
 
+			if isDPoPBound(claims) || strings.EqualFold(scheme, models.TokenTypeDPoP) {
+				if err := m.verifyDPoP(ectx, scheme, token, claims); err != nil {
+					return dpopUnauthorized(ectx, err)
+				}
+			}
+
+			if claims.Cnf != nil && claims.Cnf.X5TS256 != "" && !hasClientCertificate(ectx, claims.Cnf.X5TS256) {
+				ectx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+				return echo.ErrUnauthorized
+			}
+
+			if !scopes.HasScope(scopes.ParseScopes(claims.Scope), scope) {
+				return echo.ErrForbidden
+			}
+
 			SetUserClaims(ectx, &claims)
 
 			return next(ectx)
 		}
 	}
 }
+
+// validateSessionToken autentica o cookie de sessão. Tokens emitidos a clientes
+// (com client_id) nunca valem como sessão do usuário, mesmo reenviados no cookie.
+func (m *authMiddleware) validateSessionToken(ctx context.Context, token string) (models.AccessTokenClaims, error) {
+	claims, err := m.jwtService.ValidateSessionTokenJWT(ctx, token)
+	if err != nil {
+		return models.AccessTokenClaims{}, err
+	}
+
+	if claims.ClientID != "" || !claims.HasUser() {
+		return models.AccessTokenClaims{}, domain.ErrInvalidSessionToken
+	}
+
+	return m.checkRevocation(ctx, claims)
+}
+
+func (m *authMiddleware) validateAccessToken(ctx context.Context, token string) (models.AccessTokenClaims, error) {
+	claims, err := m.jwtService.ValidateAccessTokenJWT(ctx, token)
+	if err != nil {
+		return models.AccessTokenClaims{}, err
+	}
+
+	return m.checkRevocation(ctx, claims)
+}
+
+func (m *authMiddleware) checkRevocation(ctx context.Context, claims models.AccessTokenClaims) (models.AccessTokenClaims, error) {
+	revoked, err := m.tokenRevocationService.IsAccessTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		return models.AccessTokenClaims{}, err
+	}
+
+	if revoked {
+		return models.AccessTokenClaims{}, domain.ErrAccessTokenRevoked
+	}
+
+	return claims, nil
+}
+
+// RequireDPoP deve vir depois de EnsureBearerScope e recusa access tokens bearer,
+// aceitando apenas tokens vinculados a uma chave DPoP cuja prova já foi conferida.
+func (m *authMiddleware) RequireDPoP() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ectx echo.Context) error {
+			claims, err := GetUserClaims(ectx)
+			if err != nil {
+				return echo.ErrUnauthorized
+			}
+
+			if !isDPoPBound(*claims) {
+				return dpopUnauthorized(ectx, domain.ErrDPoPKeyMismatch)
+			}
+
+			return next(ectx)
+		}
+	}
+}
+
+// dpopUnauthorized indica no WWW-Authenticate se a prova ou o token foi recusado.
+func dpopUnauthorized(ectx echo.Context, err error) error {
+	code := "invalid_token"
+	if errors.Is(err, domain.ErrInvalidDPoPProof) {
+		code = "invalid_dpop_proof"
+	}
+
+	ectx.Response().Header().Set(echo.HeaderWWWAuthenticate, fmt.Sprintf(`DPoP error="%s"`, code))
+	return echo.ErrUnauthorized
+}
+
+// verifyDPoP exige o esquema DPoP, uma única prova com ath do token e a mesma chave
+// registrada na claim cnf (RFC 9449, seção 7).
+func (m *authMiddleware) verifyDPoP(ectx echo.Context, scheme, token string, claims models.AccessTokenClaims) error {
+	if !strings.EqualFold(scheme, models.TokenTypeDPoP) || !isDPoPBound(claims) {
+		return domain.ErrDPoPKeyMismatch
+	}
+
+	proofs := ectx.Request().Header.Values(models.HeaderDPoP)
+	if len(proofs) != 1 {
+		return domain.ErrInvalidDPoPProof
+	}
+
+	jkt, err := m.dpopService.ValidateProof(ectx.Request().Context(), models.DPoPProofInput{
+		Proof:       proofs[0],
+		Method:      ectx.Request().Method,
+		Endpoint:    ectx.Request().URL.Path,
+		AccessToken: token,
+	})
+	if err != nil {
+		return err
+	}
+
+	if jkt != claims.Cnf.JKT {
+		return domain.ErrDPoPKeyMismatch
+	}
+
+	return nil
+}
+
+func isDPoPBound(claims models.AccessTokenClaims) bool {
+	return claims.Cnf != nil && claims.Cnf.JKT != ""
+}
+
+// hasClientCertificate confere o certificado da conexão com o x5t#S256 do token
+// (RFC 8705, seção 3).
+func hasClientCertificate(ectx echo.Context, thumbprint string) bool {
+	tlsState := ectx.Request().TLS
+	if tlsState == nil || len(tlsState.PeerCertificates) == 0 {
+		return false
+	}
+
+	return mtls.Thumbprint(tlsState.PeerCertificates[0]) == thumbprint
+}
+
+// authorizationToken aceita os esquemas Bearer e DPoP no header Authorization.
+func authorizationToken(ectx echo.Context) (string, string, bool) {
+	scheme, token, found := strings.Cut(ectx.Request().Header.Get(echo.HeaderAuthorization), " ")
+	if !found || token == "" {
+		return "", "", false
+	}
+
+	if !strings.EqualFold(scheme, "Bearer") && !strings.EqualFold(scheme, models.TokenTypeDPoP) {
+		return "", "", false
+	}
+
+	return scheme, token, true
+}
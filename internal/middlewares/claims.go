@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"errors"
+	"time"
 
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/labstack/echo/v4"
@@ -52,6 +53,16 @@ func GetOTPJTI(ectx echo.Context) string {
 	return otp.ID
 }
 
+// GetAuthTime retorna o momento do login, o iat do token de sessão.
+func GetAuthTime(ectx echo.Context) time.Time {
+	user, err := GetUserClaims(ectx)
+	if err != nil || user.IssuedAt == nil {
+		return time.Time{}
+	}
+
+	return user.IssuedAt.Time
+}
+
 func GetUserID(ectx echo.Context) string {
 	user, err := GetUserClaims(ectx)
 	if err != nil {
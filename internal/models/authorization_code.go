@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type CreateAuthorizationCodeInput struct {
 	UserID              string
 	ClientID            string
@@ -7,4 +9,7 @@ type CreateAuthorizationCodeInput struct {
 	CodeChallenge       string
 	CodeChallengeMethod string
 	Scopes              []string
+	Resources           []string
+	Nonce               string
+	AuthTime            time.Time
 }
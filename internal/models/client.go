@@ -3,6 +3,8 @@ package models
 import (
 	"time"
 
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -11,7 +13,38 @@ type CreateClientPayload struct {
 	Name         string   `json:"name" validate:"required"`
 	Description  string   `json:"description" validate:"required"`
 	RedirectURIs []string `json:"redirect_uris" validate:"required,min=1,dive,uri"`
-	GrantTypes   []string `json:"grant_types" validate:"required,min=1,dive,oneof=authorization_code refresh_token"`
+	GrantTypes   []string `json:"grant_types" validate:"required,min=1,dive,oneof=authorization_code refresh_token client_credentials urn:ietf:params:oauth:grant-type:device_code urn:ietf:params:oauth:grant-type:token-exchange"`
+	// TokenEndpointAuthMethod é opcional; quando omitido, clientes com client_credentials
+	// usam client_secret_basic e os demais são públicos (none).
+	TokenEndpointAuthMethod string `json:"token_endpoint_auth_method" validate:"omitempty,oneof=none client_secret_basic client_secret_post private_key_jwt tls_client_auth self_signed_tls_client_auth"`
+	// JWKS é obrigatório para private_key_jwt e contém as chaves que assinam as asserções
+	// e os request objects (JAR).
+	JWKS *JWKSResponse `json:"jwks" validate:"required_if=TokenEndpointAuthMethod private_key_jwt"`
+	// IDTokenSignedResponseAlg é opcional; quando omitido os ID tokens usam ES256.
+	IDTokenSignedResponseAlg string `json:"id_token_signed_response_alg" validate:"omitempty,oneof=ES256 RS256 PS256 EdDSA"`
+	// UserinfoSignedResponseAlg é opcional; quando informado o UserInfo responde um JWT assinado.
+	UserinfoSignedResponseAlg string `json:"userinfo_signed_response_alg" validate:"omitempty,oneof=ES256 RS256 PS256 EdDSA"`
+	// RequirePushedAuthorizationRequests recusa no /authorize parâmetros que não venham de PAR.
+	RequirePushedAuthorizationRequests bool `json:"require_pushed_authorization_requests"`
+	// TLSClientAuthSubjectDN é obrigatório para tls_client_auth, no formato RFC 2253 (ex.: "CN=orders,O=Acme").
+	TLSClientAuthSubjectDN string `json:"tls_client_auth_subject_dn" validate:"required_if=TokenEndpointAuthMethod tls_client_auth"`
+	// TLSClientCertificateThumbprint é obrigatório para self_signed_tls_client_auth.
+	TLSClientCertificateThumbprint string `json:"tls_client_certificate_thumbprint" validate:"required_if=TokenEndpointAuthMethod self_signed_tls_client_auth"`
+}
+
+// CreateClientInput representa os dados para criação de cliente
+type CreateClientInput struct {
+	Name                               string
+	Description                        string
+	RedirectURIs                       []string
+	GrantTypes                         []string
+	TokenEndpointAuthMethod            string
+	JWKS                               []signer.JWK
+	IDTokenSignedResponseAlg           string
+	UserinfoSignedResponseAlg          string
+	RequirePushedAuthorizationRequests bool
+	TLSClientAuthSubjectDN             string
+	TLSClientCertificateThumbprint     string
 }
 
 // UpdateClientPayload representa o payload para atualização de cliente
@@ -19,19 +52,28 @@ type UpdateClientPayload struct {
 	Name         *string  `json:"name,omitempty" validate:"omitempty,min=1"`
 	Description  *string  `json:"description,omitempty" validate:"omitempty,min=1"`
 	RedirectURIs []string `json:"redirect_uris,omitempty" validate:"omitempty,min=1,dive,uri"`
-	GrantTypes   []string `json:"grant_types,omitempty" validate:"omitempty,min=1,dive,oneof=authorization_code refresh_token"`
+	GrantTypes   []string `json:"grant_types,omitempty" validate:"omitempty,min=1,dive,oneof=authorization_code refresh_token client_credentials urn:ietf:params:oauth:grant-type:device_code urn:ietf:params:oauth:grant-type:token-exchange"`
 	Scopes       []string `json:"scopes,omitempty" validate:"omitempty,min=1"`
 }
 
 // ClientResponse representa a resposta da API para cliente
 type ClientResponse struct {
-	ID           primitive.ObjectID `json:"id"`
-	ClientID     string             `json:"client_id"`
-	Name         string             `json:"name"`
-	Description  string             `json:"description"`
-	RedirectURIs []string           `json:"redirect_uris"`
-	Scopes       []string           `json:"scopes"`
-	CreatedAt    time.Time          `json:"created_at"`
+	ID                                 primitive.ObjectID            `json:"id"`
+	ClientID                           string                        `json:"client_id"`
+	ClientSecret                       string                        `json:"client_secret,omitempty"`
+	Name                               string                        `json:"name"`
+	Description                        string                        `json:"description"`
+	RedirectURIs                       []string                      `json:"redirect_uris"`
+	Scopes                             []string                      `json:"scopes"`
+	IDTokenSignedResponseAlg           string                        `json:"id_token_signed_response_alg"`
+	UserinfoSignedResponseAlg          string                        `json:"userinfo_signed_response_alg,omitempty"`
+	TokenEndpointAuthMethod            string                        `json:"token_endpoint_auth_method"`
+	TokenExchangePolicy                *entities.TokenExchangePolicy `json:"token_exchange_policy,omitempty"`
+	RequirePushedAuthorizationRequests bool                          `json:"require_pushed_authorization_requests"`
+	FirstParty                         bool                          `json:"first_party"`
+	TLSClientAuthSubjectDN             string                        `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientCertificateThumbprint     string                        `json:"tls_client_certificate_thumbprint,omitempty"`
+	CreatedAt                          time.Time                     `json:"created_at"`
 }
 
 // ClientListResponse representa a resposta da API para listagem de clientes
@@ -39,3 +81,24 @@ type ClientListResponse struct {
 	Clients []ClientResponse `json:"clients"`
 	Total   int64            `json:"total"`
 }
+
+func NewCreateClientInput(payload CreateClientPayload) CreateClientInput {
+	input := CreateClientInput{
+		Name:                               payload.Name,
+		Description:                        payload.Description,
+		RedirectURIs:                       payload.RedirectURIs,
+		GrantTypes:                         payload.GrantTypes,
+		TokenEndpointAuthMethod:            payload.TokenEndpointAuthMethod,
+		IDTokenSignedResponseAlg:           payload.IDTokenSignedResponseAlg,
+		UserinfoSignedResponseAlg:          payload.UserinfoSignedResponseAlg,
+		RequirePushedAuthorizationRequests: payload.RequirePushedAuthorizationRequests,
+		TLSClientAuthSubjectDN:             payload.TLSClientAuthSubjectDN,
+		TLSClientCertificateThumbprint:     payload.TLSClientCertificateThumbprint,
+	}
+
+	if payload.JWKS != nil {
+		input.JWKS = payload.JWKS.Keys
+	}
+
+	return input
+}
@@ -0,0 +1,63 @@
+package models
+
+import "crypto/x509"
+
+const (
+	TokenEndpointAuthMethodNone              = "none"
+	TokenEndpointAuthMethodClientSecretBasic = "client_secret_basic"
+	TokenEndpointAuthMethodClientSecretPost  = "client_secret_post"
+	TokenEndpointAuthMethodPrivateKeyJWT     = "private_key_jwt"
+	// Métodos de autenticação por certificado de cliente (RFC 8705, seção 2).
+	TokenEndpointAuthMethodTLSClientAuth           = "tls_client_auth"
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth = "self_signed_tls_client_auth"
+
+	// ClientAssertionTypeJWTBearer identifica asserções JWT de cliente (RFC 7523, seção 2.2).
+	ClientAssertionTypeJWTBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// SupportedTokenEndpointAuthMethods lista os métodos de autenticação aceitos no endpoint de token.
+var SupportedTokenEndpointAuthMethods = []string{
+	TokenEndpointAuthMethodNone,
+	TokenEndpointAuthMethodClientSecretBasic,
+	TokenEndpointAuthMethodClientSecretPost,
+	TokenEndpointAuthMethodPrivateKeyJWT,
+	TokenEndpointAuthMethodTLSClientAuth,
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+}
+
+// ClientAuthenticationPayload reúne os parâmetros de autenticação de cliente enviados
+// no formulário; é embutido nos payloads dos endpoints que autenticam o cliente.
+type ClientAuthenticationPayload struct {
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+	// Asserção de cliente para private_key_jwt (RFC 7523).
+	ClientAssertionType string `form:"client_assertion_type"`
+	ClientAssertion     string `form:"client_assertion"`
+}
+
+// ClientAuthenticationInput reúne as credenciais apresentadas pelo cliente, seja no
+// header Authorization (Basic) ou no corpo do formulário.
+type ClientAuthenticationInput struct {
+	ClientID            string
+	ClientSecret        string
+	BasicClientID       string
+	BasicClientSecret   string
+	HasBasicAuth        bool
+	ClientAssertionType string
+	ClientAssertion     string
+	// Endpoint é o caminho do endpoint que recebeu a requisição; junto do issuer,
+	// forma a audiência aceita nas asserções de private_key_jwt.
+	Endpoint string
+	// ClientCertificates é a cadeia apresentada no handshake TLS, com a folha primeiro.
+	ClientCertificates []*x509.Certificate
+}
+
+func NewClientAuthenticationInput(payload ClientAuthenticationPayload, endpoint string) ClientAuthenticationInput {
+	return ClientAuthenticationInput{
+		ClientID:            payload.ClientID,
+		ClientSecret:        payload.ClientSecret,
+		ClientAssertionType: payload.ClientAssertionType,
+		ClientAssertion:     payload.ClientAssertion,
+		Endpoint:            endpoint,
+	}
+}
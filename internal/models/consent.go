@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+)
+
+type ConsentRequestPayload struct {
+	ConsentChallenge string `query:"consent_challenge" validate:"required"`
+}
+
+// ConsentRequestResponse descreve a autorização pendente para a tela de consentimento;
+// GrantedScopes são os escopos que o usuário já havia autorizado ao cliente.
+type ConsentRequestResponse struct {
+	ClientID      string         `json:"client_id"`
+	ClientName    string         `json:"client_name"`
+	Scopes        []scopes.Scope `json:"scopes"`
+	GrantedScopes []string       `json:"granted_scopes"`
+}
+
+type ConsentDecisionPayload struct {
+	ConsentChallenge string `json:"consent_challenge" form:"consent_challenge" validate:"required"`
+	Approve          bool   `json:"approve" form:"approve"`
+}
+
+type ConsentDecisionInput struct {
+	ConsentChallenge string
+	UserID           string
+	Approve          bool
+}
+
+// ConsentDecisionResponse indica para onde a tela de consentimento deve levar o
+// navegador: de volta ao /authorize ou ao cliente com access_denied.
+type ConsentDecisionResponse struct {
+	RedirectTo string `json:"redirect_to"`
+}
+
+// GrantResponse descreve um aplicativo conectado à conta do usuário.
+type GrantResponse struct {
+	ClientID   string     `json:"client_id"`
+	ClientName string     `json:"client_name"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+type RevokeGrantPayload struct {
+	ClientID string `param:"client_id" validate:"required"`
+}
+
+func NewConsentDecisionInput(payload ConsentDecisionPayload, userID string) ConsentDecisionInput {
+	return ConsentDecisionInput{
+		ConsentChallenge: payload.ConsentChallenge,
+		UserID:           userID,
+		Approve:          payload.Approve,
+	}
+}
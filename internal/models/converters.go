@@ -35,13 +35,21 @@ func CreateUserPayloadToEntity(payload *CreateUserPayload) *entities.User {
 // ClientToResponse converte uma entidade Client para ClientResponse
 func ClientToResponse(client *entities.Client) *ClientResponse {
 	return &ClientResponse{
-		ID:           client.ID,
-		ClientID:     client.ClientID,
-		Name:         client.Name,
-		Description:  client.Description,
-		RedirectURIs: client.RedirectURIs,
-		Scopes:       client.Scopes,
-		CreatedAt:    client.CreatedAt,
+		ID:                                 client.ID,
+		ClientID:                           client.ClientID,
+		Name:                               client.Name,
+		Description:                        client.Description,
+		RedirectURIs:                       client.RedirectURIs,
+		Scopes:                             client.Scopes,
+		IDTokenSignedResponseAlg:           client.IDTokenSigningAlgorithm(),
+		UserinfoSignedResponseAlg:          client.UserinfoSignedResponseAlg,
+		TokenEndpointAuthMethod:            client.AuthMethod(),
+		TokenExchangePolicy:                client.TokenExchangePolicy,
+		RequirePushedAuthorizationRequests: client.RequirePushedAuthorizationRequests,
+		FirstParty:                         client.FirstParty,
+		TLSClientAuthSubjectDN:             client.TLSClientAuthSubjectDN,
+		TLSClientCertificateThumbprint:     client.TLSClientCertificateThumbprint,
+		CreatedAt:                          client.CreatedAt,
 	}
 }
 
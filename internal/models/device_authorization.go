@@ -0,0 +1,85 @@
+package models
+
+import (
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+)
+
+type DeviceAuthorizationPayload struct {
+	ClientAuthenticationPayload
+	Scope string `form:"scope"`
+}
+
+type DeviceAuthorizationInput struct {
+	ClientID string
+	Scope    []string
+	// VerificationURI é a URL onde o usuário informa o user_code.
+	VerificationURI string
+}
+
+// DeviceAuthorizationResponse segue a RFC 8628, seção 3.2.
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+type DeviceVerificationPayload struct {
+	UserCode string `query:"user_code"`
+}
+
+// DeviceVerificationResponse descreve a autorização pendente para que o usuário
+// confirme o dispositivo antes de aprovar.
+type DeviceVerificationResponse struct {
+	UserCode   string   `json:"user_code"`
+	ClientID   string   `json:"client_id"`
+	ClientName string   `json:"client_name"`
+	Scopes     []string `json:"scopes"`
+}
+
+type DeviceDecisionPayload struct {
+	UserCode string `json:"user_code" form:"user_code" validate:"required"`
+	Approve  bool   `json:"approve" form:"approve"`
+}
+
+type DeviceDecisionInput struct {
+	UserCode string
+	UserID   string
+	Approve  bool
+}
+
+type DeviceCodeInput struct {
+	DeviceCode            string
+	ClientID              string
+	Resource              []string
+	DPoPJKT               string
+	CertificateThumbprint string
+}
+
+func NewDeviceAuthorizationInput(payload DeviceAuthorizationPayload, verificationURI string) DeviceAuthorizationInput {
+	return DeviceAuthorizationInput{
+		ClientID:        payload.ClientID,
+		Scope:           scopes.ParseScopes(payload.Scope),
+		VerificationURI: verificationURI,
+	}
+}
+
+func NewDeviceDecisionInput(payload DeviceDecisionPayload, userID string) DeviceDecisionInput {
+	return DeviceDecisionInput{
+		UserCode: payload.UserCode,
+		UserID:   userID,
+		Approve:  payload.Approve,
+	}
+}
+
+func NewDeviceCodeInput(payload TokenPayload) DeviceCodeInput {
+	return DeviceCodeInput{
+		DeviceCode:            payload.DeviceCode,
+		ClientID:              payload.ClientID,
+		Resource:              payload.Resource,
+		DPoPJKT:               payload.DPoPJKT,
+		CertificateThumbprint: payload.CertificateThumbprint,
+	}
+}
@@ -0,0 +1,36 @@
+package models
+
+import "github.com/golang-jwt/jwt/v5"
+
+const (
+	// HeaderDPoP transporta a prova DPoP nas requisições.
+	HeaderDPoP = "DPoP"
+	// DPoPProofType é o typ exigido no header das provas DPoP (RFC 9449, seção 4.2).
+	DPoPProofType = "dpop+jwt"
+	// TokenTypeDPoP é o token_type dos access tokens vinculados a uma chave DPoP.
+	TokenTypeDPoP = "DPoP"
+)
+
+// DPoPProofClaims são as claims da prova DPoP; ath só é enviado junto de um access token.
+type DPoPProofClaims struct {
+	jwt.RegisteredClaims
+	HTM string `json:"htm"`
+	HTU string `json:"htu"`
+	ATH string `json:"ath,omitempty"`
+}
+
+// ConfirmationClaim é a claim cnf que vincula o token ao thumbprint da chave DPoP
+// (RFC 9449, seção 6.1) ou do certificado mTLS do cliente (RFC 8705, seção 3.1).
+type ConfirmationClaim struct {
+	JKT     string `json:"jkt,omitempty"`
+	X5TS256 string `json:"x5t#S256,omitempty"`
+}
+
+type DPoPProofInput struct {
+	Proof  string
+	Method string
+	// Endpoint é o caminho da requisição; junto do issuer, forma o htu esperado.
+	Endpoint string
+	// AccessToken é informado quando a prova acompanha um access token, exigindo ath.
+	AccessToken string
+}
@@ -0,0 +1,42 @@
+package models
+
+const (
+	TokenTypeHintAccessToken  = "access_token"
+	TokenTypeHintRefreshToken = "refresh_token"
+)
+
+type IntrospectPayload struct {
+	Token         string `form:"token" validate:"required"`
+	TokenTypeHint string `form:"token_type_hint"`
+}
+
+type IntrospectTokenInput struct {
+	Token         string
+	TokenTypeHint string
+}
+
+// IntrospectionResponse segue a RFC 7662. Tokens inválidos, expirados ou
+// revogados são respondidos apenas com active=false.
+type IntrospectionResponse struct {
+	Active    bool        `json:"active"`
+	Scope     string      `json:"scope,omitempty"`
+	ClientID  string      `json:"client_id,omitempty"`
+	TokenType string      `json:"token_type,omitempty"`
+	Exp       int64       `json:"exp,omitempty"`
+	Iat       int64       `json:"iat,omitempty"`
+	Nbf       int64       `json:"nbf,omitempty"`
+	Sub       string      `json:"sub,omitempty"`
+	Aud       []string    `json:"aud,omitempty"`
+	Iss       string      `json:"iss,omitempty"`
+	Jti       string      `json:"jti,omitempty"`
+	Act       *ActorClaim `json:"act,omitempty"`
+	// Cnf permite ao resource server conferir a chave DPoP (RFC 9449, seção 6.2).
+	Cnf *ConfirmationClaim `json:"cnf,omitempty"`
+}
+
+func NewIntrospectTokenInput(payload IntrospectPayload) IntrospectTokenInput {
+	return IntrospectTokenInput{
+		Token:         payload.Token,
+		TokenTypeHint: payload.TokenTypeHint,
+	}
+}
@@ -0,0 +1,7 @@
+package models
+
+import "github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+
+type JWKSResponse struct {
+	Keys []signer.JWK `json:"keys"`
+}
@@ -1,6 +1,18 @@
 package models
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// SessionTokenType é o typ do token de sessão gravado no cookie após o login. Ele
+// nunca é entregue a clientes OAuth, e só ele autentica o usuário nas telas do servidor.
+const SessionTokenType = "session"
+
+// SubjectTypeClient marca tokens emitidos ao próprio cliente (client_credentials): o
+// sub é o client_id e não há usuário por trás do token.
+const SubjectTypeClient = "client"
 
 type OTPTokenClaims struct {
 	jwt.RegisteredClaims
@@ -9,11 +21,69 @@ type OTPTokenClaims struct {
 type AccessTokenClaims struct {
 	jwt.RegisteredClaims
 	TokenType string `json:"typ"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	// Act identifica quem age em nome do sub em tokens obtidos por delegação (RFC 8693, seção 4.1).
+	Act *ActorClaim `json:"act,omitempty"`
+	// Cnf vincula o token a uma chave DPoP ou a um certificado mTLS; tokens sem cnf são bearer.
+	Cnf *ConfirmationClaim `json:"cnf,omitempty"`
+	// SubjectType é SubjectTypeClient quando o sub não é um usuário.
+	SubjectType string `json:"sub_type,omitempty"`
+}
+
+// HasUser indica se o sub do token identifica um usuário.
+func (c AccessTokenClaims) HasUser() bool {
+	return c.Subject != "" && c.SubjectType != SubjectTypeClient
+}
+
+// ActorClaim é a claim act; delegações sucessivas ficam aninhadas em Act.
+type ActorClaim struct {
+	Subject  string      `json:"sub"`
+	ClientID string      `json:"client_id,omitempty"`
+	Act      *ActorClaim `json:"act,omitempty"`
 }
 
+// IDTokenClaims segue o OIDC Core, seção 2; aud é sempre o client_id.
 type IDTokenClaims struct {
 	jwt.RegisteredClaims
-	TokenType string `json:"typ"`
-	Name      string `json:"name"`
-	Email     string `json:"email"`
+	TokenType       string           `json:"typ"`
+	AuthorizedParty string           `json:"azp"`
+	Nonce           string           `json:"nonce,omitempty"`
+	AuthTime        *jwt.NumericDate `json:"auth_time,omitempty"`
+	AccessTokenHash string           `json:"at_hash,omitempty"`
+	CodeHash        string           `json:"c_hash,omitempty"`
+	Name            string           `json:"name,omitempty"`
+	Email           string           `json:"email,omitempty"`
+}
+
+type IDTokenInput struct {
+	Algorithm string
+	UserID    string
+	ClientID  string
+	Name      string
+	Email     string
+	Nonce     string
+	// AuthTime é o momento do login do usuário; zero omite auth_time.
+	AuthTime time.Time
+	// AccessToken e Code emitidos junto com o ID token geram at_hash e c_hash.
+	AccessToken string
+	Code        string
+	ExpiresIn   time.Duration
+}
+
+type AccessTokenInput struct {
+	ID        string
+	UserID    string
+	ClientID  string
+	Scopes    []string
+	ExpiresAt time.Time
+	// Audience substitui a audiência padrão dos access tokens.
+	Audience []string
+	Actor    *ActorClaim
+	// DPoPJKT vincula o token ao thumbprint da chave DPoP apresentada na emissão.
+	DPoPJKT string
+	// CertificateThumbprint vincula o token ao certificado mTLS do cliente (RFC 8705, seção 3).
+	CertificateThumbprint string
+	// SubjectType é SubjectTypeClient para tokens sem usuário.
+	SubjectType string
 }
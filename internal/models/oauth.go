@@ -1,22 +1,86 @@
 package models
 
-import "strings"
+import (
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
+	GrantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+	ResponseTypeCode = "code"
+)
+
+// Valores do parâmetro prompt (OIDC Core, seção 3.1.2.1).
+const (
+	PromptNone          = "none"
+	PromptLogin         = "login"
+	PromptConsent       = "consent"
+	PromptSelectAccount = "select_account"
+)
+
+// SupportedGrantTypes lista os grant types aceitos pelo endpoint de token.
+var SupportedGrantTypes = []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials, GrantTypeDeviceCode, GrantTypeTokenExchange}
+
+// SupportedPrompts lista os valores de prompt aceitos pelo endpoint de autorização.
+var SupportedPrompts = []string{PromptNone, PromptLogin, PromptConsent, PromptSelectAccount}
+
+// SupportedResponseTypes lista os response types emitidos pelo endpoint de autorização.
+var SupportedResponseTypes = []string{ResponseTypeCode}
+
+// AuthorizePayload aceita os parâmetros na query, em um request object assinado
+// (JAR, RFC 9101) ou, com PAR (RFC 9126), apenas client_id e o request_uri devolvido
+// pelo endpoint de pushed authorization.
 type AuthorizePayload struct {
 	ClientID            string `query:"client_id" validate:"required"`
-	RedirectURI         string `query:"redirect_uri" validate:"required"`
-	ResponseType        string `query:"response_type" validate:"required"`
-	Scope               string `query:"scope" validate:"required"`
-	State               string `query:"state" validate:"required"`
-	CodeChallenge       string `query:"code_challenge" validate:"required"`
-	CodeChallengeMethod string `query:"code_challenge_method" validate:"required"`
+	RequestURI          string `query:"request_uri"`
+	Request             string `query:"request" validate:"excluded_with=RequestURI"`
+	RedirectURI         string `query:"redirect_uri" validate:"required_without_all=RequestURI Request"`
+	ResponseType        string `query:"response_type" validate:"required_without_all=RequestURI Request"`
+	Scope               string `query:"scope" validate:"required_without_all=RequestURI Request"`
+	State               string `query:"state" validate:"required_without_all=RequestURI Request"`
+	CodeChallenge       string `query:"code_challenge" validate:"required_without_all=RequestURI Request"`
+	CodeChallengeMethod string `query:"code_challenge_method" validate:"required_without_all=RequestURI Request"`
+	Nonce               string `query:"nonce"`
+	Prompt              string `query:"prompt"`
+	MaxAge              *int64 `query:"max_age" validate:"omitempty,min=0"`
+	LoginHint           string `query:"login_hint"`
+	IDTokenHint         string `query:"id_token_hint"`
+	// ConsentChallenge é acrescentado pela tela de consentimento ao voltar para o /authorize.
+	ConsentChallenge string `query:"consent_challenge"`
+	// Resource indica as APIs para as quais os tokens serão emitidos (RFC 8707).
+	Resource []string `query:"resource"`
 }
 
-type ExchangeAuthorizationCodePayload struct {
-	Code         string `form:"code"`
-	CodeVerifier string `form:"code_verifier"`
-	ClientID     string `form:"client_id"`
-	RedirectURI  string `form:"redirect_uri"`
+type TokenPayload struct {
+	ClientAuthenticationPayload
+	GrantType    string `form:"grant_type" validate:"required"`
+	Code         string `form:"code" validate:"required_if=GrantType authorization_code"`
+	CodeVerifier string `form:"code_verifier" validate:"required_if=GrantType authorization_code"`
+	RedirectURI  string `form:"redirect_uri" validate:"required_if=GrantType authorization_code"`
+	RefreshToken string `form:"refresh_token" validate:"required_if=GrantType refresh_token"`
+	DeviceCode   string `form:"device_code" validate:"required_if=GrantType urn:ietf:params:oauth:grant-type:device_code"`
+	Scope        string `form:"scope"`
+	// Resource restringe o aud e os escopos do access token (RFC 8707, seção 2.2).
+	Resource []string `form:"resource"`
+	// Parâmetros do token exchange (RFC 8693, seção 2.1).
+	SubjectToken       string   `form:"subject_token" validate:"required_if=GrantType urn:ietf:params:oauth:grant-type:token-exchange"`
+	SubjectTokenType   string   `form:"subject_token_type" validate:"required_if=GrantType urn:ietf:params:oauth:grant-type:token-exchange"`
+	ActorToken         string   `form:"actor_token"`
+	ActorTokenType     string   `form:"actor_token_type" validate:"required_with=ActorToken"`
+	RequestedTokenType string   `form:"requested_token_type"`
+	Audience           []string `form:"audience"`
+	// DPoPJKT é o thumbprint da chave da prova DPoP validada pelo handler; nunca vem do corpo.
+	DPoPJKT string `json:"-"`
+	// CertificateThumbprint é o x5t#S256 do certificado mTLS do cliente autenticado.
+	CertificateThumbprint string `json:"-"`
 }
 
 type AuthorizeInput struct {
@@ -28,13 +92,61 @@ type AuthorizeInput struct {
 	CodeChallengeMethod string
 	State               string
 	UserID              string
+	RequestURI          string
+	RequestObject       string
+	Resource            []string
+	Nonce               string
+	Prompt              []string
+	MaxAge              *int64
+	LoginHint           string
+	IDTokenHint         string
+	ConsentChallenge    string
+	// AuthTime é o iat da sessão de login do usuário, repassado ao ID token.
+	AuthTime time.Time
+	// RequestedAt é quando o cliente criou a requisição (PAR ou iat do request object);
+	// um login posterior a ele atende prompt=login.
+	RequestedAt time.Time
+}
+
+// ParsePrompt separa os valores de prompt; sem o parâmetro o resultado é nil.
+func ParsePrompt(prompt string) []string {
+	if strings.TrimSpace(prompt) == "" {
+		return nil
+	}
+
+	return strings.Fields(prompt)
+}
+
+// HasPrompt informa se o cliente pediu o valor de prompt.
+func (i AuthorizeInput) HasPrompt(prompt string) bool {
+	return slices.Contains(i.Prompt, prompt)
 }
 
 type ExchangeAuthorizationCodeInput struct {
-	Code         string
-	CodeVerifier string
-	ClientID     string
-	RedirectURI  string
+	Code                  string
+	CodeVerifier          string
+	ClientID              string
+	RedirectURI           string
+	Resource              []string
+	DPoPJKT               string
+	CertificateThumbprint string
+}
+
+type RefreshTokenInput struct {
+	RefreshToken          string
+	ClientID              string
+	Scope                 []string
+	Resource              []string
+	DPoPJKT               string
+	CertificateThumbprint string
+}
+
+type ClientCredentialsInput struct {
+	ClientID              string
+	Scope                 []string
+	Resource              []string
+	DPoPJKT               string
+	CertificateThumbprint string
 }
 
 type TokenResponse struct {
@@ -43,13 +155,17 @@ type TokenResponse struct {
 	IDToken      string `json:"id_token,omitempty"`
 	RefreshToken string `json:"refresh_token,omitempty"`
 	ExpiresIn    int64  `json:"expires_in"`
+	// Scope só é informado quando difere do concedido, ao restringir o token a um resource.
+	Scope string `json:"scope,omitempty"`
+	// IssuedTokenType só é informado em respostas de token exchange (RFC 8693, seção 2.2.1).
+	IssuedTokenType string `json:"issued_token_type,omitempty"`
 }
 
 type AuthorizeResponse struct {
 	RedirectURL string
 }
 
-func NewAuthorizeInput(payload AuthorizePayload, userID string) AuthorizeInput {
+func NewAuthorizeInput(payload AuthorizePayload, userID string, authTime time.Time) AuthorizeInput {
 	return AuthorizeInput{
 		ClientID:            payload.ClientID,
 		RedirectURI:         payload.RedirectURI,
@@ -59,14 +175,48 @@ func NewAuthorizeInput(payload AuthorizePayload, userID string) AuthorizeInput {
 		State:               payload.State,
 		Scope:               strings.Split(payload.Scope, " "),
 		UserID:              userID,
+		RequestURI:          payload.RequestURI,
+		RequestObject:       payload.Request,
+		Resource:            payload.Resource,
+		Nonce:               payload.Nonce,
+		Prompt:              ParsePrompt(payload.Prompt),
+		MaxAge:              payload.MaxAge,
+		LoginHint:           payload.LoginHint,
+		IDTokenHint:         payload.IDTokenHint,
+		ConsentChallenge:    payload.ConsentChallenge,
+		AuthTime:            authTime,
 	}
 }
 
-func NewExchangeAuthorizationCodeInput(payload ExchangeAuthorizationCodePayload) ExchangeAuthorizationCodeInput {
+func NewExchangeAuthorizationCodeInput(payload TokenPayload) ExchangeAuthorizationCodeInput {
 	return ExchangeAuthorizationCodeInput{
-		Code:         payload.Code,
-		CodeVerifier: payload.CodeVerifier,
-		ClientID:     payload.ClientID,
-		RedirectURI:  payload.RedirectURI,
+		Code:                  payload.Code,
+		CodeVerifier:          payload.CodeVerifier,
+		ClientID:              payload.ClientID,
+		RedirectURI:           payload.RedirectURI,
+		Resource:              payload.Resource,
+		DPoPJKT:               payload.DPoPJKT,
+		CertificateThumbprint: payload.CertificateThumbprint,
+	}
+}
+
+func NewRefreshTokenInput(payload TokenPayload) RefreshTokenInput {
+	return RefreshTokenInput{
+		RefreshToken:          payload.RefreshToken,
+		ClientID:              payload.ClientID,
+		Scope:                 scopes.ParseScopes(payload.Scope),
+		Resource:              payload.Resource,
+		DPoPJKT:               payload.DPoPJKT,
+		CertificateThumbprint: payload.CertificateThumbprint,
+	}
+}
+
+func NewClientCredentialsInput(payload TokenPayload) ClientCredentialsInput {
+	return ClientCredentialsInput{
+		ClientID:              payload.ClientID,
+		Scope:                 scopes.ParseScopes(payload.Scope),
+		Resource:              payload.Resource,
+		DPoPJKT:               payload.DPoPJKT,
+		CertificateThumbprint: payload.CertificateThumbprint,
 	}
 }
@@ -0,0 +1,48 @@
+package models
+
+import "strings"
+
+// RequestURIPrefix identifica os request_uri emitidos pelo endpoint de PAR (RFC 9126, seção 2.2).
+const RequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+
+// PushedAuthorizationPayload recebe no corpo os mesmos parâmetros do /authorize.
+type PushedAuthorizationPayload struct {
+	ClientAuthenticationPayload
+	RedirectURI         string   `form:"redirect_uri" validate:"required"`
+	ResponseType        string   `form:"response_type" validate:"required"`
+	Scope               string   `form:"scope" validate:"required"`
+	State               string   `form:"state" validate:"required"`
+	CodeChallenge       string   `form:"code_challenge" validate:"required"`
+	CodeChallengeMethod string   `form:"code_challenge_method" validate:"required"`
+	Resource            []string `form:"resource"`
+	Nonce               string   `form:"nonce"`
+	Prompt              string   `form:"prompt"`
+	MaxAge              *int64   `form:"max_age" validate:"omitempty,min=0"`
+	LoginHint           string   `form:"login_hint"`
+	IDTokenHint         string   `form:"id_token_hint"`
+	// RequestURI não pode ser enviado ao endpoint de PAR (RFC 9126, seção 2.1).
+	RequestURI string `form:"request_uri" validate:"isdefault"`
+}
+
+type PushedAuthorizationResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int64  `json:"expires_in"`
+}
+
+func NewPushedAuthorizationInput(payload PushedAuthorizationPayload) AuthorizeInput {
+	return AuthorizeInput{
+		ClientID:            payload.ClientID,
+		RedirectURI:         payload.RedirectURI,
+		ResponseType:        payload.ResponseType,
+		CodeChallenge:       payload.CodeChallenge,
+		CodeChallengeMethod: payload.CodeChallengeMethod,
+		State:               payload.State,
+		Scope:               strings.Split(payload.Scope, " "),
+		Resource:            payload.Resource,
+		Nonce:               payload.Nonce,
+		Prompt:              ParsePrompt(payload.Prompt),
+		MaxAge:              payload.MaxAge,
+		LoginHint:           payload.LoginHint,
+		IDTokenHint:         payload.IDTokenHint,
+	}
+}
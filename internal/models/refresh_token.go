@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type CreateRefreshTokenInput struct {
+	UserID    string
+	ClientID  string
+	FamilyID  string
+	Scopes    []string
+	Resources []string
+	AuthTime  time.Time
+	DPoPJKT   string
+}
@@ -0,0 +1,23 @@
+package models
+
+import "github.com/golang-jwt/jwt/v5"
+
+// RequestObjectClaims são os parâmetros de autorização assinados pelo cliente no
+// request object (RFC 9101, seção 4).
+type RequestObjectClaims struct {
+	jwt.RegisteredClaims
+	ClientID            string `json:"client_id"`
+	RedirectURI         string `json:"redirect_uri"`
+	ResponseType        string `json:"response_type"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	Nonce               string `json:"nonce,omitempty"`
+	Prompt              string `json:"prompt,omitempty"`
+	MaxAge              *int64 `json:"max_age,omitempty"`
+	LoginHint           string `json:"login_hint,omitempty"`
+	IDTokenHint         string `json:"id_token_hint,omitempty"`
+	// Resource aceita tanto uma string quanto uma lista (RFC 8707, seção 2).
+	Resource jwt.ClaimStrings `json:"resource,omitempty"`
+}
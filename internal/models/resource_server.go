@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateResourceServerPayload registra uma API. O identifier é uma URI absoluta sem
+// fragmento (RFC 8707, seção 2) e os escopos precisam existir no catálogo.
+type CreateResourceServerPayload struct {
+	Identifier string   `json:"identifier" validate:"required,uri"`
+	Name       string   `json:"name" validate:"required"`
+	Scopes     []string `json:"scopes" validate:"required,min=1,dive,required"`
+}
+
+type CreateResourceServerInput struct {
+	Identifier string
+	Name       string
+	Scopes     []string
+}
+
+type ResourceServerResponse struct {
+	ID         primitive.ObjectID `json:"id"`
+	Identifier string             `json:"identifier"`
+	Name       string             `json:"name"`
+	Scopes     []string           `json:"scopes"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+func NewCreateResourceServerInput(payload CreateResourceServerPayload) CreateResourceServerInput {
+	return CreateResourceServerInput{
+		Identifier: payload.Identifier,
+		Name:       payload.Name,
+		Scopes:     payload.Scopes,
+	}
+}
+
+func ResourceServerToResponse(resourceServer *entities.ResourceServer) *ResourceServerResponse {
+	return &ResourceServerResponse{
+		ID:         resourceServer.ID,
+		Identifier: resourceServer.Identifier,
+		Name:       resourceServer.Name,
+		Scopes:     resourceServer.Scopes,
+		CreatedAt:  resourceServer.CreatedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package models
+
+// RevokePayload exige a autenticação do cliente dono do token (RFC 7009, seção 2.1).
+type RevokePayload struct {
+	ClientAuthenticationPayload
+	Token         string `form:"token" validate:"required"`
+	TokenTypeHint string `form:"token_type_hint"`
+}
+
+type RevokeTokenInput struct {
+	Token         string
+	TokenTypeHint string
+	ClientID      string
+}
+
+func NewRevokeTokenInput(payload RevokePayload) RevokeTokenInput {
+	return RevokeTokenInput{
+		Token:         payload.Token,
+		TokenTypeHint: payload.TokenTypeHint,
+		ClientID:      payload.ClientID,
+	}
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+)
+
+// Identificadores de tipo de token da RFC 8693, seção 3.
+const (
+	TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeJWT         = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+type TokenExchangeInput struct {
+	ClientID           string
+	SubjectToken       string
+	SubjectTokenType   string
+	ActorToken         string
+	ActorTokenType     string
+	RequestedTokenType string
+	Audience           []string
+	// Resource passa pelo registro de resource servers e restringe os escopos (RFC 8707).
+	Resource              []string
+	Scope                 []string
+	DPoPJKT               string
+	CertificateThumbprint string
+	// PresentedCertificateThumbprint é o certificado da conexão mTLS, exigido para trocar
+	// tokens vinculados a um certificado mesmo quando o cliente não usa tls_client_auth.
+	PresentedCertificateThumbprint string
+}
+
+// TokenExchangePolicyPayload define para quais audiências e escopos o cliente pode trocar tokens.
+type TokenExchangePolicyPayload struct {
+	Audiences        []string `json:"audiences" validate:"required,min=1,dive,required"`
+	Scopes           []string `json:"scopes" validate:"omitempty,dive,required"`
+	AllowDelegation  bool     `json:"allow_delegation"`
+	SubjectAudiences []string `json:"subject_audiences" validate:"omitempty,dive,required"`
+}
+
+func NewTokenExchangeInput(payload TokenPayload) TokenExchangeInput {
+	return TokenExchangeInput{
+		ClientID:              payload.ClientID,
+		SubjectToken:          payload.SubjectToken,
+		SubjectTokenType:      payload.SubjectTokenType,
+		ActorToken:            payload.ActorToken,
+		ActorTokenType:        payload.ActorTokenType,
+		RequestedTokenType:    payload.RequestedTokenType,
+		Audience:              payload.Audience,
+		Resource:              payload.Resource,
+		Scope:                 scopes.ParseScopes(payload.Scope),
+		DPoPJKT:               payload.DPoPJKT,
+		CertificateThumbprint: payload.CertificateThumbprint,
+	}
+}
+
+func NewTokenExchangePolicy(payload *TokenExchangePolicyPayload) *entities.TokenExchangePolicy {
+	if payload == nil {
+		return nil
+	}
+
+	return &entities.TokenExchangePolicy{
+		Audiences:        payload.Audiences,
+		Scopes:           payload.Scopes,
+		AllowDelegation:  payload.AllowDelegation,
+		SubjectAudiences: payload.SubjectAudiences,
+	}
+}
@@ -0,0 +1,29 @@
+package models
+
+import "github.com/golang-jwt/jwt/v5"
+
+// ContentTypeJWT é o content type da resposta assinada do UserInfo (OIDC Core, seção 5.3.2).
+const ContentTypeJWT = "application/jwt"
+
+type UserInfoInput struct {
+	UserID   string
+	ClientID string
+	Scopes   []string
+}
+
+// UserInfoClaims é a resposta do UserInfo. Sem assinatura só sub é preenchido entre as
+// claims registradas; na resposta assinada também vão iss, aud e iat.
+type UserInfoClaims struct {
+	jwt.RegisteredClaims
+	Name          string `json:"name,omitempty"`
+	GivenName     string `json:"given_name,omitempty"`
+	FamilyName    string `json:"family_name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+}
+
+// UserInfoResponse carrega as claims em JSON ou, se o cliente pediu, o JWT assinado.
+type UserInfoResponse struct {
+	Claims *UserInfoClaims
+	JWT    string
+}
@@ -9,12 +9,15 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type AuthorizationCodeRepository interface {
 	Create(ctx context.Context, authorizationCode *entities.AuthorizationCode) error
 	FindByCode(ctx context.Context, code string) (*entities.AuthorizationCode, error)
+	Consume(ctx context.Context, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID string) (*entities.AuthorizationCode, error)
 	Delete(ctx context.Context, id string) error
+	DeleteUnconsumedByUserAndClient(ctx context.Context, userID, clientID string) error
 }
 
 type authorizationCodeRepository struct {
@@ -23,7 +26,7 @@ type authorizationCodeRepository struct {
 
 func NewAuthorizationCodeRepository(db *mongo.Database) AuthorizationCodeRepository {
 	return &authorizationCodeRepository{
-		collection: db.Collection("authorization_codes"),
+		collection: db.Collection(authorizationCodesCollection),
 	}
 }
 
@@ -56,6 +59,38 @@ func (r *authorizationCodeRepository) FindByCode(ctx context.Context, code strin
 	return &authorizationCode, nil
 }
 
+// Consume marca o código como usado somente se ainda pertencer ao cliente e ao
+// redirect_uri da troca e não tiver sido consumido.
+func (r *authorizationCodeRepository) Consume(ctx context.Context, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID string) (*entities.AuthorizationCode, error) {
+	filter := bson.M{
+		"code":         code,
+		"client_id":    clientID,
+		"redirect_uri": redirectURI,
+		"consumed_at":  bson.M{"$exists": false},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"consumed_at":             time.Now().UTC(),
+			"access_token_id":         accessTokenID,
+			"refresh_token_family_id": refreshTokenFamilyID,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var authorizationCode entities.AuthorizationCode
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&authorizationCode); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrAuthorizationCodeNotFound
+		}
+
+		return nil, err
+	}
+
+	return &authorizationCode, nil
+}
+
 func (r *authorizationCodeRepository) Delete(ctx context.Context, id string) error {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -68,3 +103,19 @@ func (r *authorizationCodeRepository) Delete(ctx context.Context, id string) err
 
 	return nil
 }
+
+// DeleteUnconsumedByUserAndClient descarta os códigos ainda não trocados, para que um
+// cliente desconectado não obtenha tokens com um código emitido antes da desconexão.
+func (r *authorizationCodeRepository) DeleteUnconsumedByUserAndClient(ctx context.Context, userID, clientID string) error {
+	filter := bson.M{
+		"user_id":     userID,
+		"client_id":   clientID,
+		"consumed_at": bson.M{"$exists": false},
+	}
+
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -14,6 +14,10 @@ import (
 type ClientRepository interface {
 	Create(ctx context.Context, client *entities.Client) error
 	GetByClientID(ctx context.Context, clientID string) (*entities.Client, error)
+	UpdateFirstParty(ctx context.Context, clientID string, firstParty bool) error
+	UpdateTokenExchangePolicy(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy) error
+	AddScope(ctx context.Context, clientID, scope string) error
+	RemoveScope(ctx context.Context, clientID, scope string) error
 }
 
 type clientRepository struct {
@@ -22,7 +26,7 @@ type clientRepository struct {
 
 func NewClientRepository(db *mongo.Database) ClientRepository {
 	return &clientRepository{
-		collection: db.Collection("clients"),
+		collection: db.Collection(clientsCollection),
 	}
 }
 
@@ -36,6 +40,10 @@ func (r *clientRepository) Create(ctx context.Context, client *entities.Client)
 	}
 
 	if _, err := r.collection.InsertOne(ctx, client); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrClientAlreadyExists
+		}
+
 		return err
 	}
 
@@ -54,3 +62,44 @@ func (r *clientRepository) GetByClientID(ctx context.Context, clientID string) (
 
 	return &client, nil
 }
+
+func (r *clientRepository) UpdateFirstParty(ctx context.Context, clientID string, firstParty bool) error {
+	return r.update(ctx, clientID, bson.M{"first_party": firstParty})
+}
+
+func (r *clientRepository) UpdateTokenExchangePolicy(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy) error {
+	return r.update(ctx, clientID, bson.M{"token_exchange_policy": policy})
+}
+
+func (r *clientRepository) AddScope(ctx context.Context, clientID, scope string) error {
+	return r.updateOne(ctx, clientID, bson.M{
+		"$addToSet": bson.M{"scopes": scope},
+		"$set":      bson.M{"updated_at": time.Now().UTC()},
+	})
+}
+
+func (r *clientRepository) RemoveScope(ctx context.Context, clientID, scope string) error {
+	return r.updateOne(ctx, clientID, bson.M{
+		"$pull": bson.M{"scopes": scope},
+		"$set":  bson.M{"updated_at": time.Now().UTC()},
+	})
+}
+
+func (r *clientRepository) update(ctx context.Context, clientID string, fields bson.M) error {
+	fields["updated_at"] = time.Now().UTC()
+
+	return r.updateOne(ctx, clientID, bson.M{"$set": fields})
+}
+
+func (r *clientRepository) updateOne(ctx context.Context, clientID string, update bson.M) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{"client_id": clientID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrClientNotFound
+	}
+
+	return nil
+}
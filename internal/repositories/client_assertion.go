@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ClientAssertionRepository interface {
+	Create(ctx context.Context, assertion *entities.ClientAssertion) error
+}
+
+type clientAssertionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewClientAssertionRepository(db *mongo.Database) ClientAssertionRepository {
+	return &clientAssertionRepository{
+		collection: db.Collection(clientAssertionsCollection),
+	}
+}
+
+// Create grava o jti da asserção. O índice único em client_id+jti torna a checagem
+// atômica: uma segunda inserção do mesmo jti falha com ErrClientAssertionReplayed.
+func (r *clientAssertionRepository) Create(ctx context.Context, assertion *entities.ClientAssertion) error {
+	if assertion.ID.IsZero() {
+		assertion.ID = primitive.NewObjectID()
+	}
+
+	assertion.CreatedAt = time.Now().UTC()
+
+	if _, err := r.collection.InsertOne(ctx, assertion); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrClientAssertionReplayed
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ConsentRepository interface {
+	FindByUserAndClient(ctx context.Context, userID, clientID string) (*entities.Consent, error)
+	FindByUser(ctx context.Context, userID string) ([]*entities.Consent, error)
+	Save(ctx context.Context, consent *entities.Consent) error
+	Grant(ctx context.Context, userID, clientID string, scopes []string) error
+	RecordUse(ctx context.Context, userID, clientID string, scopes []string) error
+	Delete(ctx context.Context, userID, clientID string) error
+}
+
+type consentRepository struct {
+	collection *mongo.Collection
+}
+
+func NewConsentRepository(db *mongo.Database) ConsentRepository {
+	return &consentRepository{
+		collection: db.Collection(consentsCollection),
+	}
+}
+
+func (r *consentRepository) FindByUserAndClient(ctx context.Context, userID, clientID string) (*entities.Consent, error) {
+	filter := bson.M{
+		"user_id":   userID,
+		"client_id": clientID,
+	}
+
+	var consent entities.Consent
+	if err := r.collection.FindOne(ctx, filter).Decode(&consent); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrConsentNotFound
+		}
+
+		return nil, err
+	}
+
+	return &consent, nil
+}
+
+func (r *consentRepository) FindByUser(ctx context.Context, userID string) ([]*entities.Consent, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var consents []*entities.Consent
+	if err := cursor.All(ctx, &consents); err != nil {
+		return nil, err
+	}
+
+	return consents, nil
+}
+
+// Save mantém um único consentimento por usuário e cliente, substituindo os escopos.
+func (r *consentRepository) Save(ctx context.Context, consent *entities.Consent) error {
+	now := time.Now().UTC()
+	consent.UpdatedAt = now
+
+	filter := bson.M{
+		"user_id":   consent.UserID,
+		"client_id": consent.ClientID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"scopes":     consent.Scopes,
+			"updated_at": consent.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        primitive.NewObjectID(),
+			"created_at": now,
+		},
+	}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Grant soma escopos ao consentimento, criando-o se preciso. É usado quando o usuário
+// autoriza sem passar pela tela de consentimento (clientes first-party e device flow).
+func (r *consentRepository) Grant(ctx context.Context, userID, clientID string, scopes []string) error {
+	now := time.Now().UTC()
+
+	filter := bson.M{
+		"user_id":   userID,
+		"client_id": clientID,
+	}
+
+	update := bson.M{
+		"$addToSet": bson.M{
+			"scopes": bson.M{"$each": scopes},
+		},
+		"$set": bson.M{
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        primitive.NewObjectID(),
+			"created_at": now,
+		},
+	}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordUse registra a emissão de tokens no consentimento existente. Não cria o
+// registro: uma troca posterior à desconexão não pode recriar o consentimento apagado.
+func (r *consentRepository) RecordUse(ctx context.Context, userID, clientID string, scopes []string) error {
+	now := time.Now().UTC()
+
+	filter := bson.M{
+		"user_id":   userID,
+		"client_id": clientID,
+	}
+
+	update := bson.M{
+		"$addToSet": bson.M{
+			"scopes": bson.M{"$each": scopes},
+		},
+		"$set": bson.M{
+			"last_used_at": now,
+			"updated_at":   now,
+		},
+	}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *consentRepository) Delete(ctx context.Context, userID, clientID string) error {
+	filter := bson.M{
+		"user_id":   userID,
+		"client_id": clientID,
+	}
+
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrConsentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ConsentRequestRepository interface {
+	Create(ctx context.Context, request *entities.ConsentRequest) error
+	FindPending(ctx context.Context, challengeHash, userID string) (*entities.ConsentRequest, error)
+	Decide(ctx context.Context, challengeHash, userID, status string) error
+	ConsumeApproved(ctx context.Context, challengeHash, userID, clientID string) (*entities.ConsentRequest, error)
+}
+
+type consentRequestRepository struct {
+	collection *mongo.Collection
+}
+
+func NewConsentRequestRepository(db *mongo.Database) ConsentRequestRepository {
+	return &consentRequestRepository{
+		collection: db.Collection(consentRequestsCollection),
+	}
+}
+
+func (r *consentRequestRepository) Create(ctx context.Context, request *entities.ConsentRequest) error {
+	if request.ID.IsZero() {
+		request.ID = primitive.NewObjectID()
+	}
+
+	request.CreatedAt = time.Now().UTC()
+
+	if _, err := r.collection.InsertOne(ctx, request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *consentRequestRepository) FindPending(ctx context.Context, challengeHash, userID string) (*entities.ConsentRequest, error) {
+	filter := bson.M{
+		"challenge_hash": challengeHash,
+		"user_id":        userID,
+		"status":         entities.ConsentRequestStatusPending,
+		"expires_at":     bson.M{"$gt": time.Now().UTC()},
+	}
+
+	var request entities.ConsentRequest
+	if err := r.collection.FindOne(ctx, filter).Decode(&request); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrConsentRequestNotFound
+		}
+
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// Decide registra a decisão do usuário apenas enquanto a requisição estiver pendente.
+func (r *consentRequestRepository) Decide(ctx context.Context, challengeHash, userID, status string) error {
+	filter := bson.M{
+		"challenge_hash": challengeHash,
+		"user_id":        userID,
+		"status":         entities.ConsentRequestStatusPending,
+		"expires_at":     bson.M{"$gt": time.Now().UTC()},
+	}
+
+	update := bson.M{
+		"$set": bson.M{"status": status},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrConsentRequestNotFound
+	}
+
+	return nil
+}
+
+// ConsumeApproved remove e retorna a requisição aprovada; o consent_challenge só
+// libera uma autorização.
+func (r *consentRequestRepository) ConsumeApproved(ctx context.Context, challengeHash, userID, clientID string) (*entities.ConsentRequest, error) {
+	filter := bson.M{
+		"challenge_hash": challengeHash,
+		"user_id":        userID,
+		"client_id":      clientID,
+		"status":         entities.ConsentRequestStatusApproved,
+		"expires_at":     bson.M{"$gt": time.Now().UTC()},
+	}
+
+	var request entities.ConsentRequest
+	if err := r.collection.FindOneAndDelete(ctx, filter).Decode(&request); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrConsentRequestNotFound
+		}
+
+		return nil, err
+	}
+
+	return &request, nil
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DeviceCodeRepository interface {
+	Create(ctx context.Context, deviceCode *entities.DeviceCode) error
+	FindByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (*entities.DeviceCode, error)
+	FindPendingByUserCode(ctx context.Context, userCode string) (*entities.DeviceCode, error)
+	Decide(ctx context.Context, userCode, userID, status string) error
+	UpdatePolling(ctx context.Context, id string, polledAt time.Time, interval time.Duration) error
+	Consume(ctx context.Context, id string) (*entities.DeviceCode, error)
+	DenyApprovedByUserAndClient(ctx context.Context, userID, clientID string) error
+}
+
+type deviceCodeRepository struct {
+	collection *mongo.Collection
+}
+
+func NewDeviceCodeRepository(db *mongo.Database) DeviceCodeRepository {
+	return &deviceCodeRepository{
+		collection: db.Collection(deviceCodesCollection),
+	}
+}
+
+func (r *deviceCodeRepository) Create(ctx context.Context, deviceCode *entities.DeviceCode) error {
+	if deviceCode.ID.IsZero() {
+		deviceCode.ID = primitive.NewObjectID()
+	}
+
+	deviceCode.CreatedAt = time.Now().UTC()
+
+	if _, err := r.collection.InsertOne(ctx, deviceCode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *deviceCodeRepository) FindByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (*entities.DeviceCode, error) {
+	filter := bson.M{"device_code_hash": deviceCodeHash}
+
+	var deviceCode entities.DeviceCode
+	if err := r.collection.FindOne(ctx, filter).Decode(&deviceCode); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrDeviceCodeNotFound
+		}
+
+		return nil, err
+	}
+
+	return &deviceCode, nil
+}
+
+func (r *deviceCodeRepository) FindPendingByUserCode(ctx context.Context, userCode string) (*entities.DeviceCode, error) {
+	filter := bson.M{
+		"user_code":  userCode,
+		"status":     entities.DeviceCodeStatusPending,
+		"expires_at": bson.M{"$gt": time.Now().UTC()},
+	}
+
+	var deviceCode entities.DeviceCode
+	if err := r.collection.FindOne(ctx, filter).Decode(&deviceCode); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrUserCodeNotFound
+		}
+
+		return nil, err
+	}
+
+	return &deviceCode, nil
+}
+
+// Decide registra a decisão do usuário apenas enquanto a autorização estiver pendente,
+// impedindo que um user_code seja reutilizado.
+func (r *deviceCodeRepository) Decide(ctx context.Context, userCode, userID, status string) error {
+	filter := bson.M{
+		"user_code":  userCode,
+		"status":     entities.DeviceCodeStatusPending,
+		"expires_at": bson.M{"$gt": time.Now().UTC()},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":  status,
+			"user_id": userID,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrUserCodeNotFound
+	}
+
+	return nil
+}
+
+func (r *deviceCodeRepository) UpdatePolling(ctx context.Context, id string, polledAt time.Time, interval time.Duration) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"last_polled_at": polledAt,
+			"interval":       interval,
+		},
+	}
+
+	if _, err := r.collection.UpdateByID(ctx, objectID, update); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Consume marca a autorização aprovada como usada, garantindo que o device_code
+// seja trocado por tokens uma única vez.
+func (r *deviceCodeRepository) Consume(ctx context.Context, id string) (*entities.DeviceCode, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"_id":    objectID,
+		"status": entities.DeviceCodeStatusApproved,
+	}
+
+	update := bson.M{
+		"$set": bson.M{"status": entities.DeviceCodeStatusConsumed},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var deviceCode entities.DeviceCode
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&deviceCode); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrDeviceCodeNotFound
+		}
+
+		return nil, err
+	}
+
+	return &deviceCode, nil
+}
+
+// DenyApprovedByUserAndClient recusa as autorizações aprovadas e ainda não trocadas;
+// o dispositivo passa a receber access_denied no polling.
+func (r *deviceCodeRepository) DenyApprovedByUserAndClient(ctx context.Context, userID, clientID string) error {
+	filter := bson.M{
+		"user_id":   userID,
+		"client_id": clientID,
+		"status":    entities.DeviceCodeStatusApproved,
+	}
+
+	update := bson.M{
+		"$set": bson.M{"status": entities.DeviceCodeStatusDenied},
+	}
+
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	authorizationCodesCollection          = "authorization_codes"
+	clientAssertionsCollection            = "client_assertions"
+	clientsCollection                     = "clients"
+	consentRequestsCollection             = "consent_requests"
+	consentsCollection                    = "consents"
+	deviceCodesCollection                 = "device_codes"
+	otpsCollection                        = "otps"
+	pushedAuthorizationRequestsCollection = "pushed_authorization_requests"
+	refreshTokensCollection               = "refresh_tokens"
+	resourceServersCollection             = "resource_servers"
+	revokedTokensCollection               = "revoked_tokens"
+	signingKeysCollection                 = "signing_keys"
+	usersCollection                       = "users"
+)
+
+// collectionIndexes lista os índices que o servidor depende para garantir unicidade
+// e expiração dos documentos. Os TTL em expires_at removem o documento quando ele
+// expira; as consultas continuam filtrando por expires_at, já que o monitor de TTL
+// do MongoDB roda só a cada 60 segundos.
+var collectionIndexes = map[string][]mongo.IndexModel{
+	// Sem TTL: o código consumido precisa continuar no banco depois de expirar para
+	// detectar a reutilização e revogar os tokens emitidos com ele.
+	authorizationCodesCollection: {
+		uniqueIndex("code"),
+		index("user_id", "client_id"),
+	},
+	clientAssertionsCollection: {
+		uniqueIndex("client_id", "jti"),
+		ttlIndex(),
+	},
+	clientsCollection: {
+		uniqueIndex("client_id"),
+	},
+	consentRequestsCollection: {
+		uniqueIndex("challenge_hash"),
+		ttlIndex(),
+	},
+	consentsCollection: {
+		uniqueIndex("user_id", "client_id"),
+	},
+	deviceCodesCollection: {
+		uniqueIndex("device_code_hash"),
+		index("user_code"),
+		index("user_id", "client_id"),
+		ttlIndex(),
+	},
+	otpsCollection: {
+		ttlIndex(),
+	},
+	pushedAuthorizationRequestsCollection: {
+		uniqueIndex("request_uri_hash"),
+		ttlIndex(),
+	},
+	refreshTokensCollection: {
+		uniqueIndex("token_hash"),
+		index("family_id"),
+		index("user_id", "client_id"),
+		ttlIndex(),
+	},
+	resourceServersCollection: {
+		uniqueIndex("identifier"),
+	},
+	revokedTokensCollection: {
+		uniqueIndex("jti"),
+		ttlIndex(),
+	},
+	signingKeysCollection: {
+		uniqueIndex("kid"),
+		index("algorithm", "status"),
+	},
+	usersCollection: {
+		uniqueIndex("email"),
+	},
+}
+
+// EnsureIndexes cria os índices das coleções; CreateMany não altera índices que já
+// existem com a mesma definição, então pode rodar a cada inicialização.
+func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
+	for collection, indexes := range collectionIndexes {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes); err != nil {
+			return fmt.Errorf("create %s indexes: %w", collection, err)
+		}
+	}
+
+	return nil
+}
+
+func index(fields ...string) mongo.IndexModel {
+	keys := bson.D{}
+	for _, field := range fields {
+		keys = append(keys, bson.E{Key: field, Value: 1})
+	}
+
+	return mongo.IndexModel{Keys: keys}
+}
+
+func uniqueIndex(fields ...string) mongo.IndexModel {
+	model := index(fields...)
+	model.Options = options.Index().SetUnique(true)
+
+	return model
+}
+
+func ttlIndex() mongo.IndexModel {
+	model := index("expires_at")
+	model.Options = options.Index().SetExpireAfterSeconds(0)
+
+	return model
+}
@@ -24,7 +24,7 @@ type otpRepository struct {
 
 func NewOTPRepository(db *mongo.Database) OTPRepository {
 	return &otpRepository{
-		collection: db.Collection("otps"),
+		collection: db.Collection(otpsCollection),
 	}
 }
 
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PushedAuthorizationRequestRepository interface {
+	Create(ctx context.Context, request *entities.PushedAuthorizationRequest) error
+	Redeem(ctx context.Context, requestURIHash string, expiresAt time.Time) (*entities.PushedAuthorizationRequest, error)
+	Consume(ctx context.Context, requestURIHash string) (*entities.PushedAuthorizationRequest, error)
+}
+
+type pushedAuthorizationRequestRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPushedAuthorizationRequestRepository(db *mongo.Database) PushedAuthorizationRequestRepository {
+	return &pushedAuthorizationRequestRepository{
+		collection: db.Collection(pushedAuthorizationRequestsCollection),
+	}
+}
+
+func (r *pushedAuthorizationRequestRepository) Create(ctx context.Context, request *entities.PushedAuthorizationRequest) error {
+	if request.ID.IsZero() {
+		request.ID = primitive.NewObjectID()
+	}
+
+	request.CreatedAt = time.Now().UTC()
+
+	if _, err := r.collection.InsertOne(ctx, request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Redeem retorna a requisição ainda válida sem consumi-la; o /authorize precisa lê-la
+// antes do login para tratar prompt e max_age. No primeiro uso a validade passa a
+// expiresAt, para que o request_uri sobreviva ao login e ao consentimento.
+func (r *pushedAuthorizationRequestRepository) Redeem(ctx context.Context, requestURIHash string, expiresAt time.Time) (*entities.PushedAuthorizationRequest, error) {
+	now := time.Now().UTC()
+	filter := bson.M{
+		"request_uri_hash": requestURIHash,
+		"expires_at":       bson.M{"$gt": now},
+		"redeemed_at":      bson.M{"$exists": false},
+	}
+	update := bson.M{"$set": bson.M{"redeemed_at": now, "expires_at": expiresAt.UTC()}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var request entities.PushedAuthorizationRequest
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&request)
+	if err == nil {
+		return &request, nil
+	}
+
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	// Já resgatado: a validade estendida no primeiro uso não é renovada.
+	return r.findByRequestURIHash(ctx, requestURIHash)
+}
+
+func (r *pushedAuthorizationRequestRepository) findByRequestURIHash(ctx context.Context, requestURIHash string) (*entities.PushedAuthorizationRequest, error) {
+	filter := bson.M{
+		"request_uri_hash": requestURIHash,
+		"expires_at":       bson.M{"$gt": time.Now().UTC()},
+	}
+
+	var request entities.PushedAuthorizationRequest
+	if err := r.collection.FindOne(ctx, filter).Decode(&request); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrInvalidRequestURI
+		}
+
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// Consume remove e retorna a requisição ainda válida; cada request_uri só pode ser
+// usado uma vez (RFC 9126, seção 4).
+func (r *pushedAuthorizationRequestRepository) Consume(ctx context.Context, requestURIHash string) (*entities.PushedAuthorizationRequest, error) {
+	filter := bson.M{
+		"request_uri_hash": requestURIHash,
+		"expires_at":       bson.M{"$gt": time.Now().UTC()},
+	}
+
+	var request entities.PushedAuthorizationRequest
+	if err := r.collection.FindOneAndDelete(ctx, filter).Decode(&request); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrInvalidRequestURI
+		}
+
+		return nil, err
+	}
+
+	return &request, nil
+}
@@ -14,6 +14,9 @@ import (
 type RefreshTokenRepository interface {
 	Create(ctx context.Context, refreshToken *entities.RefreshToken) error
 	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	Revoke(ctx context.Context, id string) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeByUserAndClient(ctx context.Context, userID, clientID string) error
 }
 
 type refreshTokenRepository struct {
@@ -22,7 +25,7 @@ type refreshTokenRepository struct {
 
 func NewRefreshTokenRepository(db *mongo.Database) RefreshTokenRepository {
 	return &refreshTokenRepository{
-		collection: db.Collection("refresh_tokens"),
+		collection: db.Collection(refreshTokensCollection),
 	}
 }
 
@@ -56,3 +59,71 @@ func (r *refreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash
 
 	return &refreshToken, nil
 }
+
+func (r *refreshTokenRepository) Revoke(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrInvalidObjectID
+	}
+
+	filter := bson.M{
+		"_id":        objectID,
+		"revoked_at": bson.M{"$exists": false},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"revoked_at": time.Now().UTC(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.ModifiedCount == 0 {
+		return domain.ErrRefreshTokenRevoked
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	filter := bson.M{
+		"family_id":  familyID,
+		"revoked_at": bson.M{"$exists": false},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"revoked_at": time.Now().UTC(),
+		},
+	}
+
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeByUserAndClient(ctx context.Context, userID, clientID string) error {
+	filter := bson.M{
+		"user_id":    userID,
+		"client_id":  clientID,
+		"revoked_at": bson.M{"$exists": false},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"revoked_at": time.Now().UTC(),
+		},
+	}
+
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ResourceServerRepository interface {
+	Create(ctx context.Context, resourceServer *entities.ResourceServer) error
+	GetByIdentifier(ctx context.Context, identifier string) (*entities.ResourceServer, error)
+}
+
+type resourceServerRepository struct {
+	collection *mongo.Collection
+}
+
+func NewResourceServerRepository(db *mongo.Database) ResourceServerRepository {
+	return &resourceServerRepository{
+		collection: db.Collection(resourceServersCollection),
+	}
+}
+
+func (r *resourceServerRepository) Create(ctx context.Context, resourceServer *entities.ResourceServer) error {
+	if resourceServer.ID.IsZero() {
+		resourceServer.ID = primitive.NewObjectID()
+	}
+
+	if resourceServer.CreatedAt.IsZero() {
+		resourceServer.CreatedAt = time.Now().UTC()
+	}
+
+	if _, err := r.collection.InsertOne(ctx, resourceServer); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrResourceServerAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *resourceServerRepository) GetByIdentifier(ctx context.Context, identifier string) (*entities.ResourceServer, error) {
+	var resourceServer entities.ResourceServer
+	if err := r.collection.FindOne(ctx, bson.M{"identifier": identifier}).Decode(&resourceServer); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrResourceServerNotFound
+		}
+
+		return nil, err
+	}
+
+	return &resourceServer, nil
+}
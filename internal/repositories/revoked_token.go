@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type RevokedTokenRepository interface {
+	Create(ctx context.Context, revokedToken *entities.RevokedToken) error
+	ExistsByJTI(ctx context.Context, jti string) (bool, error)
+}
+
+type revokedTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRevokedTokenRepository(db *mongo.Database) RevokedTokenRepository {
+	return &revokedTokenRepository{
+		collection: db.Collection(revokedTokensCollection),
+	}
+}
+
+func (r *revokedTokenRepository) Create(ctx context.Context, revokedToken *entities.RevokedToken) error {
+	if revokedToken.ID.IsZero() {
+		revokedToken.ID = primitive.NewObjectID()
+	}
+
+	revokedToken.RevokedAt = time.Now().UTC()
+
+	// Revogar de novo o mesmo jti não é erro: o token já está na lista.
+	if _, err := r.collection.InsertOne(ctx, revokedToken); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *revokedTokenRepository) ExistsByJTI(ctx context.Context, jti string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"jti": jti})
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SigningKeyRepository interface {
+	Create(ctx context.Context, signingKey *entities.SigningKey) error
+	FindByKID(ctx context.Context, kid string) (*entities.SigningKey, error)
+	FindByStatus(ctx context.Context, algorithm string, status entities.SigningKeyStatus) (*entities.SigningKey, error)
+	FindVerifiable(ctx context.Context) ([]*entities.SigningKey, error)
+	Activate(ctx context.Context, kid string) error
+	Retire(ctx context.Context, kid string, verifyUntil time.Time) error
+}
+
+type signingKeyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSigningKeyRepository(db *mongo.Database) SigningKeyRepository {
+	return &signingKeyRepository{
+		collection: db.Collection(signingKeysCollection),
+	}
+}
+
+func (r *signingKeyRepository) Create(ctx context.Context, signingKey *entities.SigningKey) error {
+	if signingKey.ID.IsZero() {
+		signingKey.ID = primitive.NewObjectID()
+	}
+
+	signingKey.CreatedAt = time.Now().UTC()
+
+	if _, err := r.collection.InsertOne(ctx, signingKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *signingKeyRepository) FindByKID(ctx context.Context, kid string) (*entities.SigningKey, error) {
+	var signingKey entities.SigningKey
+	if err := r.collection.FindOne(ctx, bson.M{"kid": kid}).Decode(&signingKey); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrSigningKeyNotFound
+		}
+
+		return nil, err
+	}
+
+	return &signingKey, nil
+}
+
+// FindByStatus retorna a chave mais recente do algoritmo no status informado.
+func (r *signingKeyRepository) FindByStatus(ctx context.Context, algorithm string, status entities.SigningKeyStatus) (*entities.SigningKey, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var signingKey entities.SigningKey
+	if err := r.collection.FindOne(ctx, bson.M{"algorithm": algorithm, "status": status}, opts).Decode(&signingKey); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrSigningKeyNotFound
+		}
+
+		return nil, err
+	}
+
+	return &signingKey, nil
+}
+
+func (r *signingKeyRepository) FindVerifiable(ctx context.Context) ([]*entities.SigningKey, error) {
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"status": bson.M{"$in": bson.A{entities.SigningKeyStatusPending, entities.SigningKeyStatusActive}}},
+			bson.M{"status": entities.SigningKeyStatusRetired, "verify_until": bson.M{"$gt": time.Now().UTC()}},
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var signingKeys []*entities.SigningKey
+	if err := cursor.All(ctx, &signingKeys); err != nil {
+		return nil, err
+	}
+
+	return signingKeys, nil
+}
+
+func (r *signingKeyRepository) Activate(ctx context.Context, kid string) error {
+	update := bson.M{
+		"$set": bson.M{
+			"status":       entities.SigningKeyStatusActive,
+			"activated_at": time.Now().UTC(),
+		},
+	}
+
+	return r.updateStatus(ctx, kid, entities.SigningKeyStatusPending, update)
+}
+
+func (r *signingKeyRepository) Retire(ctx context.Context, kid string, verifyUntil time.Time) error {
+	update := bson.M{
+		"$set": bson.M{
+			"status":       entities.SigningKeyStatusRetired,
+			"retired_at":   time.Now().UTC(),
+			"verify_until": verifyUntil,
+		},
+	}
+
+	return r.updateStatus(ctx, kid, entities.SigningKeyStatusActive, update)
+}
+
+// updateStatus só aplica a transição se a chave ainda estiver no status esperado,
+// evitando que duas rotações concorrentes promovam chaves diferentes.
+func (r *signingKeyRepository) updateStatus(ctx context.Context, kid string, from entities.SigningKeyStatus, update bson.M) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{"kid": kid, "status": from}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrSigningKeyNotFound
+	}
+
+	return nil
+}
@@ -23,7 +23,7 @@ type userRepository struct {
 
 func NewUserRepository(db *mongo.Database) UserRepository {
 	return &userRepository{
-		collection: db.Collection("users"),
+		collection: db.Collection(usersCollection),
 	}
 }
 
@@ -35,6 +35,10 @@ func (u *userRepository) Create(ctx context.Context, user *entities.User) error
 	user.CreatedAt = time.Now()
 	_, err := u.collection.InsertOne(ctx, user)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrUserAlreadyExists
+		}
+
 		return err
 	}
 
@@ -9,10 +9,21 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func RegisterRoutes(apiGroup *echo.Group, env *configs.Environment, clientHandler handlers.ClientHandler, authHandler handlers.AuthHandler, oauthHandler handlers.OAuthHandler, authMiddleware middlewares.AuthMiddleware) {
+func RegisterWellKnownRoutes(wellKnownGroup *echo.Group, wellKnownHandler handlers.WellKnownHandler) {
+	wellKnownGroup.GET("/openid-configuration", wellKnownHandler.OpenIDConfiguration)
+	wellKnownGroup.GET("/oauth-authorization-server", wellKnownHandler.OAuthAuthorizationServer)
+	wellKnownGroup.GET("/jwks.json", wellKnownHandler.JWKS).Name = handlers.RouteJWKS
+}
+
+func RegisterRoutes(apiGroup *echo.Group, env *configs.Environment, clientHandler handlers.ClientHandler, authHandler handlers.AuthHandler, oauthHandler handlers.OAuthHandler, deviceHandler handlers.DeviceHandler, consentHandler handlers.ConsentHandler, grantHandler handlers.GrantHandler, resourceServerHandler handlers.ResourceServerHandler, userInfoHandler handlers.UserInfoHandler, authMiddleware middlewares.AuthMiddleware) {
 	registerClientRoutes(apiGroup, clientHandler)
+	registerResourceServerRoutes(apiGroup, resourceServerHandler, authMiddleware)
 	registerAuthRoutes(apiGroup, authHandler, authMiddleware)
 	registerOAuthRoutes(apiGroup, oauthHandler, authMiddleware)
+	registerDeviceRoutes(apiGroup, deviceHandler, authMiddleware)
+	registerConsentRoutes(apiGroup, consentHandler, authMiddleware)
+	registerGrantRoutes(apiGroup, grantHandler, authMiddleware)
+	registerUserInfoRoutes(apiGroup, userInfoHandler, authMiddleware)
 	registerDevRoutes(apiGroup, env)
 }
 
@@ -32,6 +43,12 @@ func registerClientRoutes(group *echo.Group, clientHandler handlers.ClientHandle
 	group.POST("/clients", clientHandler.CreateClient)
 }
 
+// registerResourceServerRoutes exige um token com escopo administrativo: o registro
+// define quais escopos cada audiência pode receber.
+func registerResourceServerRoutes(group *echo.Group, resourceServerHandler handlers.ResourceServerHandler, authMiddleware middlewares.AuthMiddleware) {
+	group.POST("/resource-servers", resourceServerHandler.CreateResourceServer, authMiddleware.EnsureBearerScope("resource_servers:create"))
+}
+
 func registerAuthRoutes(group *echo.Group, h handlers.AuthHandler, authMiddleware middlewares.AuthMiddleware) {
 	authGroup := group.Group("/auth")
 
@@ -44,6 +61,38 @@ func registerAuthRoutes(group *echo.Group, h handlers.AuthHandler, authMiddlewar
 func registerOAuthRoutes(group *echo.Group, h handlers.OAuthHandler, authMiddleware middlewares.AuthMiddleware) {
 	oauthGroup := group.Group("/oauth")
 
-	oauthGroup.GET("/authorize", h.Authorize, authMiddleware.AttachUserClaimsIfAuthenticated())
-	oauthGroup.POST("/token", h.Token)
+	oauthGroup.GET("/authorize", h.Authorize, authMiddleware.AttachUserClaimsIfAuthenticated()).Name = handlers.RouteOAuthAuthorize
+	oauthGroup.POST("/token", h.Token).Name = handlers.RouteOAuthToken
+	oauthGroup.POST("/introspect", h.Introspect, authMiddleware.EnsureBearerScope("token:introspect")).Name = handlers.RouteOAuthIntrospect
+	oauthGroup.POST("/revoke", h.Revoke).Name = handlers.RouteOAuthRevoke
+	oauthGroup.POST("/par", h.PushedAuthorization).Name = handlers.RouteOAuthPushedAuthorization
+}
+
+func registerDeviceRoutes(group *echo.Group, h handlers.DeviceHandler, authMiddleware middlewares.AuthMiddleware) {
+	oauthGroup := group.Group("/oauth")
+
+	oauthGroup.POST("/device_authorization", h.DeviceAuthorization).Name = handlers.RouteOAuthDeviceAuthorization
+	oauthGroup.GET("/device", h.DeviceVerification, authMiddleware.AttachUserClaimsIfAuthenticated()).Name = handlers.RouteOAuthDevice
+	oauthGroup.POST("/device", h.DeviceDecision, authMiddleware.EnsureAuthenticated())
+}
+
+func registerConsentRoutes(group *echo.Group, h handlers.ConsentHandler, authMiddleware middlewares.AuthMiddleware) {
+	oauthGroup := group.Group("/oauth")
+
+	oauthGroup.GET("/consent", h.GetConsentRequest, authMiddleware.EnsureAuthenticated())
+	oauthGroup.POST("/consent", h.DecideConsentRequest, authMiddleware.EnsureAuthenticated())
+}
+
+func registerGrantRoutes(group *echo.Group, h handlers.GrantHandler, authMiddleware middlewares.AuthMiddleware) {
+	meGroup := group.Group("/me")
+
+	meGroup.GET("/grants", h.ListGrants, authMiddleware.EnsureAuthenticated())
+	meGroup.DELETE("/grants/:client_id", h.RevokeGrant, authMiddleware.EnsureAuthenticated())
+}
+
+func registerUserInfoRoutes(group *echo.Group, h handlers.UserInfoHandler, authMiddleware middlewares.AuthMiddleware) {
+	oauthGroup := group.Group("/oauth")
+
+	oauthGroup.GET("/userinfo", h.UserInfo, authMiddleware.EnsureBearerScope("openid")).Name = handlers.RouteOAuthUserInfo
+	oauthGroup.POST("/userinfo", h.UserInfo, authMiddleware.EnsureBearerScope("openid"))
 }
@@ -3,38 +3,55 @@ package server
 import (
 	"fmt"
 	"log/slog"
+	"net/http"
 
 	"github.com/aetheris-lab/aetheris-id/api/configs"
 	"github.com/aetheris-lab/aetheris-id/api/internal/api"
 	"github.com/aetheris-lab/aetheris-id/api/internal/handlers"
 	"github.com/aetheris-lab/aetheris-id/api/internal/middlewares"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/mtls"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"golang.org/x/time/rate"
 )
 
 type Server struct {
-	echo *echo.Echo
-	port string
+	echo      *echo.Echo
+	port      string
+	tls       configs.TLS
+	clientCAs *mtls.ClientCAs
 }
 
-func NewServer(config *configs.Environment, clientHandler handlers.ClientHandler, authHandler handlers.AuthHandler, oauthHandler handlers.OAuthHandler, authMiddleware middlewares.AuthMiddleware) *Server {
+func NewServer(config *configs.Environment, clientHandler handlers.ClientHandler, authHandler handlers.AuthHandler, oauthHandler handlers.OAuthHandler, deviceHandler handlers.DeviceHandler, consentHandler handlers.ConsentHandler, grantHandler handlers.GrantHandler, wellKnownHandler handlers.WellKnownHandler, resourceServerHandler handlers.ResourceServerHandler, userInfoHandler handlers.UserInfoHandler, authMiddleware middlewares.AuthMiddleware, clientCAs *mtls.ClientCAs) *Server {
 	e := echo.New()
 	s := &Server{
-		echo: e,
-		port: fmt.Sprintf(":%d", config.Server.Port),
+		echo:      e,
+		port:      fmt.Sprintf(":%d", config.Server.Port),
+		tls:       config.TLS,
+		clientCAs: clientCAs,
 	}
 
 	s.configureMiddlewares(config)
 	s.configureValidator()
 	s.configureErrorHandler()
-	s.configureRoutes(config, clientHandler, authHandler, oauthHandler, authMiddleware)
+	s.configureRoutes(config, clientHandler, authHandler, oauthHandler, deviceHandler, consentHandler, grantHandler, wellKnownHandler, resourceServerHandler, userInfoHandler, authMiddleware)
 
 	return s
 }
 
+// Start sobe o listener HTTPS com mTLS quando TLS_CERT_FILE está configurado; caso
+// contrário, usa HTTP (ex.: atrás de um proxy que termina o TLS).
 func (s *Server) Start() error {
-	return s.echo.Start(s.port)
+	if s.tls.CertFile == "" {
+		return s.echo.Start(s.port)
+	}
+
+	tlsConfig, err := mtls.NewServerConfig(s.tls.CertFile, s.tls.KeyFile, s.clientCAs)
+	if err != nil {
+		return fmt.Errorf("configure tls: %w", err)
+	}
+
+	return s.echo.StartServer(&http.Server{Addr: s.port, TLSConfig: tlsConfig})
 }
 
 func (s *Server) configureMiddlewares(config *configs.Environment) {
@@ -79,7 +96,10 @@ func (s *Server) configureErrorHandler() {
 	s.echo.HTTPErrorHandler = api.CustomHTTPErrorHandler
 }
 
-func (s *Server) configureRoutes(config *configs.Environment, clientHandler handlers.ClientHandler, authHandler handlers.AuthHandler, oauthHandler handlers.OAuthHandler, authMiddleware middlewares.AuthMiddleware) {
+func (s *Server) configureRoutes(config *configs.Environment, clientHandler handlers.ClientHandler, authHandler handlers.AuthHandler, oauthHandler handlers.OAuthHandler, deviceHandler handlers.DeviceHandler, consentHandler handlers.ConsentHandler, grantHandler handlers.GrantHandler, wellKnownHandler handlers.WellKnownHandler, resourceServerHandler handlers.ResourceServerHandler, userInfoHandler handlers.UserInfoHandler, authMiddleware middlewares.AuthMiddleware) {
+	wellKnownGroup := s.echo.Group("/.well-known")
+	RegisterWellKnownRoutes(wellKnownGroup, wellKnownHandler)
+
 	apiGroup := s.echo.Group("/api/v1")
-	RegisterRoutes(apiGroup, config, clientHandler, authHandler, oauthHandler, authMiddleware)
+	RegisterRoutes(apiGroup, config, clientHandler, authHandler, oauthHandler, deviceHandler, consentHandler, grantHandler, resourceServerHandler, userInfoHandler, authMiddleware)
 }
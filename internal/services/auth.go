@@ -68,9 +68,9 @@ func (s *authService) Authenticate(ctx context.Context, code, otpID string) (*mo
 
 	expiresAt := time.Now().Add(s.config.OTP.JWTExpirationMinutes)
 
-	token, err := s.jwtService.GenerateAccessTokenJWT(ctx, otp.UserID.Hex(), expiresAt)
+	token, err := s.jwtService.GenerateSessionTokenJWT(ctx, otp.UserID.Hex(), expiresAt)
 	if err != nil {
-		return nil, fmt.Errorf("generate access token jwt: %w", err)
+		return nil, fmt.Errorf("generate session token jwt: %w", err)
 	}
 
 	return &models.AuthenticateResponse{
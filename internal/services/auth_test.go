@@ -303,7 +303,7 @@ func TestAuthenticate(t *testing.T) {
 
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockJWTService.EXPECT().
-			GenerateAccessTokenJWT(ctx, userID.Hex(), mock.AnythingOfType("time.Time")).
+			GenerateSessionTokenJWT(ctx, userID.Hex(), mock.AnythingOfType("time.Time")).
 			Return(expectedToken, nil)
 
 		authService := NewAuthService(mockUserRepo, mockOTPService, mockJWTService, &config)
@@ -448,7 +448,7 @@ func TestAuthenticate(t *testing.T) {
 
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockJWTService.EXPECT().
-			GenerateAccessTokenJWT(ctx, userID.Hex(), mock.AnythingOfType("time.Time")).
+			GenerateSessionTokenJWT(ctx, userID.Hex(), mock.AnythingOfType("time.Time")).
 			Return("", expectedError)
 
 		authService := NewAuthService(mockUserRepo, mockOTPService, mockJWTService, &config)
@@ -459,7 +459,7 @@ func TestAuthenticate(t *testing.T) {
 		// Assert
 		require.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "generate access token jwt")
+		assert.Contains(t, err.Error(), "generate session token jwt")
 		assert.Contains(t, err.Error(), expectedError.Error())
 	})
 
@@ -594,7 +594,7 @@ func TestAuthenticate(t *testing.T) {
 
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockJWTService.EXPECT().
-			GenerateAccessTokenJWT(ctx, userID.Hex(), mock.AnythingOfType("time.Time")).
+			GenerateSessionTokenJWT(ctx, userID.Hex(), mock.AnythingOfType("time.Time")).
 			Return(expectedToken, nil)
 
 		authService := NewAuthService(mockUserRepo, mockOTPService, mockJWTService, &config)
@@ -5,33 +5,52 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/aetheris-lab/aetheris-id/api/configs"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 const (
 	authorizationCodeLength = 32
 	authorizationCodeExpiry = 10 * time.Minute
+
+	codeChallengeMethodS256  = "S256"
+	codeChallengeMethodPlain = "plain"
 )
 
+// SupportedCodeChallengeMethods lista os métodos PKCE aceitos por validatePKCE.
+var SupportedCodeChallengeMethods = []string{codeChallengeMethodS256, codeChallengeMethodPlain}
+
 type AuthorizationCodeService interface {
 	CreateAuthorizationCode(ctx context.Context, input models.CreateAuthorizationCodeInput) (*entities.AuthorizationCode, error)
-	ValidateAuthorizationCode(ctx context.Context, code string, codeVerifier string) (*entities.AuthorizationCode, error)
+	ValidateAuthorizationCode(ctx context.Context, code, codeVerifier, clientID, redirectURI string) (*entities.AuthorizationCode, error)
 }
 
 type authorizationCodeService struct {
-	authorizationCodeRepo repositories.AuthorizationCodeRepository
+	authorizationCodeRepo  repositories.AuthorizationCodeRepository
+	refreshTokenService    RefreshTokenService
+	tokenRevocationService TokenRevocationService
+	config                 *configs.Environment
 }
 
 func NewAuthorizationCodeService(
-	authorizationCodeRepo repositories.AuthorizationCodeRepository) AuthorizationCodeService {
+	authorizationCodeRepo repositories.AuthorizationCodeRepository,
+	refreshTokenService RefreshTokenService,
+	tokenRevocationService TokenRevocationService,
+	config *configs.Environment,
+) AuthorizationCodeService {
 	return &authorizationCodeService{
-		authorizationCodeRepo: authorizationCodeRepo,
+		authorizationCodeRepo:  authorizationCodeRepo,
+		refreshTokenService:    refreshTokenService,
+		tokenRevocationService: tokenRevocationService,
+		config:                 config,
 	}
 }
 
@@ -52,6 +71,9 @@ func (s *authorizationCodeService) CreateAuthorizationCode(ctx context.Context,
 		CodeChallengeMethod: input.CodeChallengeMethod,
 		ExpiresAt:           expiresAt,
 		Scopes:              input.Scopes,
+		Resources:           input.Resources,
+		Nonce:               input.Nonce,
+		AuthTime:            input.AuthTime,
 	}
 
 	if err := s.authorizationCodeRepo.Create(ctx, authorizationCode); err != nil {
@@ -61,21 +83,68 @@ func (s *authorizationCodeService) CreateAuthorizationCode(ctx context.Context,
 	return authorizationCode, nil
 }
 
-func (s *authorizationCodeService) ValidateAuthorizationCode(ctx context.Context, code string, codeVerifier string) (*entities.AuthorizationCode, error) {
+// ValidateAuthorizationCode confere o código antes de consumi-lo, para que uma
+// requisição inválida não inutilize o código do cliente legítimo. Um segundo uso
+// pelo mesmo cliente revoga os tokens emitidos na primeira troca (RFC 6749 §4.1.2).
+func (s *authorizationCodeService) ValidateAuthorizationCode(ctx context.Context, code, codeVerifier, clientID, redirectURI string) (*entities.AuthorizationCode, error) {
 	authorizationCode, err := s.authorizationCodeRepo.FindByCode(ctx, code)
 	if err != nil {
 		return nil, fmt.Errorf("find authorization code: %w", err)
 	}
 
+	if !authorizationCode.IsValidClientID(clientID) {
+		return nil, fmt.Errorf("validate authorization code %w: %s", domain.ErrClientMismatch, clientID)
+	}
+
+	if authorizationCode.IsConsumed() {
+		return nil, s.handleConsumedAuthorizationCode(ctx, authorizationCode)
+	}
+
 	if authorizationCode.IsExpired() {
 		return nil, domain.ErrAuthorizationCodeExpired
 	}
 
+	if !authorizationCode.IsValidRedirectURI(redirectURI) {
+		return nil, fmt.Errorf("validate authorization code %w", domain.ErrUnauthorizedRedirectURI)
+	}
+
 	if !validatePKCE(codeVerifier, authorizationCode.CodeChallenge, authorizationCode.CodeChallengeMethod) {
 		return nil, domain.ErrAuthorizationCodeInvalid
 	}
 
-	return authorizationCode, nil
+	consumedAuthorizationCode, err := s.authorizationCodeRepo.Consume(ctx, code, clientID, redirectURI, primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex())
+	if err != nil {
+		if !errors.Is(err, domain.ErrAuthorizationCodeNotFound) {
+			return nil, fmt.Errorf("consume authorization code: %w", err)
+		}
+
+		// Outra requisição consumiu o mesmo código primeiro.
+		authorizationCode, err := s.authorizationCodeRepo.FindByCode(ctx, code)
+		if err != nil {
+			return nil, fmt.Errorf("find authorization code: %w", err)
+		}
+
+		return nil, s.handleConsumedAuthorizationCode(ctx, authorizationCode)
+	}
+
+	return consumedAuthorizationCode, nil
+}
+
+func (s *authorizationCodeService) handleConsumedAuthorizationCode(ctx context.Context, authorizationCode *entities.AuthorizationCode) error {
+	if authorizationCode.RefreshTokenFamilyID != "" {
+		if err := s.refreshTokenService.RevokeFamily(ctx, authorizationCode.RefreshTokenFamilyID); err != nil {
+			return fmt.Errorf("revoke refresh tokens issued from authorization code: %w", err)
+		}
+	}
+
+	if authorizationCode.AccessTokenID != "" {
+		expiresAt := authorizationCode.ConsumedAt.Add(s.config.Security.AccessTokenExpirationHours)
+		if err := s.tokenRevocationService.RevokeAccessToken(ctx, authorizationCode.AccessTokenID, expiresAt); err != nil {
+			return fmt.Errorf("revoke access token issued from authorization code: %w", err)
+		}
+	}
+
+	return domain.ErrAuthorizationCodeReused
 }
 
 func generateSecureRandomString(n int) (string, error) {
@@ -89,11 +158,11 @@ func generateSecureRandomString(n int) (string, error) {
 
 func validatePKCE(verifier, challenge, method string) bool {
 	switch method {
-	case "S256":
+	case codeChallengeMethodS256:
 		verifierHash := sha256.Sum256([]byte(verifier))
 		calculatedChallenge := base64.URLEncoding.WithPadding(base64.NoPadding).EncodeToString(verifierHash[:])
 		return calculatedChallenge == challenge
-	case "plain":
+	case codeChallengeMethodPlain:
 		return verifier == challenge
 	default:
 		return false
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aetheris-lab/aetheris-id/api/configs"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
@@ -43,7 +44,7 @@ func TestCreateAuthorizationCode(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*entities.AuthorizationCode")).
 			Return(nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
 		result, err := service.CreateAuthorizationCode(ctx, input)
@@ -81,7 +82,7 @@ func TestCreateAuthorizationCode(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*entities.AuthorizationCode")).
 			Return(expectedError)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
 		result, err := service.CreateAuthorizationCode(ctx, input)
@@ -111,7 +112,7 @@ func TestCreateAuthorizationCode(t *testing.T) {
 			Return(nil).
 			Times(2)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
 		result1, err1 := service.CreateAuthorizationCode(ctx, input)
@@ -142,7 +143,7 @@ func TestCreateAuthorizationCode(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*entities.AuthorizationCode")).
 			Return(nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
 		result, err := service.CreateAuthorizationCode(ctx, input)
@@ -155,36 +156,47 @@ func TestCreateAuthorizationCode(t *testing.T) {
 }
 
 func TestValidateAuthorizationCode(t *testing.T) {
-	t.Run("should return success when valid code and verifier are provided", func(t *testing.T) {
-		// Arrange
-		ctx := context.Background()
-		code := "valid_code_123"
-		codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
-		codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
-		expiresAt := time.Now().Add(5 * time.Minute)
+	const (
+		clientID    = "client456"
+		redirectURI = "https://example.com/callback"
+	)
 
-		authorizationCode := &entities.AuthorizationCode{
+	newAuthorizationCode := func(code, codeChallenge, codeChallengeMethod string, expiresAt time.Time) *entities.AuthorizationCode {
+		return &entities.AuthorizationCode{
 			ID:                  primitive.NewObjectID(),
 			Code:                code,
 			UserID:              "user123",
-			ClientID:            "client456",
-			RedirectURI:         "https://example.com/callback",
+			ClientID:            clientID,
+			RedirectURI:         redirectURI,
 			CodeChallenge:       codeChallenge,
-			CodeChallengeMethod: "S256",
+			CodeChallengeMethod: codeChallengeMethod,
 			ExpiresAt:           expiresAt,
 			Scopes:              []string{"read", "write"},
 			CreatedAt:           time.Now(),
 		}
+	}
+
+	t.Run("should return success when valid code and verifier are provided", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		code := "valid_code_123"
+		codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+		codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+		authorizationCode := newAuthorizationCode(code, codeChallenge, "S256", time.Now().Add(5*time.Minute))
 
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
 		mockRepo.EXPECT().
 			FindByCode(ctx, code).
 			Return(authorizationCode, nil)
+		mockRepo.EXPECT().
+			Consume(ctx, code, clientID, redirectURI, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			Return(authorizationCode, nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier, clientID, redirectURI)
 
 		// Assert
 		require.NoError(t, err)
@@ -196,54 +208,116 @@ func TestValidateAuthorizationCode(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
 		code := "valid_code_123"
-		codeVerifier := "plain_verifier"
-		codeChallenge := "plain_verifier"
-		expiresAt := time.Now().Add(5 * time.Minute)
 
-		authorizationCode := &entities.AuthorizationCode{
-			ID:                  primitive.NewObjectID(),
-			Code:                code,
-			UserID:              "user123",
-			ClientID:            "client456",
-			RedirectURI:         "https://example.com/callback",
-			CodeChallenge:       codeChallenge,
-			CodeChallengeMethod: "plain",
-			ExpiresAt:           expiresAt,
-			Scopes:              []string{"read"},
-			CreatedAt:           time.Now(),
-		}
+		authorizationCode := newAuthorizationCode(code, "plain_verifier", "plain", time.Now().Add(5*time.Minute))
 
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
 		mockRepo.EXPECT().
 			FindByCode(ctx, code).
 			Return(authorizationCode, nil)
+		mockRepo.EXPECT().
+			Consume(ctx, code, clientID, redirectURI, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			Return(authorizationCode, nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, "plain_verifier", clientID, redirectURI)
 
 		// Assert
 		require.NoError(t, err)
-		assert.NotNil(t, result)
 		assert.Equal(t, authorizationCode, result)
 	})
 
+	t.Run("should keep the code usable after a request with a wrong verifier", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		code := "valid_code_123"
+		codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+		codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+		authorizationCode := newAuthorizationCode(code, codeChallenge, "S256", time.Now().Add(5*time.Minute))
+
+		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockRepo.EXPECT().
+			FindByCode(ctx, code).
+			Return(authorizationCode, nil).Twice()
+		mockRepo.EXPECT().
+			Consume(ctx, code, clientID, redirectURI, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			Return(authorizationCode, nil).Once()
+
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
+
+		// Act
+		_, wrongVerifierErr := service.ValidateAuthorizationCode(ctx, code, "wrong_verifier", clientID, redirectURI)
+		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier, clientID, redirectURI)
+
+		// Assert
+		assert.ErrorIs(t, wrongVerifierErr, domain.ErrAuthorizationCodeInvalid)
+		require.NoError(t, err)
+		assert.Equal(t, authorizationCode, result)
+	})
+
+	t.Run("should return error without consuming the code when client ID differs", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		code := "valid_code"
+
+		authorizationCode := newAuthorizationCode(code, "plain_verifier", "plain", time.Now().Add(5*time.Minute))
+
+		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockRepo.EXPECT().
+			FindByCode(ctx, code).
+			Return(authorizationCode, nil)
+
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateAuthorizationCode(ctx, code, "plain_verifier", "other-client", redirectURI)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrClientMismatch)
+	})
+
+	t.Run("should return error without consuming the code when redirect URI differs", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		code := "valid_code"
+
+		authorizationCode := newAuthorizationCode(code, "plain_verifier", "plain", time.Now().Add(5*time.Minute))
+
+		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockRepo.EXPECT().
+			FindByCode(ctx, code).
+			Return(authorizationCode, nil)
+
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateAuthorizationCode(ctx, code, "plain_verifier", clientID, "https://attacker.example.com/callback")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedRedirectURI)
+	})
+
 	t.Run("should return error when authorization code is not found", func(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
 		code := "invalid_code"
-		codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
 
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
 		mockRepo.EXPECT().
 			FindByCode(ctx, code).
 			Return(nil, domain.ErrAuthorizationCodeNotFound)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk", clientID, redirectURI)
 
 		// Assert
 		require.Error(t, err)
@@ -256,23 +330,27 @@ func TestValidateAuthorizationCode(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
 		code := "valid_code"
-		codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
 		expectedError := errors.New("database connection failed")
 
+		authorizationCode := newAuthorizationCode(code, "plain_verifier", "plain", time.Now().Add(5*time.Minute))
+
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
 		mockRepo.EXPECT().
 			FindByCode(ctx, code).
+			Return(authorizationCode, nil)
+		mockRepo.EXPECT().
+			Consume(ctx, code, clientID, redirectURI, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
 			Return(nil, expectedError)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, "plain_verifier", clientID, redirectURI)
 
 		// Assert
 		require.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "find authorization code")
+		assert.Contains(t, err.Error(), "consume authorization code")
 		assert.Contains(t, err.Error(), expectedError.Error())
 	})
 
@@ -282,30 +360,18 @@ func TestValidateAuthorizationCode(t *testing.T) {
 		code := "expired_code"
 		codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
 		codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
-		expiresAt := time.Now().Add(-5 * time.Minute) // Expired 5 minutes ago
 
-		authorizationCode := &entities.AuthorizationCode{
-			ID:                  primitive.NewObjectID(),
-			Code:                code,
-			UserID:              "user123",
-			ClientID:            "client456",
-			RedirectURI:         "https://example.com/callback",
-			CodeChallenge:       codeChallenge,
-			CodeChallengeMethod: "S256",
-			ExpiresAt:           expiresAt,
-			Scopes:              []string{"read"},
-			CreatedAt:           time.Now(),
-		}
+		authorizationCode := newAuthorizationCode(code, codeChallenge, "S256", time.Now().Add(-5*time.Minute))
 
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
 		mockRepo.EXPECT().
 			FindByCode(ctx, code).
 			Return(authorizationCode, nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier, clientID, redirectURI)
 
 		// Assert
 		require.Error(t, err)
@@ -317,32 +383,18 @@ func TestValidateAuthorizationCode(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
 		code := "valid_code"
-		codeVerifier := "invalid_verifier"
-		codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
-		expiresAt := time.Now().Add(5 * time.Minute)
 
-		authorizationCode := &entities.AuthorizationCode{
-			ID:                  primitive.NewObjectID(),
-			Code:                code,
-			UserID:              "user123",
-			ClientID:            "client456",
-			RedirectURI:         "https://example.com/callback",
-			CodeChallenge:       codeChallenge,
-			CodeChallengeMethod: "S256",
-			ExpiresAt:           expiresAt,
-			Scopes:              []string{"read"},
-			CreatedAt:           time.Now(),
-		}
+		authorizationCode := newAuthorizationCode(code, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", "S256", time.Now().Add(5*time.Minute))
 
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
 		mockRepo.EXPECT().
 			FindByCode(ctx, code).
 			Return(authorizationCode, nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, "invalid_verifier", clientID, redirectURI)
 
 		// Assert
 		require.Error(t, err)
@@ -354,32 +406,18 @@ func TestValidateAuthorizationCode(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
 		code := "valid_code"
-		codeVerifier := "wrong_verifier"
-		codeChallenge := "correct_challenge"
-		expiresAt := time.Now().Add(5 * time.Minute)
 
-		authorizationCode := &entities.AuthorizationCode{
-			ID:                  primitive.NewObjectID(),
-			Code:                code,
-			UserID:              "user123",
-			ClientID:            "client456",
-			RedirectURI:         "https://example.com/callback",
-			CodeChallenge:       codeChallenge,
-			CodeChallengeMethod: "plain",
-			ExpiresAt:           expiresAt,
-			Scopes:              []string{"read"},
-			CreatedAt:           time.Now(),
-		}
+		authorizationCode := newAuthorizationCode(code, "correct_challenge", "plain", time.Now().Add(5*time.Minute))
 
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
 		mockRepo.EXPECT().
 			FindByCode(ctx, code).
 			Return(authorizationCode, nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, "wrong_verifier", clientID, redirectURI)
 
 		// Assert
 		require.Error(t, err)
@@ -391,21 +429,44 @@ func TestValidateAuthorizationCode(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
 		code := "valid_code"
-		codeVerifier := "any_verifier"
-		codeChallenge := "any_challenge"
-		expiresAt := time.Now().Add(5 * time.Minute)
+
+		authorizationCode := newAuthorizationCode(code, "any_challenge", "unsupported_method", time.Now().Add(5*time.Minute))
+
+		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockRepo.EXPECT().
+			FindByCode(ctx, code).
+			Return(authorizationCode, nil)
+
+		service := NewAuthorizationCodeService(mockRepo, nil, nil, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateAuthorizationCode(ctx, code, "any_verifier", clientID, redirectURI)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrAuthorizationCodeInvalid, err)
+	})
+
+	t.Run("should revoke issued tokens when authorization code is replayed", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		code := "consumed_code"
+		consumedAt := time.Now().Add(-time.Minute)
+		config := &configs.Environment{
+			Security: configs.Security{
+				AccessTokenExpirationHours:  time.Hour,
+				RefreshTokenExpirationHours: 24 * time.Hour,
+			},
+		}
 
 		authorizationCode := &entities.AuthorizationCode{
-			ID:                  primitive.NewObjectID(),
-			Code:                code,
-			UserID:              "user123",
-			ClientID:            "client456",
-			RedirectURI:         "https://example.com/callback",
-			CodeChallenge:       codeChallenge,
-			CodeChallengeMethod: "unsupported_method",
-			ExpiresAt:           expiresAt,
-			Scopes:              []string{"read"},
-			CreatedAt:           time.Now(),
+			ID:                   primitive.NewObjectID(),
+			Code:                 code,
+			ClientID:             clientID,
+			AccessTokenID:        "access-token-id",
+			RefreshTokenFamilyID: "refresh-token-family-id",
+			ConsumedAt:           &consumedAt,
 		}
 
 		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
@@ -413,15 +474,97 @@ func TestValidateAuthorizationCode(t *testing.T) {
 			FindByCode(ctx, code).
 			Return(authorizationCode, nil)
 
-		service := NewAuthorizationCodeService(mockRepo)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockRefreshTokenService.EXPECT().
+			RevokeFamily(ctx, "refresh-token-family-id").
+			Return(nil)
+
+		mockTokenRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		mockTokenRevocationService.EXPECT().
+			RevokeAccessToken(ctx, "access-token-id", consumedAt.Add(time.Hour)).
+			Return(nil)
+
+		service := NewAuthorizationCodeService(mockRepo, mockRefreshTokenService, mockTokenRevocationService, config)
 
 		// Act
-		result, err := service.ValidateAuthorizationCode(ctx, code, codeVerifier)
+		result, err := service.ValidateAuthorizationCode(ctx, code, "any_verifier", clientID, redirectURI)
 
 		// Assert
 		require.Error(t, err)
 		assert.Nil(t, result)
-		assert.Equal(t, domain.ErrAuthorizationCodeInvalid, err)
+		assert.ErrorIs(t, err, domain.ErrAuthorizationCodeReused)
+	})
+
+	t.Run("should revoke issued tokens when a concurrent request consumed the code first", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		code := "valid_code"
+		consumedAt := time.Now()
+
+		authorizationCode := newAuthorizationCode(code, "plain_verifier", "plain", time.Now().Add(5*time.Minute))
+		consumedCode := *authorizationCode
+		consumedCode.RefreshTokenFamilyID = "refresh-token-family-id"
+		consumedCode.ConsumedAt = &consumedAt
+
+		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockRepo.EXPECT().
+			FindByCode(ctx, code).
+			Return(authorizationCode, nil).Once()
+		mockRepo.EXPECT().
+			Consume(ctx, code, clientID, redirectURI, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			Return(nil, domain.ErrAuthorizationCodeNotFound)
+		mockRepo.EXPECT().
+			FindByCode(ctx, code).
+			Return(&consumedCode, nil).Once()
+
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockRefreshTokenService.EXPECT().
+			RevokeFamily(ctx, "refresh-token-family-id").
+			Return(nil)
+
+		service := NewAuthorizationCodeService(mockRepo, mockRefreshTokenService, nil, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateAuthorizationCode(ctx, code, "plain_verifier", clientID, redirectURI)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrAuthorizationCodeReused)
+	})
+
+	t.Run("should return error when revoking refresh tokens of a replayed code fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		code := "consumed_code"
+		consumedAt := time.Now().Add(-time.Minute)
+
+		authorizationCode := &entities.AuthorizationCode{
+			Code:                 code,
+			ClientID:             clientID,
+			RefreshTokenFamilyID: "refresh-token-family-id",
+			ConsumedAt:           &consumedAt,
+		}
+
+		mockRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockRepo.EXPECT().
+			FindByCode(ctx, code).
+			Return(authorizationCode, nil)
+
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockRefreshTokenService.EXPECT().
+			RevokeFamily(ctx, "refresh-token-family-id").
+			Return(errors.New("database error"))
+
+		service := NewAuthorizationCodeService(mockRepo, mockRefreshTokenService, nil, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateAuthorizationCode(ctx, code, "any_verifier", clientID, redirectURI)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "revoke refresh tokens issued from authorization code")
 	})
 }
 
@@ -4,32 +4,41 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/aetheris-lab/aetheris-id/api/configs"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type ClientService interface {
-	CreateClient(ctx context.Context, name string, description string, redirectURIs []string, grantTypes []string) (*models.ClientResponse, error)
+	CreateClient(ctx context.Context, input models.CreateClientInput) (*models.ClientResponse, error)
 	GetClientByClientID(ctx context.Context, clientID string) (*entities.Client, error)
+	SetFirstParty(ctx context.Context, clientID string, firstParty bool) error
+	SetTokenExchangePolicy(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy) error
+	GrantScope(ctx context.Context, clientID, scope string) error
+	RevokeScope(ctx context.Context, clientID, scope string) error
 }
 
 type clientService struct {
 	clientRepo repositories.ClientRepository
+	config     *configs.Environment
 }
 
-func NewClientService(clientRepo repositories.ClientRepository) ClientService {
+func NewClientService(clientRepo repositories.ClientRepository, config *configs.Environment) ClientService {
 	return &clientService{
 		clientRepo: clientRepo,
+		config:     config,
 	}
 }
 
-func (s *clientService) CreateClient(ctx context.Context, name string, description string, redirectURIs []string, grantTypes []string) (*models.ClientResponse, error) {
-	clientId := s.generateClientID(name)
+func (s *clientService) CreateClient(ctx context.Context, input models.CreateClientInput) (*models.ClientResponse, error) {
+	clientId := s.generateClientID(input.Name)
 
 	clientFromClientID, err := s.clientRepo.GetByClientID(ctx, clientId)
 	if err != nil && !errors.Is(err, domain.ErrClientNotFound) {
@@ -40,20 +49,51 @@ func (s *clientService) CreateClient(ctx context.Context, name string, descripti
 		return nil, fmt.Errorf("create client: %w", domain.ErrClientAlreadyExists)
 	}
 
+	authMethod := tokenEndpointAuthMethod(input)
+	if err := validateClientAuthentication(authMethod, input); err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
 	client := &entities.Client{
-		Name:         name,
-		Description:  description,
-		RedirectURIs: redirectURIs,
-		ClientID:     clientId,
-		Scopes:       scopes.GetDefaultFirstPartyScopes(),
-		GrantTypes:   grantTypes,
+		Name:                               input.Name,
+		Description:                        input.Description,
+		RedirectURIs:                       input.RedirectURIs,
+		ClientID:                           clientId,
+		Scopes:                             scopes.GetDefaultFirstPartyScopes(),
+		GrantTypes:                         input.GrantTypes,
+		IDTokenSignedResponseAlg:           input.IDTokenSignedResponseAlg,
+		UserinfoSignedResponseAlg:          input.UserinfoSignedResponseAlg,
+		TokenEndpointAuthMethod:            authMethod,
+		JWKS:                               input.JWKS,
+		RequirePushedAuthorizationRequests: input.RequirePushedAuthorizationRequests,
+		TLSClientAuthSubjectDN:             input.TLSClientAuthSubjectDN,
+		TLSClientCertificateThumbprint:     input.TLSClientCertificateThumbprint,
+	}
+
+	// O client_secret só é exibido nesta resposta; apenas o hash é persistido.
+	var clientSecret string
+	if authMethod == models.TokenEndpointAuthMethodClientSecretBasic || authMethod == models.TokenEndpointAuthMethodClientSecretPost {
+		clientSecret, err = generateSecureRandomString(32)
+		if err != nil {
+			return nil, fmt.Errorf("generate client secret: %w", err)
+		}
+
+		secretHash, err := bcrypt.GenerateFromPassword([]byte(clientSecret), s.config.Security.BcryptCost)
+		if err != nil {
+			return nil, fmt.Errorf("hash client secret: %w", err)
+		}
+
+		client.SecretHash = string(secretHash)
 	}
 
 	if err := s.clientRepo.Create(ctx, client); err != nil {
 		return nil, fmt.Errorf("create client: %w", err)
 	}
 
-	return models.ClientToResponse(client), nil
+	response := models.ClientToResponse(client)
+	response.ClientSecret = clientSecret
+
+	return response, nil
 }
 
 func (s *clientService) GetClientByClientID(ctx context.Context, clientID string) (*entities.Client, error) {
@@ -65,6 +105,105 @@ func (s *clientService) GetClientByClientID(ctx context.Context, clientID string
 	return client, nil
 }
 
+// SetFirstParty marca o cliente como aplicativo da própria plataforma, que dispensa a
+// tela de consentimento. Não é exposto na API de cadastro; é usado pelo cmd/clients.
+func (s *clientService) SetFirstParty(ctx context.Context, clientID string, firstParty bool) error {
+	if err := s.clientRepo.UpdateFirstParty(ctx, clientID, firstParty); err != nil {
+		return fmt.Errorf("update first party: %w", err)
+	}
+
+	return nil
+}
+
+// SetTokenExchangePolicy define para quais audiências e escopos o cliente troca tokens.
+// Como a política decide quais tokens de usuário o cliente pode reaproveitar, ela não é
+// aceita no cadastro público; é definida pelo cmd/clients.
+func (s *clientService) SetTokenExchangePolicy(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy) error {
+	if policy == nil || len(policy.Audiences) == 0 {
+		return fmt.Errorf("set token exchange policy %w: audiences are required", domain.ErrInvalidClientMetadata)
+	}
+
+	if err := s.clientRepo.UpdateTokenExchangePolicy(ctx, clientID, policy); err != nil {
+		return fmt.Errorf("update token exchange policy: %w", err)
+	}
+
+	return nil
+}
+
+// GrantScope adiciona um escopo aos que o cliente pode pedir. É o caminho para os
+// escopos administrativos (token:introspect, resource_servers:create), que o cadastro
+// público nunca concede; é usado pelo cmd/clients.
+func (s *clientService) GrantScope(ctx context.Context, clientID, scope string) error {
+	if _, ok := scopes.GetScopeByName(scope); !ok {
+		return fmt.Errorf("grant scope %w: %s", domain.ErrInvalidScope, scope)
+	}
+
+	if err := s.clientRepo.AddScope(ctx, clientID, scope); err != nil {
+		return fmt.Errorf("add client scope: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeScope remove o escopo do cliente; tokens já emitidos com ele valem até expirar.
+func (s *clientService) RevokeScope(ctx context.Context, clientID, scope string) error {
+	if err := s.clientRepo.RemoveScope(ctx, clientID, scope); err != nil {
+		return fmt.Errorf("remove client scope: %w", err)
+	}
+
+	return nil
+}
+
+// confidentialGrantTypes só podem ser usados por clientes que se autenticam.
+var confidentialGrantTypes = []string{models.GrantTypeClientCredentials, models.GrantTypeTokenExchange}
+
+// tokenEndpointAuthMethod aplica o padrão quando o método não é informado: clientes
+// com client_credentials ou token exchange precisam de credenciais, os demais são públicos.
+func tokenEndpointAuthMethod(input models.CreateClientInput) string {
+	switch {
+	case input.TokenEndpointAuthMethod != "":
+		return input.TokenEndpointAuthMethod
+	case slices.ContainsFunc(input.GrantTypes, isConfidentialGrantType):
+		return models.TokenEndpointAuthMethodClientSecretBasic
+	default:
+		return models.TokenEndpointAuthMethodNone
+	}
+}
+
+func isConfidentialGrantType(grantType string) bool {
+	return slices.Contains(confidentialGrantTypes, grantType)
+}
+
+func validateClientAuthentication(authMethod string, input models.CreateClientInput) error {
+	if authMethod == models.TokenEndpointAuthMethodNone {
+		if index := slices.IndexFunc(input.GrantTypes, isConfidentialGrantType); index >= 0 {
+			return fmt.Errorf("%w: %s requires an authenticated client", domain.ErrInvalidClientMetadata, input.GrantTypes[index])
+		}
+	}
+
+	// As chaves também verificam request objects (JAR), então podem ser registradas
+	// com qualquer método de autenticação.
+	if authMethod == models.TokenEndpointAuthMethodPrivateKeyJWT && len(input.JWKS) == 0 {
+		return fmt.Errorf("%w: private_key_jwt requires jwks", domain.ErrInvalidClientMetadata)
+	}
+
+	if authMethod == models.TokenEndpointAuthMethodTLSClientAuth && input.TLSClientAuthSubjectDN == "" {
+		return fmt.Errorf("%w: tls_client_auth requires tls_client_auth_subject_dn", domain.ErrInvalidClientMetadata)
+	}
+
+	if authMethod == models.TokenEndpointAuthMethodSelfSignedTLSClientAuth && input.TLSClientCertificateThumbprint == "" {
+		return fmt.Errorf("%w: self_signed_tls_client_auth requires tls_client_certificate_thumbprint", domain.ErrInvalidClientMetadata)
+	}
+
+	for _, jwk := range input.JWKS {
+		if _, err := jwk.PublicKey(); err != nil {
+			return fmt.Errorf("%w: jwks: %s", domain.ErrInvalidClientMetadata, err)
+		}
+	}
+
+	return nil
+}
+
 func (s *clientService) generateClientID(name string) string {
 	clientID := strings.ToLower(name)
 	clientID = strings.ReplaceAll(clientID, " ", "-")
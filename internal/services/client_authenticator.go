@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/mtls"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ClientAuthenticator autentica o cliente no endpoint de token usando o método
+// registrado em token_endpoint_auth_method.
+type ClientAuthenticator interface {
+	Authenticate(ctx context.Context, input models.ClientAuthenticationInput) (*entities.Client, error)
+}
+
+type clientAuthenticator struct {
+	clientRepo          repositories.ClientRepository
+	clientAssertionRepo repositories.ClientAssertionRepository
+	config              *configs.Environment
+	clientCAs           *mtls.ClientCAs
+}
+
+func NewClientAuthenticator(
+	clientRepo repositories.ClientRepository,
+	clientAssertionRepo repositories.ClientAssertionRepository,
+	config *configs.Environment,
+	clientCAs *mtls.ClientCAs,
+) ClientAuthenticator {
+	return &clientAuthenticator{
+		clientRepo:          clientRepo,
+		clientAssertionRepo: clientAssertionRepo,
+		config:              config,
+		clientCAs:           clientCAs,
+	}
+}
+
+func (a *clientAuthenticator) Authenticate(ctx context.Context, input models.ClientAuthenticationInput) (*entities.Client, error) {
+	if input.HasBasicAuth {
+		clientID, clientSecret, err := decodeBasicAuth(input.BasicClientID, input.BasicClientSecret)
+		if err != nil {
+			return nil, fmt.Errorf("authenticate client: %w", err)
+		}
+
+		input.BasicClientID, input.BasicClientSecret = clientID, clientSecret
+	}
+
+	method, clientID, err := presentedAuthMethod(input)
+	if err != nil {
+		return nil, fmt.Errorf("authenticate client: %w", err)
+	}
+
+	client, err := a.clientRepo.GetByClientID(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	// O certificado não identifica o método sozinho: tls_client_auth e
+	// self_signed_tls_client_auth só se distinguem pelo cadastro do cliente.
+	if method == models.TokenEndpointAuthMethodNone && len(input.ClientCertificates) > 0 && client.UsesTLSClientAuth() {
+		method = client.AuthMethod()
+	}
+
+	if client.AuthMethod() != method {
+		return nil, fmt.Errorf("authenticate client %w: client must use %s, got %s", domain.ErrClientAuthMethodMismatch, client.AuthMethod(), method)
+	}
+
+	switch method {
+	case models.TokenEndpointAuthMethodClientSecretBasic:
+		err = verifyClientSecret(client, input.BasicClientSecret)
+	case models.TokenEndpointAuthMethodClientSecretPost:
+		err = verifyClientSecret(client, input.ClientSecret)
+	case models.TokenEndpointAuthMethodPrivateKeyJWT:
+		err = a.verifyClientAssertion(ctx, client, input)
+	case models.TokenEndpointAuthMethodTLSClientAuth:
+		err = a.verifyTLSClientAuth(client, input.ClientCertificates)
+	case models.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		err = verifySelfSignedTLSClientAuth(client, input.ClientCertificates)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("authenticate client: %w", err)
+	}
+
+	return client, nil
+}
+
+// presentedAuthMethod identifica o método usado na requisição e o client_id
+// correspondente. A RFC 6749 (seção 2.3) proíbe mais de um método por requisição.
+func presentedAuthMethod(input models.ClientAuthenticationInput) (string, string, error) {
+	var methods []string
+	if input.HasBasicAuth {
+		methods = append(methods, models.TokenEndpointAuthMethodClientSecretBasic)
+	}
+	if input.ClientSecret != "" {
+		methods = append(methods, models.TokenEndpointAuthMethodClientSecretPost)
+	}
+	if input.ClientAssertion != "" || input.ClientAssertionType != "" {
+		methods = append(methods, models.TokenEndpointAuthMethodPrivateKeyJWT)
+	}
+
+	if len(methods) > 1 {
+		return "", "", fmt.Errorf("%w: %v", domain.ErrMultipleClientAuthMethods, methods)
+	}
+
+	method := models.TokenEndpointAuthMethodNone
+	if len(methods) == 1 {
+		method = methods[0]
+	}
+
+	clientID := input.ClientID
+	switch method {
+	case models.TokenEndpointAuthMethodClientSecretBasic:
+		if clientID != "" && clientID != input.BasicClientID {
+			return "", "", fmt.Errorf("%w: client_id does not match the authorization header", domain.ErrInvalidClientSecret)
+		}
+
+		clientID = input.BasicClientID
+	case models.TokenEndpointAuthMethodPrivateKeyJWT:
+		// client_id é opcional com asserções; o sub ainda será verificado com a assinatura.
+		if clientID == "" {
+			clientID = unverifiedAssertionSubject(input.ClientAssertion)
+		}
+	}
+
+	if clientID == "" {
+		return "", "", fmt.Errorf("%w: missing client_id", domain.ErrInvalidClientSecret)
+	}
+
+	return method, clientID, nil
+}
+
+func verifyClientSecret(client *entities.Client, clientSecret string) error {
+	if client.SecretHash == "" {
+		return fmt.Errorf("%w: client has no secret", domain.ErrInvalidClientSecret)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(client.SecretHash), []byte(clientSecret)); err != nil {
+		return domain.ErrInvalidClientSecret
+	}
+
+	return nil
+}
+
+// verifyTLSClientAuth exige uma cadeia emitida por uma das CAs configuradas e o
+// subject DN registrado para o cliente (RFC 8705, seção 2.1).
+func (a *clientAuthenticator) verifyTLSClientAuth(client *entities.Client, chain []*x509.Certificate) error {
+	if err := a.clientCAs.Verify(chain); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidClientCertificate, err)
+	}
+
+	if chain[0].Subject.String() != client.TLSClientAuthSubjectDN {
+		return fmt.Errorf("%w: subject DN does not match", domain.ErrInvalidClientCertificate)
+	}
+
+	return nil
+}
+
+// verifySelfSignedTLSClientAuth compara o certificado apresentado com o thumbprint
+// registrado; sem cadeia, a confiança vem apenas do cadastro (RFC 8705, seção 2.2).
+func verifySelfSignedTLSClientAuth(client *entities.Client, chain []*x509.Certificate) error {
+	if len(chain) == 0 {
+		return fmt.Errorf("%w: no client certificate", domain.ErrInvalidClientCertificate)
+	}
+
+	if mtls.Thumbprint(chain[0]) != client.TLSClientCertificateThumbprint {
+		return fmt.Errorf("%w: certificate thumbprint does not match", domain.ErrInvalidClientCertificate)
+	}
+
+	now := time.Now()
+	if now.Before(chain[0].NotBefore) || now.After(chain[0].NotAfter) {
+		return fmt.Errorf("%w: certificate is not valid at this time", domain.ErrInvalidClientCertificate)
+	}
+
+	return nil
+}
+
+// verifyClientAssertion valida a asserção conforme a RFC 7523 (seção 3): iss e sub
+// iguais ao client_id, aud apontando para o servidor, exp obrigatório e jti de uso único.
+func (a *clientAuthenticator) verifyClientAssertion(ctx context.Context, client *entities.Client, input models.ClientAuthenticationInput) error {
+	if input.ClientAssertionType != models.ClientAssertionTypeJWTBearer {
+		return fmt.Errorf("%w: unsupported client_assertion_type %q", domain.ErrInvalidClientAssertion, input.ClientAssertionType)
+	}
+
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(input.ClientAssertion, &claims, func(token *jwt.Token) (any, error) {
+		return clientAssertionKey(client, token)
+	},
+		jwt.WithValidMethods(signer.SupportedAlgorithms),
+		jwt.WithIssuer(client.ClientID),
+		jwt.WithSubject(client.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidClientAssertion, err)
+	}
+
+	issuer := Issuer(a.config)
+	audiences := []string{issuer, issuer + input.Endpoint}
+	if !slices.ContainsFunc(claims.Audience, func(audience string) bool { return slices.Contains(audiences, audience) }) {
+		return fmt.Errorf("%w: invalid audience", domain.ErrInvalidClientAssertion)
+	}
+
+	if claims.ID == "" {
+		return fmt.Errorf("%w: missing jti", domain.ErrInvalidClientAssertion)
+	}
+
+	err = a.clientAssertionRepo.Create(ctx, &entities.ClientAssertion{
+		ClientID:  client.ClientID,
+		JTI:       claims.ID,
+		ExpiresAt: claims.ExpiresAt.Time,
+	})
+	if errors.Is(err, domain.ErrClientAssertionReplayed) {
+		return fmt.Errorf("%w: assertion already used", domain.ErrInvalidClientAssertion)
+	}
+
+	if err != nil {
+		return fmt.Errorf("store client assertion jti: %w", err)
+	}
+
+	return nil
+}
+
+// clientAssertionKey escolhe a chave pelo kid do header; sem kid, só é aceito
+// quando o cliente registrou uma única chave.
+func clientAssertionKey(client *entities.Client, token *jwt.Token) (crypto.PublicKey, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	var candidates []signer.JWK
+	for _, jwk := range client.JWKS {
+		if kid == "" || jwk.Kid == kid {
+			candidates = append(candidates, jwk)
+		}
+	}
+
+	if len(candidates) != 1 {
+		return nil, errors.New("no matching client key")
+	}
+
+	jwk := candidates[0]
+	if jwk.Alg != "" && jwk.Alg != token.Method.Alg() {
+		return nil, fmt.Errorf("client key does not allow %s", token.Method.Alg())
+	}
+
+	publicKey, err := jwk.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("parse client key: %w", err)
+	}
+
+	if err := signer.ValidateKey(token.Method.Alg(), publicKey); err != nil {
+		return nil, err
+	}
+
+	return publicKey, nil
+}
+
+func unverifiedAssertionSubject(assertion string) string {
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(assertion, &claims); err != nil {
+		return ""
+	}
+
+	return claims.Subject
+}
+
+// decodeBasicAuth decodifica as credenciais do header Basic, que a RFC 6749
+// (seção 2.3.1) exige codificadas como application/x-www-form-urlencoded.
+func decodeBasicAuth(username, password string) (string, string, error) {
+	clientID, err := url.QueryUnescape(username)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: malformed authorization header", domain.ErrInvalidClientSecret)
+	}
+
+	clientSecret, err := url.QueryUnescape(password)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: malformed authorization header", domain.ErrInvalidClientSecret)
+	}
+
+	return clientID, clientSecret, nil
+}
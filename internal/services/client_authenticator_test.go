@@ -0,0 +1,441 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/mtls"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthenticateClient(t *testing.T) {
+	secretHash, err := bcrypt.GenerateFromPassword([]byte("s3cret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+
+	t.Run("should accept public clients without credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &entities.Client{ClientID: "spa"}
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "spa").Return(client, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{ClientID: "spa"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, client, result)
+	})
+
+	t.Run("should authenticate client_secret_basic with form-encoded credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &entities.Client{ClientID: "batch job", SecretHash: string(secretHash), TokenEndpointAuthMethod: "client_secret_basic"}
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "batch job").Return(client, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			HasBasicAuth:      true,
+			BasicClientID:     "batch+job",
+			BasicClientSecret: "s3cret",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, client, result)
+	})
+
+	t.Run("should return error when client secret does not match", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &entities.Client{ClientID: "batch-job", SecretHash: string(secretHash), TokenEndpointAuthMethod: "client_secret_post"}
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "batch-job").Return(client, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{ClientID: "batch-job", ClientSecret: "wrong"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientSecret)
+	})
+
+	t.Run("should return error when confidential client omits its credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &entities.Client{ClientID: "batch-job", SecretHash: string(secretHash), TokenEndpointAuthMethod: "client_secret_basic"}
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "batch-job").Return(client, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{ClientID: "batch-job"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrClientAuthMethodMismatch)
+	})
+
+	t.Run("should return error when more than one method is used", func(t *testing.T) {
+		// Arrange
+		authenticator := NewClientAuthenticator(nil, nil, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(context.Background(), models.ClientAuthenticationInput{
+			ClientID:          "batch-job",
+			ClientSecret:      "s3cret",
+			HasBasicAuth:      true,
+			BasicClientID:     "batch-job",
+			BasicClientSecret: "s3cret",
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrMultipleClientAuthMethods)
+	})
+}
+
+func TestAuthenticateClientPrivateKeyJWT(t *testing.T) {
+	config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwk, err := signer.NewJWK(&privateKey.PublicKey, signer.ES256)
+	require.NoError(t, err)
+
+	client := &entities.Client{ClientID: "signed-client", TokenEndpointAuthMethod: "private_key_jwt", JWKS: []signer.JWK{jwk}}
+
+	newAssertion := func(t *testing.T, audience string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+			Issuer:    "signed-client",
+			Subject:   "signed-client",
+			Audience:  jwt.ClaimStrings{audience},
+			ID:        "assertion-id",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		})
+		token.Header["kid"] = jwk.Kid
+
+		assertion, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		return assertion
+	}
+
+	t.Run("should accept a signed assertion and record its jti", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "signed-client").Return(client, nil)
+
+		mockClientAssertionRepo := mocks.NewClientAssertionRepositoryMock(t)
+		mockClientAssertionRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(assertion *entities.ClientAssertion) bool {
+				return assertion.ClientID == "signed-client" && assertion.JTI == "assertion-id" && !assertion.ExpiresAt.IsZero()
+			})).
+			Return(nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, mockClientAssertionRepo, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientAssertionType: models.ClientAssertionTypeJWTBearer,
+			ClientAssertion:     newAssertion(t, "https://id.example.com/api/v1/oauth/token"),
+			Endpoint:            "/api/v1/oauth/token",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, client, result)
+	})
+
+	t.Run("should reject a replayed assertion", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "signed-client").Return(client, nil)
+
+		mockClientAssertionRepo := mocks.NewClientAssertionRepositoryMock(t)
+		mockClientAssertionRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*entities.ClientAssertion")).
+			Return(domain.ErrClientAssertionReplayed)
+
+		authenticator := NewClientAuthenticator(mockRepo, mockClientAssertionRepo, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientID:            "signed-client",
+			ClientAssertionType: models.ClientAssertionTypeJWTBearer,
+			ClientAssertion:     newAssertion(t, "https://id.example.com"),
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientAssertion)
+	})
+
+	t.Run("should reject an assertion for another audience", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "signed-client").Return(client, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientAssertionType: models.ClientAssertionTypeJWTBearer,
+			ClientAssertion:     newAssertion(t, "https://other.example.com"),
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientAssertion)
+	})
+
+	t.Run("should reject an assertion signed by an unregistered key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+			Issuer:    "signed-client",
+			Subject:   "signed-client",
+			Audience:  jwt.ClaimStrings{"https://id.example.com"},
+			ID:        "assertion-id",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		})
+		assertion, err := token.SignedString(otherKey)
+		require.NoError(t, err)
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "signed-client").Return(client, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, nil)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientAssertionType: models.ClientAssertionTypeJWTBearer,
+			ClientAssertion:     assertion,
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientAssertion)
+	})
+}
+
+// newTestCertificate emite um certificado de cliente; sem parent ele é auto-assinado.
+func newTestCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name, Organization: []string{"Acme"}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+	}
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
+
+func TestAuthenticateClientTLS(t *testing.T) {
+	config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+
+	ca, caKey := newTestCertificate(t, "Acme CA", nil, nil)
+	caFile := filepath.Join(t.TempDir(), "client-ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}), 0o600))
+
+	clientCAs, err := mtls.NewClientCAs(&configs.Environment{TLS: configs.TLS{ClientCAFile: caFile}})
+	require.NoError(t, err)
+
+	clientCert, _ := newTestCertificate(t, "orders", ca, caKey)
+	selfSignedCert, _ := newTestCertificate(t, "partner", nil, nil)
+
+	pkiClient := &entities.Client{ClientID: "orders", TokenEndpointAuthMethod: "tls_client_auth", TLSClientAuthSubjectDN: "CN=orders,O=Acme"}
+	selfSignedClient := &entities.Client{ClientID: "partner", TokenEndpointAuthMethod: "self_signed_tls_client_auth", TLSClientCertificateThumbprint: mtls.Thumbprint(selfSignedCert)}
+
+	t.Run("should accept a certificate issued by a trusted CA with the registered subject", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "orders").Return(pkiClient, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, clientCAs)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientID:           "orders",
+			ClientCertificates: []*x509.Certificate{clientCert},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, pkiClient, result)
+	})
+
+	t.Run("should reject a trusted certificate with another subject", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		otherCert, _ := newTestCertificate(t, "billing", ca, caKey)
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "orders").Return(pkiClient, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, clientCAs)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientID:           "orders",
+			ClientCertificates: []*x509.Certificate{otherCert},
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+
+	t.Run("should reject a self-signed certificate for tls_client_auth", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		forgedCert, _ := newTestCertificate(t, "orders", nil, nil)
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "orders").Return(pkiClient, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, clientCAs)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientID:           "orders",
+			ClientCertificates: []*x509.Certificate{forgedCert},
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+
+	t.Run("should reject tls_client_auth without a client certificate", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "orders").Return(pkiClient, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, clientCAs)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{ClientID: "orders"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrClientAuthMethodMismatch)
+	})
+
+	t.Run("should accept the registered self-signed certificate", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "partner").Return(selfSignedClient, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, clientCAs)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientID:           "partner",
+			ClientCertificates: []*x509.Certificate{selfSignedCert},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, selfSignedClient, result)
+	})
+
+	t.Run("should reject a self-signed certificate with another thumbprint", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		otherCert, _ := newTestCertificate(t, "partner", nil, nil)
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().GetByClientID(ctx, "partner").Return(selfSignedClient, nil)
+
+		authenticator := NewClientAuthenticator(mockRepo, nil, config, clientCAs)
+
+		// Act
+		result, err := authenticator.Authenticate(ctx, models.ClientAuthenticationInput{
+			ClientID:           "partner",
+			ClientCertificates: []*x509.Certificate{otherCert},
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+}
@@ -5,13 +5,17 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aetheris-lab/aetheris-id/api/configs"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestCreateClient(t *testing.T) {
@@ -32,10 +36,10 @@ func TestCreateClient(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*entities.Client")).
 			Return(nil)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
-		result, err := service.CreateClient(ctx, name, description, redirectURIs, grantTypes)
+		result, err := service.CreateClient(ctx, models.CreateClientInput{Name: name, Description: description, RedirectURIs: redirectURIs, GrantTypes: grantTypes})
 
 		// Assert
 		require.NoError(t, err)
@@ -45,6 +49,7 @@ func TestCreateClient(t *testing.T) {
 		assert.Equal(t, description, result.Description)
 		assert.Equal(t, redirectURIs, result.RedirectURIs)
 		assert.NotEmpty(t, result.Scopes)
+		assert.Equal(t, "ES256", result.IDTokenSignedResponseAlg)
 	})
 
 	t.Run("should return error when client already exists", func(t *testing.T) {
@@ -69,10 +74,10 @@ func TestCreateClient(t *testing.T) {
 			GetByClientID(ctx, "existing-client@aetheris-lab-connect").
 			Return(existingClient, nil)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
-		result, err := service.CreateClient(ctx, name, description, redirectURIs, grantTypes)
+		result, err := service.CreateClient(ctx, models.CreateClientInput{Name: name, Description: description, RedirectURIs: redirectURIs, GrantTypes: grantTypes})
 
 		// Assert
 		require.Error(t, err)
@@ -95,10 +100,10 @@ func TestCreateClient(t *testing.T) {
 			GetByClientID(ctx, "test-client@aetheris-lab-connect").
 			Return(nil, expectedError)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
-		result, err := service.CreateClient(ctx, name, description, redirectURIs, grantTypes)
+		result, err := service.CreateClient(ctx, models.CreateClientInput{Name: name, Description: description, RedirectURIs: redirectURIs, GrantTypes: grantTypes})
 
 		// Assert
 		require.Error(t, err)
@@ -125,10 +130,10 @@ func TestCreateClient(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*entities.Client")).
 			Return(expectedError)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
-		result, err := service.CreateClient(ctx, name, description, redirectURIs, grantTypes)
+		result, err := service.CreateClient(ctx, models.CreateClientInput{Name: name, Description: description, RedirectURIs: redirectURIs, GrantTypes: grantTypes})
 
 		// Assert
 		require.Error(t, err)
@@ -154,10 +159,10 @@ func TestCreateClient(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*entities.Client")).
 			Return(nil)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
-		result, err := service.CreateClient(ctx, name, description, redirectURIs, grantTypes)
+		result, err := service.CreateClient(ctx, models.CreateClientInput{Name: name, Description: description, RedirectURIs: redirectURIs, GrantTypes: grantTypes})
 
 		// Assert
 		require.NoError(t, err)
@@ -182,15 +187,219 @@ func TestCreateClient(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*entities.Client")).
 			Return(nil)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
-		result, err := service.CreateClient(ctx, name, description, redirectURIs, grantTypes)
+		result, err := service.CreateClient(ctx, models.CreateClientInput{Name: name, Description: description, RedirectURIs: redirectURIs, GrantTypes: grantTypes})
 
 		// Assert
 		require.NoError(t, err)
 		assert.NotNil(t, result)
 	})
+	t.Run("should return a client secret and store only its hash for client_credentials clients", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		var storedClient *entities.Client
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().
+			GetByClientID(ctx, "batch-job@aetheris-lab-connect").
+			Return(nil, domain.ErrClientNotFound)
+
+		mockRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*entities.Client")).
+			Run(func(_ context.Context, client *entities.Client) { storedClient = client }).
+			Return(nil)
+
+		service := NewClientService(mockRepo, &configs.Environment{Security: configs.Security{BcryptCost: bcrypt.MinCost}})
+
+		// Act
+		result, err := service.CreateClient(ctx, models.CreateClientInput{
+			Name:         "Batch Job",
+			Description:  "Nightly batch job",
+			RedirectURIs: []string{"https://example.com/callback"},
+			GrantTypes:   []string{"client_credentials"},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.NotEmpty(t, result.ClientSecret)
+		require.NotNil(t, storedClient)
+		assert.NotEqual(t, result.ClientSecret, storedClient.SecretHash)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(storedClient.SecretHash), []byte(result.ClientSecret)))
+		assert.Equal(t, "client_secret_basic", result.TokenEndpointAuthMethod)
+	})
+
+	t.Run("should not generate a client secret for public clients", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().
+			GetByClientID(ctx, "spa@aetheris-lab-connect").
+			Return(nil, domain.ErrClientNotFound)
+
+		mockRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(client *entities.Client) bool { return client.SecretHash == "" })).
+			Return(nil)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.CreateClient(ctx, models.CreateClientInput{
+			Name:         "SPA",
+			Description:  "Single page app",
+			RedirectURIs: []string{"https://example.com/callback"},
+			GrantTypes:   []string{"authorization_code"},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, result.ClientSecret)
+	})
+	t.Run("should return error when private_key_jwt client has no jwks", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().
+			GetByClientID(ctx, "signed-client@aetheris-lab-connect").
+			Return(nil, domain.ErrClientNotFound)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.CreateClient(ctx, models.CreateClientInput{
+			Name:                    "Signed Client",
+			GrantTypes:              []string{"client_credentials"},
+			TokenEndpointAuthMethod: "private_key_jwt",
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientMetadata)
+	})
+
+	t.Run("should return error when tls_client_auth client has no subject DN", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().
+			GetByClientID(ctx, "mtls-client@aetheris-lab-connect").
+			Return(nil, domain.ErrClientNotFound)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.CreateClient(ctx, models.CreateClientInput{
+			Name:                    "MTLS Client",
+			GrantTypes:              []string{"client_credentials"},
+			TokenEndpointAuthMethod: "tls_client_auth",
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientMetadata)
+	})
+
+	t.Run("should return error when jwks registered for request objects is invalid", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().
+			GetByClientID(ctx, "partner-app@aetheris-lab-connect").
+			Return(nil, domain.ErrClientNotFound)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.CreateClient(ctx, models.CreateClientInput{
+			Name:       "Partner App",
+			GrantTypes: []string{"authorization_code"},
+			JWKS:       []signer.JWK{{Kty: "EC", Crv: "P-256", X: "invalid"}},
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientMetadata)
+	})
+
+	t.Run("should return error when public client requests client_credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().
+			GetByClientID(ctx, "public-job@aetheris-lab-connect").
+			Return(nil, domain.ErrClientNotFound)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.CreateClient(ctx, models.CreateClientInput{
+			Name:                    "Public Job",
+			GrantTypes:              []string{"client_credentials"},
+			TokenEndpointAuthMethod: "none",
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientMetadata)
+	})
+}
+
+func TestSetTokenExchangePolicy(t *testing.T) {
+	t.Run("should store the policy of the client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		policy := &entities.TokenExchangePolicy{Audiences: []string{"https://payments.internal"}}
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().UpdateTokenExchangePolicy(ctx, "orders-api", policy).Return(nil)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		err := service.SetTokenExchangePolicy(ctx, "orders-api", policy)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when policy has no audiences", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewClientService(mocks.NewClientRepositoryMock(t), &configs.Environment{})
+
+		// Act
+		err := service.SetTokenExchangePolicy(ctx, "orders-api", &entities.TokenExchangePolicy{})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClientMetadata)
+	})
+
+	t.Run("should return error when client is not found", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		policy := &entities.TokenExchangePolicy{Audiences: []string{"https://payments.internal"}}
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().UpdateTokenExchangePolicy(ctx, "unknown", policy).Return(domain.ErrClientNotFound)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		err := service.SetTokenExchangePolicy(ctx, "unknown", policy)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrClientNotFound)
+	})
 }
 
 func TestGetClientByClientID(t *testing.T) {
@@ -213,7 +422,7 @@ func TestGetClientByClientID(t *testing.T) {
 			GetByClientID(ctx, clientID).
 			Return(expectedClient, nil)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
 		result, err := service.GetClientByClientID(ctx, clientID)
@@ -234,7 +443,7 @@ func TestGetClientByClientID(t *testing.T) {
 			GetByClientID(ctx, clientID).
 			Return(nil, domain.ErrClientNotFound)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
 		result, err := service.GetClientByClientID(ctx, clientID)
@@ -257,7 +466,7 @@ func TestGetClientByClientID(t *testing.T) {
 			GetByClientID(ctx, clientID).
 			Return(nil, expectedError)
 
-		service := NewClientService(mockRepo)
+		service := NewClientService(mockRepo, &configs.Environment{})
 
 		// Act
 		result, err := service.GetClientByClientID(ctx, clientID)
@@ -475,3 +684,65 @@ func TestGenerateClientID(t *testing.T) {
 		assert.Equal(t, "my-testclient123@aetheris-lab-connect", result)
 	})
 }
+
+func TestGrantScope(t *testing.T) {
+	t.Run("should add an administrative scope to the client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().AddScope(ctx, "orders-api", "token:introspect").Return(nil)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		err := service.GrantScope(ctx, "orders-api", "token:introspect")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should let an administrator grant resource server registration", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().AddScope(ctx, "platform-admin", "resource_servers:create").Return(nil)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		err := service.GrantScope(ctx, "platform-admin", "resource_servers:create")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when scope does not exist", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewClientService(mocks.NewClientRepositoryMock(t), &configs.Environment{})
+
+		// Act
+		err := service.GrantScope(ctx, "orders-api", "token:everything")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("should return error when client is not found", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewClientRepositoryMock(t)
+		mockRepo.EXPECT().AddScope(ctx, "unknown", "token:introspect").Return(domain.ErrClientNotFound)
+
+		service := NewClientService(mockRepo, &configs.Environment{})
+
+		// Act
+		err := service.GrantScope(ctx, "unknown", "token:introspect")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrClientNotFound)
+	})
+}
@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+)
+
+const consentChallengeLength = 32
+
+type ConsentService interface {
+	ConsentRequired(ctx context.Context, client *entities.Client, input models.AuthorizeInput) (bool, error)
+	CreateConsentRequest(ctx context.Context, input models.AuthorizeInput, continueURL string) (string, error)
+	GetConsentRequest(ctx context.Context, userID, consentChallenge string) (*models.ConsentRequestResponse, error)
+	DecideConsentRequest(ctx context.Context, input models.ConsentDecisionInput) (*models.ConsentDecisionResponse, error)
+	GrantScopes(ctx context.Context, userID, clientID string, scopes []string) error
+	RecordGrantUse(ctx context.Context, userID, clientID string, scopes []string) error
+	ListGrants(ctx context.Context, userID string) ([]models.GrantResponse, error)
+	RevokeGrant(ctx context.Context, userID, clientID string) error
+}
+
+type consentService struct {
+	consentRepo           repositories.ConsentRepository
+	consentRequestRepo    repositories.ConsentRequestRepository
+	authorizationCodeRepo repositories.AuthorizationCodeRepository
+	deviceCodeRepo        repositories.DeviceCodeRepository
+	clientService         ClientService
+	refreshTokenService   RefreshTokenService
+	config                *configs.Environment
+}
+
+func NewConsentService(
+	consentRepo repositories.ConsentRepository,
+	consentRequestRepo repositories.ConsentRequestRepository,
+	authorizationCodeRepo repositories.AuthorizationCodeRepository,
+	deviceCodeRepo repositories.DeviceCodeRepository,
+	clientService ClientService,
+	refreshTokenService RefreshTokenService,
+	config *configs.Environment,
+) ConsentService {
+	return &consentService{
+		consentRepo:           consentRepo,
+		consentRequestRepo:    consentRequestRepo,
+		authorizationCodeRepo: authorizationCodeRepo,
+		deviceCodeRepo:        deviceCodeRepo,
+		clientService:         clientService,
+		refreshTokenService:   refreshTokenService,
+		config:                config,
+	}
+}
+
+// ConsentRequired decide se a autorização passa pela tela de consentimento. Um
+// consent_challenge aprovado é consumido e libera apenas os escopos aprovados.
+func (s *consentService) ConsentRequired(ctx context.Context, client *entities.Client, input models.AuthorizeInput) (bool, error) {
+	if input.ConsentChallenge != "" {
+		request, err := s.consentRequestRepo.ConsumeApproved(ctx, hashToken(input.ConsentChallenge), input.UserID, client.ClientID)
+		if err != nil {
+			return false, fmt.Errorf("consume consent request: %w", err)
+		}
+
+		if !scopes.HasAllScopes(request.Scopes, input.Scope) {
+			return false, fmt.Errorf("%w: scope differs from the approved one", domain.ErrConsentRequestNotFound)
+		}
+
+		return false, nil
+	}
+
+	if input.HasPrompt(models.PromptConsent) {
+		return true, nil
+	}
+
+	if client.FirstParty {
+		return false, nil
+	}
+
+	// Consentimentos anteriores não valem para escopos que alteram a conta.
+	if slices.ContainsFunc(input.Scope, scopes.RequiresExplicitConsent) {
+		return true, nil
+	}
+
+	consent, err := s.consentRepo.FindByUserAndClient(ctx, input.UserID, client.ClientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrConsentNotFound) {
+			return true, nil
+		}
+
+		return false, fmt.Errorf("find consent: %w", err)
+	}
+
+	return !scopes.HasAllScopes(consent.Scopes, input.Scope), nil
+}
+
+// CreateConsentRequest guarda a autorização pendente e retorna a URL da tela de
+// consentimento; continueURL é o /authorize para onde o usuário volta ao aprovar.
+func (s *consentService) CreateConsentRequest(ctx context.Context, input models.AuthorizeInput, continueURL string) (string, error) {
+	consentChallenge, err := generateSecureRandomString(consentChallengeLength)
+	if err != nil {
+		return "", fmt.Errorf("generate consent challenge: %w", err)
+	}
+
+	if err := s.consentRequestRepo.Create(ctx, &entities.ConsentRequest{
+		ChallengeHash: hashToken(consentChallenge),
+		UserID:        input.UserID,
+		ClientID:      input.ClientID,
+		Scopes:        input.Scope,
+		RedirectURI:   input.RedirectURI,
+		State:         input.State,
+		ContinueURL:   continueURL,
+		Status:        entities.ConsentRequestStatusPending,
+		ExpiresAt:     time.Now().UTC().Add(s.config.Consent.RequestExpiration),
+	}); err != nil {
+		return "", fmt.Errorf("create consent request: %w", err)
+	}
+
+	consentURL, err := url.Parse(s.config.URLs.ClientConsentURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid consent url: %w", err)
+	}
+
+	query := consentURL.Query()
+	query.Set("consent_challenge", consentChallenge)
+	consentURL.RawQuery = query.Encode()
+
+	return consentURL.String(), nil
+}
+
+func (s *consentService) GetConsentRequest(ctx context.Context, userID, consentChallenge string) (*models.ConsentRequestResponse, error) {
+	request, err := s.consentRequestRepo.FindPending(ctx, hashToken(consentChallenge), userID)
+	if err != nil {
+		return nil, fmt.Errorf("find consent request: %w", err)
+	}
+
+	client, err := s.clientService.GetClientByClientID(ctx, request.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	requestedScopes := make([]scopes.Scope, 0, len(request.Scopes))
+	for _, name := range request.Scopes {
+		if scope, ok := scopes.GetScopeByName(name); ok {
+			requestedScopes = append(requestedScopes, scope)
+		}
+	}
+
+	grantedScopes := []string{}
+	consent, err := s.consentRepo.FindByUserAndClient(ctx, userID, client.ClientID)
+	if err != nil && !errors.Is(err, domain.ErrConsentNotFound) {
+		return nil, fmt.Errorf("find consent: %w", err)
+	}
+
+	if consent != nil {
+		grantedScopes = consent.Scopes
+	}
+
+	return &models.ConsentRequestResponse{
+		ClientID:      client.ClientID,
+		ClientName:    client.Name,
+		Scopes:        requestedScopes,
+		GrantedScopes: grantedScopes,
+	}, nil
+}
+
+// DecideConsentRequest registra a decisão do usuário. Ao aprovar, os escopos somam-se
+// ao consentimento do cliente e o navegador volta ao /authorize com o consent_challenge;
+// a recusa é devolvida como ErrAuthorizationRedirect para o cliente receber access_denied.
+func (s *consentService) DecideConsentRequest(ctx context.Context, input models.ConsentDecisionInput) (*models.ConsentDecisionResponse, error) {
+	challengeHash := hashToken(input.ConsentChallenge)
+
+	request, err := s.consentRequestRepo.FindPending(ctx, challengeHash, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find consent request: %w", err)
+	}
+
+	if !input.Approve {
+		if err := s.consentRequestRepo.Decide(ctx, challengeHash, input.UserID, entities.ConsentRequestStatusDenied); err != nil {
+			return nil, fmt.Errorf("decide consent request: %w", err)
+		}
+
+		return nil, &domain.ErrAuthorizationRedirect{
+			RedirectURI: request.RedirectURI,
+			State:       request.State,
+			Err:         domain.ErrConsentDenied,
+		}
+	}
+
+	grantedScopes := request.Scopes
+	consent, err := s.consentRepo.FindByUserAndClient(ctx, input.UserID, request.ClientID)
+	if err != nil && !errors.Is(err, domain.ErrConsentNotFound) {
+		return nil, fmt.Errorf("find consent: %w", err)
+	}
+
+	if consent != nil {
+		grantedScopes = mergeScopes(consent.Scopes, request.Scopes)
+	}
+
+	if err := s.consentRepo.Save(ctx, &entities.Consent{
+		UserID:   input.UserID,
+		ClientID: request.ClientID,
+		Scopes:   grantedScopes,
+	}); err != nil {
+		return nil, fmt.Errorf("save consent: %w", err)
+	}
+
+	if err := s.consentRequestRepo.Decide(ctx, challengeHash, input.UserID, entities.ConsentRequestStatusApproved); err != nil {
+		return nil, fmt.Errorf("decide consent request: %w", err)
+	}
+
+	continueURL, err := url.Parse(request.ContinueURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid continue url: %w", err)
+	}
+
+	query := continueURL.Query()
+	query.Set("consent_challenge", input.ConsentChallenge)
+	continueURL.RawQuery = query.Encode()
+
+	return &models.ConsentDecisionResponse{RedirectTo: continueURL.String()}, nil
+}
+
+// GrantScopes registra os escopos autorizados sem a tela de consentimento: clientes
+// first-party no /authorize e aprovações do device flow.
+func (s *consentService) GrantScopes(ctx context.Context, userID, clientID string, scopes []string) error {
+	if err := s.consentRepo.Grant(ctx, userID, clientID, scopes); err != nil {
+		return fmt.Errorf("grant consent: %w", err)
+	}
+
+	return nil
+}
+
+// RecordGrantUse atualiza last_used_at do consentimento existente; sem consentimento
+// (cliente desconectado) nada é registrado.
+func (s *consentService) RecordGrantUse(ctx context.Context, userID, clientID string, scopes []string) error {
+	if err := s.consentRepo.RecordUse(ctx, userID, clientID, scopes); err != nil {
+		return fmt.Errorf("record consent use: %w", err)
+	}
+
+	return nil
+}
+
+func (s *consentService) ListGrants(ctx context.Context, userID string) ([]models.GrantResponse, error) {
+	consents, err := s.consentRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find consents by user: %w", err)
+	}
+
+	grants := make([]models.GrantResponse, 0, len(consents))
+	for _, consent := range consents {
+		client, err := s.clientService.GetClientByClientID(ctx, consent.ClientID)
+		if err != nil && !errors.Is(err, domain.ErrClientNotFound) {
+			return nil, fmt.Errorf("get client by client_id: %w", err)
+		}
+
+		// Um cliente removido continua listado para que o usuário possa desconectá-lo.
+		var clientName string
+		if client != nil {
+			clientName = client.Name
+		}
+
+		grants = append(grants, models.GrantResponse{
+			ClientID:   consent.ClientID,
+			ClientName: clientName,
+			Scopes:     consent.Scopes,
+			CreatedAt:  consent.CreatedAt,
+			LastUsedAt: consent.LastUsedAt,
+		})
+	}
+
+	return grants, nil
+}
+
+// RevokeGrant desconecta o cliente: descarta os códigos de autorização e de dispositivo
+// ainda não trocados, remove o consentimento, fazendo a próxima autorização voltar à
+// tela de consentimento, e revoga os refresh tokens do usuário. A ausência do
+// consentimento não impede a revogação. Access tokens já emitidos valem até expirar.
+func (s *consentService) RevokeGrant(ctx context.Context, userID, clientID string) error {
+	if err := s.authorizationCodeRepo.DeleteUnconsumedByUserAndClient(ctx, userID, clientID); err != nil {
+		return fmt.Errorf("delete authorization codes: %w", err)
+	}
+
+	if err := s.deviceCodeRepo.DenyApprovedByUserAndClient(ctx, userID, clientID); err != nil {
+		return fmt.Errorf("deny device codes: %w", err)
+	}
+
+	if err := s.consentRepo.Delete(ctx, userID, clientID); err != nil && !errors.Is(err, domain.ErrConsentNotFound) {
+		return fmt.Errorf("delete consent: %w", err)
+	}
+
+	if err := s.refreshTokenService.RevokeByUserAndClient(ctx, userID, clientID); err != nil {
+		return fmt.Errorf("revoke refresh tokens: %w", err)
+	}
+
+	return nil
+}
+
+func mergeScopes(granted, requested []string) []string {
+	merged := slices.Clone(granted)
+	for _, scope := range requested {
+		if !slices.Contains(merged, scope) {
+			merged = append(merged, scope)
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,415 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsentRequired(t *testing.T) {
+	client := &entities.Client{ClientID: "partner-app"}
+	newInput := func(scope ...string) models.AuthorizeInput {
+		return models.AuthorizeInput{ClientID: "partner-app", UserID: "user-id", Scope: scope}
+	}
+
+	t.Run("should skip consent for first-party clients", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewConsentService(nil, nil, nil, nil, nil, nil, &configs.Environment{})
+
+		// Act
+		required, err := service.ConsentRequired(ctx, &entities.Client{ClientID: "portal", FirstParty: true}, newInput("openid", "profile"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, required)
+	})
+
+	t.Run("should require consent when prompt is consent even for first-party clients", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewConsentService(nil, nil, nil, nil, nil, nil, &configs.Environment{})
+
+		input := newInput("openid")
+		input.Prompt = []string{models.PromptConsent}
+
+		// Act
+		required, err := service.ConsentRequired(ctx, &entities.Client{ClientID: "portal", FirstParty: true}, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, required)
+	})
+
+	t.Run("should skip consent when a previous grant covers the requested scopes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		service := NewConsentService(mockConsentRepo, nil, nil, nil, nil, nil, &configs.Environment{})
+
+		mockConsentRepo.EXPECT().FindByUserAndClient(ctx, "user-id", "partner-app").
+			Return(&entities.Consent{Scopes: []string{"openid", "profile", "email"}}, nil)
+
+		// Act
+		required, err := service.ConsentRequired(ctx, client, newInput("openid", "profile"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, required)
+	})
+
+	t.Run("should require consent when a new scope is requested", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		service := NewConsentService(mockConsentRepo, nil, nil, nil, nil, nil, &configs.Environment{})
+
+		mockConsentRepo.EXPECT().FindByUserAndClient(ctx, "user-id", "partner-app").
+			Return(&entities.Consent{Scopes: []string{"openid"}}, nil)
+
+		// Act
+		required, err := service.ConsentRequired(ctx, client, newInput("openid", "email"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, required)
+	})
+
+	t.Run("should always require consent for scopes that change the account", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewConsentService(nil, nil, nil, nil, nil, nil, &configs.Environment{})
+
+		// Act
+		required, err := service.ConsentRequired(ctx, client, newInput("openid", "profile:write"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, required)
+	})
+
+	t.Run("should require consent for a self-registered client that claims to be first-party", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		var payload models.CreateClientPayload
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"name": "Partner App",
+			"description": "Registered through the public API",
+			"redirect_uris": ["https://partner.example.com/callback"],
+			"grant_types": ["authorization_code"],
+			"first_party": true
+		}`), &payload))
+
+		var registered *entities.Client
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "partner-app@aetheris-lab-connect").Return(nil, domain.ErrClientNotFound)
+		mockClientRepo.EXPECT().Create(ctx, mock.AnythingOfType("*entities.Client")).
+			Run(func(_ context.Context, client *entities.Client) { registered = client }).
+			Return(nil)
+
+		_, err := NewClientService(mockClientRepo, &configs.Environment{}).CreateClient(ctx, models.NewCreateClientInput(payload))
+		require.NoError(t, err)
+
+		service := NewConsentService(nil, nil, nil, nil, nil, nil, &configs.Environment{})
+
+		// Act
+		required, err := service.ConsentRequired(ctx, registered, newInput("openid", "profile:write"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, registered.FirstParty)
+		assert.True(t, required)
+	})
+
+	t.Run("should consume an approved consent challenge", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRequestRepo := mocks.NewConsentRequestRepositoryMock(t)
+		service := NewConsentService(nil, mockConsentRequestRepo, nil, nil, nil, nil, &configs.Environment{})
+
+		input := newInput("openid", "profile:write")
+		input.ConsentChallenge = "challenge"
+
+		mockConsentRequestRepo.EXPECT().ConsumeApproved(ctx, hashToken("challenge"), "user-id", "partner-app").
+			Return(&entities.ConsentRequest{Scopes: []string{"openid", "profile:write"}}, nil)
+
+		// Act
+		required, err := service.ConsentRequired(ctx, client, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, required)
+	})
+
+	t.Run("should reject a consent challenge approved for fewer scopes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRequestRepo := mocks.NewConsentRequestRepositoryMock(t)
+		service := NewConsentService(nil, mockConsentRequestRepo, nil, nil, nil, nil, &configs.Environment{})
+
+		input := newInput("openid", "email")
+		input.ConsentChallenge = "challenge"
+
+		mockConsentRequestRepo.EXPECT().ConsumeApproved(ctx, hashToken("challenge"), "user-id", "partner-app").
+			Return(&entities.ConsentRequest{Scopes: []string{"openid"}}, nil)
+
+		// Act
+		_, err := service.ConsentRequired(ctx, client, input)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrConsentRequestNotFound)
+	})
+}
+
+func TestCreateConsentRequest(t *testing.T) {
+	t.Run("should store a hashed challenge and return the consent screen url", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRequestRepo := mocks.NewConsentRequestRepositoryMock(t)
+		config := &configs.Environment{
+			URLs:    configs.URLs{ClientConsentURL: "https://app.example.com/consent"},
+			Consent: configs.Consent{RequestExpiration: 10 * time.Minute},
+		}
+		service := NewConsentService(nil, mockConsentRequestRepo, nil, nil, nil, nil, config)
+
+		var stored *entities.ConsentRequest
+		mockConsentRequestRepo.EXPECT().Create(ctx, mock.AnythingOfType("*entities.ConsentRequest")).
+			Run(func(_ context.Context, request *entities.ConsentRequest) { stored = request }).
+			Return(nil)
+
+		// Act
+		consentURL, err := service.CreateConsentRequest(ctx, models.AuthorizeInput{
+			ClientID:    "partner-app",
+			UserID:      "user-id",
+			Scope:       []string{"openid"},
+			RedirectURI: "https://partner.example.com/callback",
+			State:       "state",
+		}, "https://id.example.com/api/v1/oauth/authorize?client_id=partner-app")
+
+		// Assert
+		require.NoError(t, err)
+		parsed, err := url.Parse(consentURL)
+		require.NoError(t, err)
+		assert.Equal(t, "app.example.com", parsed.Host)
+		assert.Equal(t, hashToken(parsed.Query().Get("consent_challenge")), stored.ChallengeHash)
+		assert.Equal(t, entities.ConsentRequestStatusPending, stored.Status)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/authorize?client_id=partner-app", stored.ContinueURL)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), stored.ExpiresAt, time.Minute)
+	})
+}
+
+func TestDecideConsentRequest(t *testing.T) {
+	request := &entities.ConsentRequest{
+		UserID:      "user-id",
+		ClientID:    "partner-app",
+		Scopes:      []string{"openid", "email"},
+		RedirectURI: "https://partner.example.com/callback",
+		State:       "state",
+		ContinueURL: "https://id.example.com/api/v1/oauth/authorize?client_id=partner-app",
+	}
+
+	t.Run("should merge the approved scopes into the existing grant", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockConsentRequestRepo := mocks.NewConsentRequestRepositoryMock(t)
+		service := NewConsentService(mockConsentRepo, mockConsentRequestRepo, nil, nil, nil, nil, &configs.Environment{})
+
+		mockConsentRequestRepo.EXPECT().FindPending(ctx, hashToken("challenge"), "user-id").Return(request, nil)
+		mockConsentRepo.EXPECT().FindByUserAndClient(ctx, "user-id", "partner-app").
+			Return(&entities.Consent{Scopes: []string{"openid", "profile"}}, nil)
+		mockConsentRepo.EXPECT().Save(ctx, &entities.Consent{
+			UserID:   "user-id",
+			ClientID: "partner-app",
+			Scopes:   []string{"openid", "profile", "email"},
+		}).Return(nil)
+		mockConsentRequestRepo.EXPECT().Decide(ctx, hashToken("challenge"), "user-id", entities.ConsentRequestStatusApproved).Return(nil)
+
+		// Act
+		result, err := service.DecideConsentRequest(ctx, models.ConsentDecisionInput{ConsentChallenge: "challenge", UserID: "user-id", Approve: true})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/authorize?client_id=partner-app&consent_challenge=challenge", result.RedirectTo)
+	})
+
+	t.Run("should return access_denied redirect when the user denies", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRequestRepo := mocks.NewConsentRequestRepositoryMock(t)
+		service := NewConsentService(nil, mockConsentRequestRepo, nil, nil, nil, nil, &configs.Environment{})
+
+		mockConsentRequestRepo.EXPECT().FindPending(ctx, hashToken("challenge"), "user-id").Return(request, nil)
+		mockConsentRequestRepo.EXPECT().Decide(ctx, hashToken("challenge"), "user-id", entities.ConsentRequestStatusDenied).Return(nil)
+
+		// Act
+		result, err := service.DecideConsentRequest(ctx, models.ConsentDecisionInput{ConsentChallenge: "challenge", UserID: "user-id"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrConsentDenied)
+
+		var redirectErr *domain.ErrAuthorizationRedirect
+		require.ErrorAs(t, err, &redirectErr)
+		assert.Equal(t, "https://partner.example.com/callback", redirectErr.RedirectURI)
+		assert.Equal(t, "state", redirectErr.State)
+	})
+}
+
+func TestListGrants(t *testing.T) {
+	t.Run("should list connected clients with their scopes and last use", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockClientService := mocks.NewClientServiceMock(t)
+		service := NewConsentService(mockConsentRepo, nil, nil, nil, mockClientService, nil, &configs.Environment{})
+
+		createdAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		lastUsedAt := createdAt.Add(time.Hour)
+		mockConsentRepo.EXPECT().FindByUser(ctx, "user-id").Return([]*entities.Consent{
+			{ClientID: "partner-app", Scopes: []string{"openid", "email"}, CreatedAt: createdAt, LastUsedAt: &lastUsedAt},
+			{ClientID: "removed-app", Scopes: []string{"openid"}, CreatedAt: createdAt},
+		}, nil)
+		mockClientService.EXPECT().GetClientByClientID(ctx, "partner-app").Return(&entities.Client{ClientID: "partner-app", Name: "Partner"}, nil)
+		mockClientService.EXPECT().GetClientByClientID(ctx, "removed-app").Return(nil, domain.ErrClientNotFound)
+
+		// Act
+		result, err := service.ListGrants(ctx, "user-id")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.GrantResponse{
+			{ClientID: "partner-app", ClientName: "Partner", Scopes: []string{"openid", "email"}, CreatedAt: createdAt, LastUsedAt: &lastUsedAt},
+			{ClientID: "removed-app", Scopes: []string{"openid"}, CreatedAt: createdAt},
+		}, result)
+	})
+}
+
+func TestRevokeGrant(t *testing.T) {
+	t.Run("should invalidate pending codes, delete the consent and revoke the client's refresh tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockAuthCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockDeviceCodeRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		service := NewConsentService(mockConsentRepo, nil, mockAuthCodeRepo, mockDeviceCodeRepo, nil, mockRefreshTokenService, &configs.Environment{})
+
+		mockAuthCodeRepo.EXPECT().DeleteUnconsumedByUserAndClient(ctx, "user-id", "partner-app").Return(nil)
+		mockDeviceCodeRepo.EXPECT().DenyApprovedByUserAndClient(ctx, "user-id", "partner-app").Return(nil)
+		mockConsentRepo.EXPECT().Delete(ctx, "user-id", "partner-app").Return(nil)
+		mockRefreshTokenService.EXPECT().RevokeByUserAndClient(ctx, "user-id", "partner-app").Return(nil)
+
+		// Act
+		err := service.RevokeGrant(ctx, "user-id", "partner-app")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should revoke the refresh tokens when there is no consent to delete", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockAuthCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockDeviceCodeRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		service := NewConsentService(mockConsentRepo, nil, mockAuthCodeRepo, mockDeviceCodeRepo, nil, mockRefreshTokenService, &configs.Environment{})
+
+		mockAuthCodeRepo.EXPECT().DeleteUnconsumedByUserAndClient(ctx, "user-id", "first-party-app").Return(nil)
+		mockDeviceCodeRepo.EXPECT().DenyApprovedByUserAndClient(ctx, "user-id", "first-party-app").Return(nil)
+		mockConsentRepo.EXPECT().Delete(ctx, "user-id", "first-party-app").Return(domain.ErrConsentNotFound)
+		mockRefreshTokenService.EXPECT().RevokeByUserAndClient(ctx, "user-id", "first-party-app").Return(nil)
+
+		// Act
+		err := service.RevokeGrant(ctx, "user-id", "first-party-app")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject an authorization code issued before the disconnect", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockAuthCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockDeviceCodeRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		consentService := NewConsentService(mockConsentRepo, nil, mockAuthCodeRepo, mockDeviceCodeRepo, nil, mockRefreshTokenService, config)
+		authCodeService := NewAuthorizationCodeService(mockAuthCodeRepo, mockRefreshTokenService, nil, config)
+		oauthService := NewOAuthService(nil, authCodeService, nil, nil, mockRefreshTokenService, config, nil, nil, nil, consentService)
+
+		pendingCode := &entities.AuthorizationCode{
+			Code:        "pending-code",
+			UserID:      "user-id",
+			ClientID:    "partner-app",
+			RedirectURI: "https://partner.example.com/callback",
+			ExpiresAt:   time.Now().Add(time.Minute),
+		}
+
+		disconnected := false
+		mockAuthCodeRepo.EXPECT().DeleteUnconsumedByUserAndClient(ctx, "user-id", "partner-app").
+			RunAndReturn(func(context.Context, string, string) error {
+				disconnected = true
+				return nil
+			})
+		mockAuthCodeRepo.EXPECT().FindByCode(ctx, "pending-code").
+			RunAndReturn(func(context.Context, string) (*entities.AuthorizationCode, error) {
+				if disconnected {
+					return nil, domain.ErrAuthorizationCodeNotFound
+				}
+
+				return pendingCode, nil
+			})
+		mockDeviceCodeRepo.EXPECT().DenyApprovedByUserAndClient(ctx, "user-id", "partner-app").Return(nil)
+		mockConsentRepo.EXPECT().Delete(ctx, "user-id", "partner-app").Return(nil)
+		mockRefreshTokenService.EXPECT().RevokeByUserAndClient(ctx, "user-id", "partner-app").Return(nil)
+
+		// Act
+		revokeErr := consentService.RevokeGrant(ctx, "user-id", "partner-app")
+		result, err := oauthService.ExchangeCodeForToken(ctx, models.ExchangeAuthorizationCodeInput{
+			Code:        "pending-code",
+			ClientID:    "partner-app",
+			RedirectURI: "https://partner.example.com/callback",
+		})
+
+		// Assert
+		require.NoError(t, revokeErr)
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrAuthorizationCodeNotFound)
+		mockConsentRepo.AssertNotCalled(t, "RecordUse", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when deleting the consent fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockAuthCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockDeviceCodeRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewConsentService(mockConsentRepo, nil, mockAuthCodeRepo, mockDeviceCodeRepo, nil, nil, &configs.Environment{})
+
+		mockAuthCodeRepo.EXPECT().DeleteUnconsumedByUserAndClient(ctx, "user-id", "partner-app").Return(nil)
+		mockDeviceCodeRepo.EXPECT().DenyApprovedByUserAndClient(ctx, "user-id", "partner-app").Return(nil)
+		mockConsentRepo.EXPECT().Delete(ctx, "user-id", "partner-app").Return(errors.New("database error"))
+
+		// Act
+		err := service.RevokeGrant(ctx, "user-id", "partner-app")
+
+		// Assert
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+)
+
+const (
+	deviceCodeLength = 32
+	userCodeLength   = 8
+	// userCodeCharset evita vogais e caracteres ambíguos, como sugere a RFC 8628 (seção 6.1).
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	// slowDownIncrement é o acréscimo ao intervalo exigido pela RFC 8628 (seção 3.5).
+	slowDownIncrement = 5 * time.Second
+)
+
+type DeviceAuthorizationService interface {
+	CreateDeviceAuthorization(ctx context.Context, input models.DeviceAuthorizationInput) (*models.DeviceAuthorizationResponse, error)
+	GetDeviceVerification(ctx context.Context, userCode string) (*models.DeviceVerificationResponse, error)
+	DecideDeviceAuthorization(ctx context.Context, input models.DeviceDecisionInput) error
+	ConsumeDeviceCode(ctx context.Context, deviceCode, clientID string) (*entities.DeviceCode, error)
+	LoginRedirectURL(continueURL string) (string, error)
+}
+
+type deviceAuthorizationService struct {
+	deviceCodeRepo repositories.DeviceCodeRepository
+	clientService  ClientService
+	consentService ConsentService
+	config         *configs.Environment
+}
+
+func NewDeviceAuthorizationService(
+	deviceCodeRepo repositories.DeviceCodeRepository,
+	clientService ClientService,
+	consentService ConsentService,
+	config *configs.Environment,
+) DeviceAuthorizationService {
+	return &deviceAuthorizationService{
+		deviceCodeRepo: deviceCodeRepo,
+		clientService:  clientService,
+		consentService: consentService,
+		config:         config,
+	}
+}
+
+func (s *deviceAuthorizationService) CreateDeviceAuthorization(ctx context.Context, input models.DeviceAuthorizationInput) (*models.DeviceAuthorizationResponse, error) {
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if !client.IsValidGrantType(models.GrantTypeDeviceCode) {
+		return nil, fmt.Errorf("device authorization %w: client does not support device_code grant type", domain.ErrUnauthorizedClient)
+	}
+
+	grantedScopes := client.Scopes
+	if len(input.Scope) > 0 {
+		if err := client.ValidateScopes(input.Scope); err != nil {
+			return nil, fmt.Errorf("device authorization: %w", err)
+		}
+
+		grantedScopes = input.Scope
+	}
+
+	deviceCodeValue, err := generateSecureRandomString(deviceCodeLength)
+	if err != nil {
+		return nil, fmt.Errorf("generate device code: %w", err)
+	}
+
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, fmt.Errorf("generate user code: %w", err)
+	}
+
+	deviceCode := &entities.DeviceCode{
+		DeviceCode:     deviceCodeValue,
+		DeviceCodeHash: hashToken(deviceCodeValue),
+		UserCode:       userCode,
+		ClientID:       client.ClientID,
+		Scopes:         grantedScopes,
+		Status:         entities.DeviceCodeStatusPending,
+		Interval:       s.config.Device.PollingInterval,
+		ExpiresAt:      time.Now().UTC().Add(s.config.Device.CodeExpirationMinutes),
+	}
+
+	if err := s.deviceCodeRepo.Create(ctx, deviceCode); err != nil {
+		return nil, fmt.Errorf("create device code: %w", err)
+	}
+
+	verificationURIComplete, err := url.Parse(input.VerificationURI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid verification uri: %w", err)
+	}
+
+	query := verificationURIComplete.Query()
+	query.Set("user_code", formatUserCode(userCode))
+	verificationURIComplete.RawQuery = query.Encode()
+
+	return &models.DeviceAuthorizationResponse{
+		DeviceCode:              deviceCodeValue,
+		UserCode:                formatUserCode(userCode),
+		VerificationURI:         input.VerificationURI,
+		VerificationURIComplete: verificationURIComplete.String(),
+		ExpiresIn:               int64(s.config.Device.CodeExpirationMinutes.Seconds()),
+		Interval:                int64(s.config.Device.PollingInterval.Seconds()),
+	}, nil
+}
+
+func (s *deviceAuthorizationService) GetDeviceVerification(ctx context.Context, userCode string) (*models.DeviceVerificationResponse, error) {
+	deviceCode, err := s.deviceCodeRepo.FindPendingByUserCode(ctx, normalizeUserCode(userCode))
+	if err != nil {
+		return nil, fmt.Errorf("find device code by user code: %w", err)
+	}
+
+	client, err := s.clientService.GetClientByClientID(ctx, deviceCode.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	return &models.DeviceVerificationResponse{
+		UserCode:   formatUserCode(deviceCode.UserCode),
+		ClientID:   client.ClientID,
+		ClientName: client.Name,
+		Scopes:     deviceCode.Scopes,
+	}, nil
+}
+
+// DecideDeviceAuthorization registra a decisão do usuário; a aprovação vale como
+// consentimento e passa a aparecer nos aplicativos conectados.
+func (s *deviceAuthorizationService) DecideDeviceAuthorization(ctx context.Context, input models.DeviceDecisionInput) error {
+	userCode := normalizeUserCode(input.UserCode)
+
+	if !input.Approve {
+		if err := s.deviceCodeRepo.Decide(ctx, userCode, input.UserID, entities.DeviceCodeStatusDenied); err != nil {
+			return fmt.Errorf("decide device authorization: %w", err)
+		}
+
+		return nil
+	}
+
+	deviceCode, err := s.deviceCodeRepo.FindPendingByUserCode(ctx, userCode)
+	if err != nil {
+		return fmt.Errorf("find device authorization: %w", err)
+	}
+
+	if err := s.deviceCodeRepo.Decide(ctx, userCode, input.UserID, entities.DeviceCodeStatusApproved); err != nil {
+		return fmt.Errorf("decide device authorization: %w", err)
+	}
+
+	if err := s.consentService.GrantScopes(ctx, input.UserID, deviceCode.ClientID, deviceCode.Scopes); err != nil {
+		return fmt.Errorf("grant device authorization: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeDeviceCode responde ao polling do dispositivo: enquanto o usuário não decide,
+// retorna authorization_pending, ou slow_down se o intervalo não for respeitado.
+func (s *deviceAuthorizationService) ConsumeDeviceCode(ctx context.Context, deviceCodeValue, clientID string) (*entities.DeviceCode, error) {
+	deviceCode, err := s.deviceCodeRepo.FindByDeviceCodeHash(ctx, hashToken(deviceCodeValue))
+	if err != nil {
+		return nil, fmt.Errorf("find device code: %w", err)
+	}
+
+	if deviceCode.ClientID != clientID {
+		return nil, fmt.Errorf("consume device code %w: %s", domain.ErrClientMismatch, clientID)
+	}
+
+	if deviceCode.IsExpired() {
+		return nil, fmt.Errorf("consume device code: %w", domain.ErrDeviceCodeExpired)
+	}
+
+	switch deviceCode.Status {
+	case entities.DeviceCodeStatusApproved:
+		consumed, err := s.deviceCodeRepo.Consume(ctx, deviceCode.ID.Hex())
+		if err != nil {
+			return nil, fmt.Errorf("consume device code: %w", err)
+		}
+
+		return consumed, nil
+	case entities.DeviceCodeStatusDenied:
+		return nil, fmt.Errorf("consume device code: %w", domain.ErrDeviceAccessDenied)
+	case entities.DeviceCodeStatusPending:
+		now := time.Now().UTC()
+		interval := deviceCode.Interval
+		pollingErr := domain.ErrAuthorizationPending
+		if deviceCode.IsPollingTooFast(now) {
+			interval += slowDownIncrement
+			pollingErr = domain.ErrSlowDown
+		}
+
+		if err := s.deviceCodeRepo.UpdatePolling(ctx, deviceCode.ID.Hex(), now, interval); err != nil {
+			return nil, fmt.Errorf("update device code polling: %w", err)
+		}
+
+		return nil, fmt.Errorf("consume device code: %w", pollingErr)
+	default:
+		return nil, fmt.Errorf("consume device code: %w", domain.ErrDeviceCodeNotFound)
+	}
+}
+
+// LoginRedirectURL envia o usuário não autenticado para o login, retornando à
+// página de verificação em seguida.
+func (s *deviceAuthorizationService) LoginRedirectURL(continueURL string) (string, error) {
+	loginURL, err := url.Parse(s.config.URLs.ClientLoginURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid login url: %w", err)
+	}
+
+	query := loginURL.Query()
+	query.Set("continue", continueURL)
+	loginURL.RawQuery = query.Encode()
+
+	return loginURL.String(), nil
+}
+
+func generateUserCode() (string, error) {
+	var userCode strings.Builder
+	max := big.NewInt(int64(len(userCodeCharset)))
+	for range userCodeLength {
+		index, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+
+		userCode.WriteByte(userCodeCharset[index.Int64()])
+	}
+
+	return userCode.String(), nil
+}
+
+// normalizeUserCode aceita o código como o usuário digitou, ignorando hífens,
+// espaços e caixa.
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+
+		return r
+	}, strings.ToUpper(userCode))
+}
+
+func formatUserCode(userCode string) string {
+	if len(userCode) != userCodeLength {
+		return userCode
+	}
+
+	return userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:]
+}
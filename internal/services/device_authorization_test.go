@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCreateDeviceAuthorization(t *testing.T) {
+	config := &configs.Environment{Device: configs.Device{CodeExpirationMinutes: 10 * time.Minute, PollingInterval: 5 * time.Second}}
+
+	t.Run("should store a hashed device code and return a formatted user code", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, mockClientService, nil, config)
+
+		client := &entities.Client{ClientID: "tv-app", GrantTypes: []string{"urn:ietf:params:oauth:grant-type:device_code"}, Scopes: []string{"openid", "profile"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "tv-app").Return(client, nil)
+
+		var stored *entities.DeviceCode
+		mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*entities.DeviceCode")).
+			Run(func(_ context.Context, deviceCode *entities.DeviceCode) { stored = deviceCode }).
+			Return(nil)
+
+		// Act
+		result, err := service.CreateDeviceAuthorization(ctx, models.DeviceAuthorizationInput{
+			ClientID:        "tv-app",
+			VerificationURI: "https://id.example.com/api/v1/oauth/device",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, hashToken(result.DeviceCode), stored.DeviceCodeHash)
+		assert.Equal(t, formatUserCode(stored.UserCode), result.UserCode)
+		assert.Regexp(t, `^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$`, result.UserCode)
+		assert.Equal(t, client.Scopes, stored.Scopes)
+		assert.Equal(t, entities.DeviceCodeStatusPending, stored.Status)
+		assert.Equal(t, "https://id.example.com/api/v1/oauth/device?user_code="+result.UserCode, result.VerificationURIComplete)
+		assert.Equal(t, int64(600), result.ExpiresIn)
+		assert.Equal(t, int64(5), result.Interval)
+	})
+
+	t.Run("should return error when client does not support the device grant", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		service := NewDeviceAuthorizationService(nil, mockClientService, nil, config)
+
+		client := &entities.Client{ClientID: "web-app", GrantTypes: []string{"authorization_code"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+
+		// Act
+		result, err := service.CreateDeviceAuthorization(ctx, models.DeviceAuthorizationInput{ClientID: "web-app"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+}
+
+func TestDecideDeviceAuthorization(t *testing.T) {
+	t.Run("should normalize the user code typed by the user and record the consent", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, mockConsentService, &configs.Environment{})
+
+		deviceCode := &entities.DeviceCode{ClientID: "tv-app", Scopes: []string{"openid"}}
+		mockRepo.EXPECT().FindPendingByUserCode(ctx, "BCDFGHJK").Return(deviceCode, nil)
+		mockRepo.EXPECT().Decide(ctx, "BCDFGHJK", "user-id", entities.DeviceCodeStatusApproved).Return(nil)
+		mockConsentService.EXPECT().GrantScopes(ctx, "user-id", "tv-app", []string{"openid"}).Return(nil)
+
+		// Act
+		err := service.DecideDeviceAuthorization(ctx, models.DeviceDecisionInput{UserCode: "bcdf-ghjk", UserID: "user-id", Approve: true})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should not record consent when the user denies", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, mocks.NewConsentServiceMock(t), &configs.Environment{})
+
+		mockRepo.EXPECT().Decide(ctx, "BCDFGHJK", "user-id", entities.DeviceCodeStatusDenied).Return(nil)
+
+		// Act
+		err := service.DecideDeviceAuthorization(ctx, models.DeviceDecisionInput{UserCode: "BCDF-GHJK", UserID: "user-id"})
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func TestConsumeDeviceCode(t *testing.T) {
+	newDeviceCode := func(status string) *entities.DeviceCode {
+		return &entities.DeviceCode{
+			ID:        primitive.NewObjectID(),
+			ClientID:  "tv-app",
+			Status:    status,
+			Interval:  5 * time.Second,
+			ExpiresAt: time.Now().UTC().Add(time.Minute),
+		}
+	}
+
+	t.Run("should consume an approved device code", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, nil, &configs.Environment{})
+
+		deviceCode := newDeviceCode(entities.DeviceCodeStatusApproved)
+		mockRepo.EXPECT().FindByDeviceCodeHash(ctx, hashToken("device-code")).Return(deviceCode, nil)
+		mockRepo.EXPECT().Consume(ctx, deviceCode.ID.Hex()).Return(deviceCode, nil)
+
+		// Act
+		result, err := service.ConsumeDeviceCode(ctx, "device-code", "tv-app")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, deviceCode, result)
+	})
+
+	t.Run("should return authorization_pending and record the poll", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, nil, &configs.Environment{})
+
+		deviceCode := newDeviceCode(entities.DeviceCodeStatusPending)
+		mockRepo.EXPECT().FindByDeviceCodeHash(ctx, hashToken("device-code")).Return(deviceCode, nil)
+		mockRepo.EXPECT().UpdatePolling(ctx, deviceCode.ID.Hex(), mock.AnythingOfType("time.Time"), 5*time.Second).Return(nil)
+
+		// Act
+		result, err := service.ConsumeDeviceCode(ctx, "device-code", "tv-app")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrAuthorizationPending)
+	})
+
+	t.Run("should return slow_down and increase the interval when polling too fast", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, nil, &configs.Environment{})
+
+		deviceCode := newDeviceCode(entities.DeviceCodeStatusPending)
+		lastPolledAt := time.Now().UTC()
+		deviceCode.LastPolledAt = &lastPolledAt
+		mockRepo.EXPECT().FindByDeviceCodeHash(ctx, hashToken("device-code")).Return(deviceCode, nil)
+		mockRepo.EXPECT().UpdatePolling(ctx, deviceCode.ID.Hex(), mock.AnythingOfType("time.Time"), 10*time.Second).Return(nil)
+
+		// Act
+		result, err := service.ConsumeDeviceCode(ctx, "device-code", "tv-app")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrSlowDown)
+	})
+
+	t.Run("should return access denied when the user refused", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, nil, &configs.Environment{})
+
+		mockRepo.EXPECT().FindByDeviceCodeHash(ctx, hashToken("device-code")).Return(newDeviceCode(entities.DeviceCodeStatusDenied), nil)
+
+		// Act
+		result, err := service.ConsumeDeviceCode(ctx, "device-code", "tv-app")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrDeviceAccessDenied)
+	})
+
+	t.Run("should return expired when the device code has expired", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, nil, &configs.Environment{})
+
+		deviceCode := newDeviceCode(entities.DeviceCodeStatusPending)
+		deviceCode.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+		mockRepo.EXPECT().FindByDeviceCodeHash(ctx, hashToken("device-code")).Return(deviceCode, nil)
+
+		// Act
+		result, err := service.ConsumeDeviceCode(ctx, "device-code", "tv-app")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrDeviceCodeExpired)
+	})
+
+	t.Run("should return error when device code belongs to another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewDeviceCodeRepositoryMock(t)
+		service := NewDeviceAuthorizationService(mockRepo, nil, nil, &configs.Environment{})
+
+		mockRepo.EXPECT().FindByDeviceCodeHash(ctx, hashToken("device-code")).Return(newDeviceCode(entities.DeviceCodeStatusApproved), nil)
+
+		// Act
+		result, err := service.ConsumeDeviceCode(ctx, "device-code", "other-app")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrClientMismatch)
+	})
+}
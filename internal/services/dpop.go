@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/ttlcache"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DPoPService valida as provas de posse DPoP (RFC 9449) e retorna o thumbprint da
+// chave que as assinou, ao qual os tokens emitidos ficam vinculados.
+type DPoPService interface {
+	ValidateProof(ctx context.Context, input models.DPoPProofInput) (string, error)
+}
+
+type dpopService struct {
+	usedJTIs *ttlcache.Set
+	config   *configs.Environment
+}
+
+func NewDPoPService(config *configs.Environment) DPoPService {
+	return &dpopService{
+		usedJTIs: ttlcache.NewSet(),
+		config:   config,
+	}
+}
+
+// ValidateProof segue a seção 4.3 da RFC 9449: typ dpop+jwt, assinatura assimétrica
+// com a chave pública do header jwk, htm e htu da requisição, iat dentro da janela
+// configurada e jti de uso único.
+func (s *dpopService) ValidateProof(ctx context.Context, input models.DPoPProofInput) (string, error) {
+	var jwk signer.JWK
+	var claims models.DPoPProofClaims
+	_, err := jwt.ParseWithClaims(input.Proof, &claims, func(token *jwt.Token) (any, error) {
+		if typ, _ := token.Header["typ"].(string); typ != models.DPoPProofType {
+			return nil, fmt.Errorf("unexpected typ %q", typ)
+		}
+
+		var err error
+		jwk, err = proofJWK(token.Header["jwk"])
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey, err := jwk.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("parse jwk: %w", err)
+		}
+
+		if err := signer.ValidateKey(token.Method.Alg(), publicKey); err != nil {
+			return nil, err
+		}
+
+		return publicKey, nil
+	}, jwt.WithValidMethods(signer.SupportedAlgorithms))
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", domain.ErrInvalidDPoPProof, err)
+	}
+
+	if claims.ID == "" {
+		return "", fmt.Errorf("%w: missing jti", domain.ErrInvalidDPoPProof)
+	}
+
+	if claims.HTM != input.Method {
+		return "", fmt.Errorf("%w: htm does not match %s", domain.ErrInvalidDPoPProof, input.Method)
+	}
+
+	if !s.matchesRequestURL(claims.HTU, input.Endpoint) {
+		return "", fmt.Errorf("%w: htu does not match the request", domain.ErrInvalidDPoPProof)
+	}
+
+	lifetime := s.config.DPoP.ProofLifetime
+	if claims.IssuedAt == nil || time.Since(claims.IssuedAt.Time).Abs() > lifetime {
+		return "", fmt.Errorf("%w: iat outside the accepted window", domain.ErrInvalidDPoPProof)
+	}
+
+	if input.AccessToken != "" && claims.ATH != accessTokenHash(input.AccessToken) {
+		return "", fmt.Errorf("%w: ath does not match the access token", domain.ErrInvalidDPoPProof)
+	}
+
+	jkt, err := signer.Thumbprint(jwk)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", domain.ErrInvalidDPoPProof, err)
+	}
+
+	// A prova é aceita até lifetime depois do iat, que pode estar até lifetime no futuro.
+	if !s.usedJTIs.Add(jkt+":"+claims.ID, 2*lifetime) {
+		return "", fmt.Errorf("%w: jti already used", domain.ErrInvalidDPoPProof)
+	}
+
+	return jkt, nil
+}
+
+// matchesRequestURL compara o htu sem query e fragmento (RFC 9449, seção 4.3).
+func (s *dpopService) matchesRequestURL(htu, endpoint string) bool {
+	parsed, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+
+	parsed.RawQuery, parsed.Fragment = "", ""
+
+	return parsed.String() == Issuer(s.config)+endpoint
+}
+
+// proofJWK lê a chave pública do header jwk, recusando chaves com membros privados.
+func proofJWK(header any) (signer.JWK, error) {
+	raw, err := json.Marshal(header)
+	if err != nil || header == nil {
+		return signer.JWK{}, errors.New("missing jwk header")
+	}
+
+	var members map[string]any
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return signer.JWK{}, errors.New("invalid jwk header")
+	}
+
+	if _, ok := members["d"]; ok {
+		return signer.JWK{}, errors.New("jwk header contains a private key")
+	}
+
+	var jwk signer.JWK
+	if err := json.Unmarshal(raw, &jwk); err != nil {
+		return signer.JWK{}, errors.New("invalid jwk header")
+	}
+
+	return jwk, nil
+}
+
+// accessTokenHash calcula o ath: SHA-256 do access token em base64url.
+func accessTokenHash(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
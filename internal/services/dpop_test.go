@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDPoPProof(t *testing.T) {
+	config := &configs.Environment{
+		URLs: configs.URLs{APIBaseURL: "https://id.example.com"},
+		DPoP: configs.DPoP{ProofLifetime: time.Minute},
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwk, err := signer.NewJWK(&privateKey.PublicKey, signer.ES256)
+	require.NoError(t, err)
+
+	newProof := func(t *testing.T, claims models.DPoPProofClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["typ"] = models.DPoPProofType
+		token.Header["jwk"] = signer.JWK{Kty: jwk.Kty, Crv: jwk.Crv, X: jwk.X, Y: jwk.Y}
+
+		proof, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		return proof
+	}
+
+	newClaims := func(jti string) models.DPoPProofClaims {
+		return models.DPoPProofClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:       jti,
+				IssuedAt: jwt.NewNumericDate(time.Now()),
+			},
+			HTM: "POST",
+			HTU: "https://id.example.com/api/v1/oauth/token",
+		}
+	}
+
+	tokenRequest := func(proof string) models.DPoPProofInput {
+		return models.DPoPProofInput{Proof: proof, Method: "POST", Endpoint: "/api/v1/oauth/token"}
+	}
+
+	t.Run("should return the key thumbprint for a valid proof", func(t *testing.T) {
+		// Arrange
+		service := NewDPoPService(config)
+
+		// Act
+		jkt, err := service.ValidateProof(context.Background(), tokenRequest(newProof(t, newClaims("proof-1"))))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, jwk.Kid, jkt)
+	})
+
+	t.Run("should reject a proof whose jti was already used", func(t *testing.T) {
+		// Arrange
+		service := NewDPoPService(config)
+		proof := newProof(t, newClaims("proof-2"))
+		_, err := service.ValidateProof(context.Background(), tokenRequest(proof))
+		require.NoError(t, err)
+
+		// Act
+		jkt, err := service.ValidateProof(context.Background(), tokenRequest(proof))
+
+		// Assert
+		require.Error(t, err)
+		assert.Empty(t, jkt)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+		assert.ErrorContains(t, err, "jti already used")
+	})
+
+	t.Run("should accept an htu with query string", func(t *testing.T) {
+		// Arrange
+		service := NewDPoPService(config)
+		claims := newClaims("proof-3")
+		claims.HTU += "?foo=bar"
+
+		// Act
+		_, err := service.ValidateProof(context.Background(), tokenRequest(newProof(t, claims)))
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	testCases := []struct {
+		name   string
+		modify func(claims *models.DPoPProofClaims)
+	}{
+		{"should reject a proof for another method", func(claims *models.DPoPProofClaims) { claims.HTM = "GET" }},
+		{"should reject a proof for another url", func(claims *models.DPoPProofClaims) {
+			claims.HTU = "https://id.example.com/api/v1/oauth/revoke"
+		}},
+		{"should reject a proof issued outside the accepted window", func(claims *models.DPoPProofClaims) {
+			claims.IssuedAt = jwt.NewNumericDate(time.Now().Add(-2 * time.Minute))
+		}},
+		{"should reject a proof without jti", func(claims *models.DPoPProofClaims) { claims.ID = "" }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			service := NewDPoPService(config)
+			claims := newClaims("proof-" + tc.name)
+			tc.modify(&claims)
+
+			// Act
+			_, err := service.ValidateProof(context.Background(), tokenRequest(newProof(t, claims)))
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+		})
+	}
+
+	t.Run("should require ath matching the access token", func(t *testing.T) {
+		// Arrange
+		service := NewDPoPService(config)
+		claims := newClaims("proof-4")
+		claims.ATH = accessTokenHash("other-token")
+
+		input := tokenRequest(newProof(t, claims))
+		input.AccessToken = "access-token"
+
+		// Act
+		_, err := service.ValidateProof(context.Background(), input)
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+		assert.ErrorContains(t, err, "ath")
+	})
+
+	t.Run("should reject a proof without the dpop+jwt typ", func(t *testing.T) {
+		// Arrange
+		service := NewDPoPService(config)
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, newClaims("proof-5"))
+		token.Header["jwk"] = jwk
+		proof, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		// Act
+		_, err = service.ValidateProof(context.Background(), tokenRequest(proof))
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+
+	t.Run("should reject a jwk header carrying a private key", func(t *testing.T) {
+		// Arrange
+		service := NewDPoPService(config)
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, newClaims("proof-6"))
+		token.Header["typ"] = models.DPoPProofType
+		token.Header["jwk"] = map[string]string{"kty": jwk.Kty, "crv": jwk.Crv, "x": jwk.X, "y": jwk.Y, "d": "private"}
+		proof, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		// Act
+		_, err = service.ValidateProof(context.Background(), tokenRequest(proof))
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+		assert.ErrorContains(t, err, "private key")
+	})
+}
@@ -2,40 +2,76 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
-	"github.com/aetheris-lab/aetheris-id/api/pkg/ecdsa"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
 	"github.com/golang-jwt/jwt/v5"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type JWTService interface {
 	GenerateOTPTokenJWT(ctx context.Context, jti string, expiresAt time.Time) (string, error)
-	GenerateAccessTokenJWT(ctx context.Context, userID string, expiresAt time.Time) (string, error)
-	GenerateIDTokenJWT(ctx context.Context, userID, name, email string, expiresAt time.Duration) (string, error)
+	GenerateSessionTokenJWT(ctx context.Context, userID string, expiresAt time.Time) (string, error)
+	GenerateAccessTokenJWT(ctx context.Context, input models.AccessTokenInput) (string, error)
+	GenerateIDTokenJWT(ctx context.Context, input models.IDTokenInput) (string, error)
+	GenerateUserInfoJWT(ctx context.Context, algorithm string, claims models.UserInfoClaims) (string, error)
 	ValidateOTPTokenJWT(ctx context.Context, token string) (models.OTPTokenClaims, error)
+	ValidateSessionTokenJWT(ctx context.Context, token string) (models.AccessTokenClaims, error)
 	ValidateAccessTokenJWT(ctx context.Context, token string) (models.AccessTokenClaims, error)
+	ValidateIDTokenHint(ctx context.Context, token string) (models.IDTokenClaims, error)
+	GetJWKS(ctx context.Context) (*models.JWKSResponse, error)
+}
+
+// defaultAccessTokenAudience é a audiência dos access tokens emitidos sem uma audiência específica.
+const defaultAccessTokenAudience = "https://app.aetheris-lab.com"
+
+// sessionTokenAudience é a audiência do token de sessão, que só este servidor aceita.
+const sessionTokenAudience = "aetheris-id"
+
+// defaultSigningAlgorithm assina os access tokens, os tokens de OTP e os ID tokens
+// dos clientes que não escolheram um algoritmo.
+const defaultSigningAlgorithm = signer.ES256
+
+var signingMethods = map[string]jwt.SigningMethod{
+	signer.ES256: jwt.SigningMethodES256,
+	signer.RS256: jwt.SigningMethodRS256,
+	signer.PS256: jwt.SigningMethodPS256,
+	signer.EdDSA: jwt.SigningMethodEdDSA,
 }
 
 type jwtService struct {
-	ecdsa ecdsa.EcdsaKeyPair
+	keyService KeyService
+	config     *configs.Environment
 }
 
-func NewJWTService(ecdsa ecdsa.EcdsaKeyPair) JWTService {
+func NewJWTService(keyService KeyService, config *configs.Environment) JWTService {
 	return &jwtService{
-		ecdsa: ecdsa,
+		keyService: keyService,
+		config:     config,
 	}
 }
 
-func (s *jwtService) GenerateOTPTokenJWT(ctx context.Context, jti string, expiresAt time.Time) (string, error) {
-	privateKey, err := s.ecdsa.ParseECDSAPrivateKey()
-	if err != nil {
-		return "", fmt.Errorf("parse ecdsa private key: %w", err)
-	}
+// SigningAlgorithms lista os algoritmos JWS usados na assinatura dos tokens.
+func SigningAlgorithms() []string {
+	return signer.SupportedAlgorithms
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, models.OTPTokenClaims{
+// Issuer retorna o identificador do emissor (claim iss), derivado da URL base da API.
+func Issuer(config *configs.Environment) string {
+	return strings.TrimSuffix(config.URLs.APIBaseURL, "/")
+}
+
+func (s *jwtService) GenerateOTPTokenJWT(ctx context.Context, jti string, expiresAt time.Time) (string, error) {
+	return s.sign(ctx, defaultSigningAlgorithm, models.OTPTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    "aetheris-id",
 			ID:        jti,
@@ -45,99 +81,234 @@ func (s *jwtService) GenerateOTPTokenJWT(ctx context.Context, jti string, expire
 			Audience:  jwt.ClaimStrings{"aetheris-id"},
 		},
 	})
-
-	tokenString, err := token.SignedString(privateKey)
-	if err != nil {
-		return "", fmt.Errorf("sign token: %w", err)
-	}
-
-	return tokenString, nil
 }
 
-func (s *jwtService) GenerateAccessTokenJWT(ctx context.Context, userID string, expiresAt time.Time) (string, error) {
-	privateKey, err := s.ecdsa.ParseECDSAPrivateKey()
-	if err != nil {
-		return "", fmt.Errorf("parse ecdsa private key: %w", err)
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, models.AccessTokenClaims{
+// GenerateSessionTokenJWT emite o token de sessão do usuário logado, separado dos
+// access tokens por typ e audiência para que tokens de clientes não abram sessão.
+func (s *jwtService) GenerateSessionTokenJWT(ctx context.Context, userID string, expiresAt time.Time) (string, error) {
+	return s.sign(ctx, defaultSigningAlgorithm, models.AccessTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    "https://id.aetheris-lab.com",
+			Issuer:    Issuer(s.config),
 			ID:        primitive.NewObjectID().Hex(),
 			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
 			NotBefore: jwt.NewNumericDate(time.Now().UTC()),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
-			Audience:  jwt.ClaimStrings{"https://app.aetheris-lab.com"},
+			Audience:  jwt.ClaimStrings{sessionTokenAudience},
 			Subject:   userID,
 		},
-		TokenType: "Bearer",
+		TokenType: models.SessionTokenType,
 	})
+}
 
-	tokenString, err := token.SignedString(privateKey)
-	if err != nil {
-		return "", fmt.Errorf("sign token: %w", err)
+func (s *jwtService) GenerateAccessTokenJWT(ctx context.Context, input models.AccessTokenInput) (string, error) {
+	jti := input.ID
+	if jti == "" {
+		jti = primitive.NewObjectID().Hex()
 	}
 
-	return tokenString, nil
+	audience := jwt.ClaimStrings{defaultAccessTokenAudience}
+	if len(input.Audience) > 0 {
+		audience = input.Audience
+	}
+
+	var confirmation *models.ConfirmationClaim
+	if input.DPoPJKT != "" || input.CertificateThumbprint != "" {
+		confirmation = &models.ConfirmationClaim{JKT: input.DPoPJKT, X5TS256: input.CertificateThumbprint}
+	}
+
+	return s.sign(ctx, defaultSigningAlgorithm, models.AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer(s.config),
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			NotBefore: jwt.NewNumericDate(time.Now().UTC()),
+			ExpiresAt: jwt.NewNumericDate(input.ExpiresAt),
+			Audience:  audience,
+			Subject:   input.UserID,
+		},
+		TokenType:   accessTokenType(input.DPoPJKT),
+		ClientID:    input.ClientID,
+		Scope:       scopes.JoinScopes(input.Scopes),
+		Act:         input.Actor,
+		Cnf:         confirmation,
+		SubjectType: input.SubjectType,
+	})
 }
 
-func (s *jwtService) GenerateIDTokenJWT(ctx context.Context, userID, name, email string, expiresAt time.Duration) (string, error) {
-	privateKey, err := s.ecdsa.ParseECDSAPrivateKey()
-	if err != nil {
-		return "", fmt.Errorf("parse ecdsa private key: %w", err)
+func (s *jwtService) GenerateIDTokenJWT(ctx context.Context, input models.IDTokenInput) (string, error) {
+	var authTime *jwt.NumericDate
+	if !input.AuthTime.IsZero() {
+		authTime = jwt.NewNumericDate(input.AuthTime)
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, models.IDTokenClaims{
+	return s.sign(ctx, input.Algorithm, models.IDTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    "https://id.aetheris-lab.com",
+			Issuer:    Issuer(s.config),
 			ID:        primitive.NewObjectID().Hex(),
 			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
 			NotBefore: jwt.NewNumericDate(time.Now().UTC()),
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresAt)),
-			Audience:  jwt.ClaimStrings{"https://app.aetheris-lab.com"},
-			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(input.ExpiresIn)),
+			Audience:  jwt.ClaimStrings{input.ClientID},
+			Subject:   input.UserID,
 		},
-		TokenType: "Bearer",
+		TokenType:       "Bearer",
+		AuthorizedParty: input.ClientID,
+		Nonce:           input.Nonce,
+		AuthTime:        authTime,
+		AccessTokenHash: tokenHash(input.Algorithm, input.AccessToken),
+		CodeHash:        tokenHash(input.Algorithm, input.Code),
+		Name:            input.Name,
+		Email:           input.Email,
 	})
+}
 
-	tokenString, err := token.SignedString(privateKey)
-	if err != nil {
-		return "", fmt.Errorf("sign token: %w", err)
+// tokenHash calcula at_hash e c_hash: a metade esquerda do hash do valor, usando o
+// hash do algoritmo do ID token (OIDC Core, seção 3.3.2.11). Ed25519 usa SHA-512.
+func tokenHash(algorithm, value string) string {
+	if value == "" {
+		return ""
 	}
 
-	return tokenString, nil
+	var sum []byte
+	if algorithm == signer.EdDSA {
+		digest := sha512.Sum512([]byte(value))
+		sum = digest[:]
+	} else {
+		digest := sha256.Sum256([]byte(value))
+		sum = digest[:]
+	}
+
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2])
+}
+
+// GenerateUserInfoJWT assina a resposta do UserInfo; sub e aud vêm preenchidos pelo chamador.
+func (s *jwtService) GenerateUserInfoJWT(ctx context.Context, algorithm string, claims models.UserInfoClaims) (string, error) {
+	claims.Issuer = Issuer(s.config)
+	claims.IssuedAt = jwt.NewNumericDate(time.Now().UTC())
+
+	return s.sign(ctx, algorithm, claims)
 }
 
 func (s *jwtService) ValidateOTPTokenJWT(ctx context.Context, token string) (models.OTPTokenClaims, error) {
-	publicKey, err := s.ecdsa.ParseECDSAPublicKey()
-	if err != nil {
-		return models.OTPTokenClaims{}, fmt.Errorf("parse ecdsa public key: %w", err)
+	claims := models.OTPTokenClaims{}
+	if err := s.parse(ctx, token, &claims); err != nil {
+		return models.OTPTokenClaims{}, err
 	}
 
-	claims := models.OTPTokenClaims{}
-	_, err = jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
-		return publicKey, nil
-	})
-	if err != nil {
-		return models.OTPTokenClaims{}, fmt.Errorf("parse token: %w", err)
+	return claims, nil
+}
+
+// ValidateSessionTokenJWT aceita apenas tokens de sessão; access tokens, ID tokens e
+// tokens de OTP assinados pelas mesmas chaves são recusados.
+func (s *jwtService) ValidateSessionTokenJWT(ctx context.Context, token string) (models.AccessTokenClaims, error) {
+	claims := models.AccessTokenClaims{}
+	if err := s.parse(ctx, token, &claims, jwt.WithAudience(sessionTokenAudience)); err != nil {
+		return models.AccessTokenClaims{}, err
+	}
+
+	if claims.TokenType != models.SessionTokenType || claims.ClientID != "" {
+		return models.AccessTokenClaims{}, fmt.Errorf("parse token: %w", domain.ErrInvalidSessionToken)
 	}
 
 	return claims, nil
 }
 
+// ValidateAccessTokenJWT recusa o token de sessão, que não pode ser usado como bearer.
 func (s *jwtService) ValidateAccessTokenJWT(ctx context.Context, token string) (models.AccessTokenClaims, error) {
-	publicKey, err := s.ecdsa.ParseECDSAPublicKey()
+	claims := models.AccessTokenClaims{}
+	if err := s.parse(ctx, token, &claims); err != nil {
+		return models.AccessTokenClaims{}, err
+	}
+
+	if claims.TokenType == models.SessionTokenType {
+		return models.AccessTokenClaims{}, fmt.Errorf("parse token: session tokens are not access tokens")
+	}
+
+	return claims, nil
+}
+
+// ValidateIDTokenHint verifica um ID token emitido por este servidor aceitando-o mesmo
+// expirado, como permite o id_token_hint (OIDC Core, seção 3.1.2.1).
+func (s *jwtService) ValidateIDTokenHint(ctx context.Context, token string) (models.IDTokenClaims, error) {
+	claims := models.IDTokenClaims{}
+	if err := s.parse(ctx, token, &claims, jwt.WithoutClaimsValidation()); err != nil {
+		return models.IDTokenClaims{}, err
+	}
+
+	// Access tokens compartilham as chaves de assinatura, mas não têm azp.
+	if claims.Issuer != Issuer(s.config) || claims.AuthorizedParty == "" {
+		return models.IDTokenClaims{}, fmt.Errorf("parse token: not an id token issued by %s", Issuer(s.config))
+	}
+
+	return claims, nil
+}
+
+func (s *jwtService) GetJWKS(ctx context.Context) (*models.JWKSResponse, error) {
+	verificationKeys := s.keyService.GetVerificationKeys(ctx)
+
+	keys := make([]signer.JWK, 0, len(verificationKeys))
+	for _, key := range verificationKeys {
+		jwk, err := signer.NewJWK(key.PublicKey, key.Algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("new jwk %s: %w", key.KID, err)
+		}
+		jwk.Kid = key.KID
+
+		keys = append(keys, jwk)
+	}
+
+	return &models.JWKSResponse{
+		Keys: keys,
+	}, nil
+}
+
+// sign assina as claims com a chave ativa do algoritmo e a identifica no header
+// kid, permitindo que os resource servers a localizem no JWKS.
+func (s *jwtService) sign(ctx context.Context, algorithm string, claims jwt.Claims) (string, error) {
+	signingMethod, ok := signingMethods[algorithm]
+	if !ok {
+		return "", fmt.Errorf("sign token: unsupported algorithm %s", algorithm)
+	}
+
+	key, err := s.keyService.GetActiveSigningKey(ctx, algorithm)
 	if err != nil {
-		return models.AccessTokenClaims{}, fmt.Errorf("parse ecdsa public key: %w", err)
+		return "", fmt.Errorf("get active signing key: %w", err)
 	}
 
-	claims := models.AccessTokenClaims{}
-	_, err = jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
-		return publicKey, nil
-	})
+	token := jwt.NewWithClaims(signingMethod, claims)
+	token.Header["kid"] = key.KID
+
+	tokenString, err := token.SignedString(key.Signer)
 	if err != nil {
-		return models.AccessTokenClaims{}, fmt.Errorf("parse token: %w", err)
+		return "", fmt.Errorf("sign token: %w", err)
 	}
 
-	return claims, nil
+	return tokenString, nil
+}
+
+// parse verifica o token com a chave indicada pelo header kid, aceitando
+// chaves pendentes, ativas e aposentadas que ainda não expiraram.
+func (s *jwtService) parse(ctx context.Context, token string, claims jwt.Claims, options ...jwt.ParserOption) error {
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, domain.ErrSigningKeyNotFound
+		}
+
+		key, err := s.keyService.GetVerificationKey(ctx, kid)
+		if err != nil {
+			return nil, fmt.Errorf("get verification key: %w", err)
+		}
+
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+		}
+
+		return key.PublicKey, nil
+	}, append(options, jwt.WithValidMethods(signer.SupportedAlgorithms))...)
+	if err != nil {
+		return fmt.Errorf("parse token: %w", err)
+	}
+
+	return nil
 }
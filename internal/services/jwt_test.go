@@ -0,0 +1,523 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSigningKey(t testing.TB) *signer.Key {
+	t.Helper()
+
+	return newTestSigningKeyWithAlgorithm(t, signer.ES256)
+}
+
+func newTestSigningKeyWithAlgorithm(t testing.TB, algorithm string) *signer.Key {
+	t.Helper()
+
+	privateKeyPEM, _, err := signer.GenerateKeyPEM(algorithm)
+	require.NoError(t, err)
+
+	privateKey, err := signer.ParsePrivateKeyPEM(privateKeyPEM)
+	require.NoError(t, err)
+
+	jwk, err := signer.NewJWK(privateKey.Public(), algorithm)
+	require.NoError(t, err)
+
+	return &signer.Key{
+		KID:       jwk.Kid,
+		Algorithm: algorithm,
+		Signer:    privateKey,
+		PublicKey: privateKey.Public(),
+	}
+}
+
+func TestGenerateAccessTokenJWT(t *testing.T) {
+	t.Run("should stamp the kid of the active key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		activeKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(activeKey, nil)
+
+		config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+		service := NewJWTService(mockKeyService, config)
+
+		// Act
+		tokenString, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+			UserID:    "user-id",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		token, _, err := jwt.NewParser().ParseUnverified(tokenString, &models.AccessTokenClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, activeKey.KID, token.Header["kid"])
+		assert.Equal(t, "ES256", token.Header["alg"])
+
+		issuer, err := token.Claims.GetIssuer()
+		require.NoError(t, err)
+		assert.Equal(t, "https://id.example.com", issuer)
+	})
+
+	t.Run("should bind the token to the DPoP key thumbprint", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(newTestSigningKey(t), nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+
+		// Act
+		tokenString, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+			UserID:    "user-id",
+			ExpiresAt: time.Now().Add(time.Hour),
+			DPoPJKT:   "key-thumbprint",
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		var claims models.AccessTokenClaims
+		_, _, err = jwt.NewParser().ParseUnverified(tokenString, &claims)
+		require.NoError(t, err)
+		require.NotNil(t, claims.Cnf)
+		assert.Equal(t, "key-thumbprint", claims.Cnf.JKT)
+		assert.Equal(t, models.TokenTypeDPoP, claims.TokenType)
+	})
+
+	t.Run("should bind the token to the client certificate thumbprint", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(newTestSigningKey(t), nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+
+		// Act
+		tokenString, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+			UserID:                "orders",
+			ExpiresAt:             time.Now().Add(time.Hour),
+			CertificateThumbprint: "certificate-thumbprint",
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		claims := jwt.MapClaims{}
+		_, _, err = jwt.NewParser().ParseUnverified(tokenString, claims)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"x5t#S256": "certificate-thumbprint"}, claims["cnf"])
+	})
+}
+
+func TestGenerateIDTokenJWT(t *testing.T) {
+	for _, algorithm := range []string{signer.RS256, signer.PS256, signer.EdDSA} {
+		t.Run("should sign with the client algorithm "+algorithm, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			signingKey := newTestSigningKeyWithAlgorithm(t, algorithm)
+
+			mockKeyService := mocks.NewKeyServiceMock(t)
+			mockKeyService.EXPECT().GetActiveSigningKey(ctx, algorithm).Return(signingKey, nil)
+			mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(signingKey, nil)
+
+			service := NewJWTService(mockKeyService, &configs.Environment{})
+
+			// Act
+			tokenString, err := service.GenerateIDTokenJWT(ctx, models.IDTokenInput{Algorithm: algorithm, UserID: "user-id", ClientID: "web-app", ExpiresIn: time.Hour})
+
+			// Assert
+			require.NoError(t, err)
+
+			claims, err := service.ValidateAccessTokenJWT(ctx, tokenString)
+			require.NoError(t, err)
+			assert.Equal(t, "user-id", claims.Subject)
+
+			token, _, err := jwt.NewParser().ParseUnverified(tokenString, &models.IDTokenClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, token.Header["alg"])
+		})
+	}
+
+	t.Run("should set client audience, azp, nonce, auth_time and token hashes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		authTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+		// Act
+		tokenString, err := service.GenerateIDTokenJWT(ctx, models.IDTokenInput{
+			Algorithm:   signer.ES256,
+			UserID:      "user-id",
+			ClientID:    "web-app",
+			Name:        "Ada Lovelace",
+			Nonce:       "n-0S6_WzA2Mj",
+			AuthTime:    authTime,
+			AccessToken: "jHkWEdUXMU1BwAsC4vtUsZwnNvTIxEl0z9K3vx5KF0Y",
+			Code:        "Qcb0Orv1zh30vL1MPRsbm-diHiMwcLyZvn1arpZv-Jxf_11jnpEX3Tgfvk",
+			ExpiresIn:   time.Hour,
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		var claims models.IDTokenClaims
+		_, _, err = jwt.NewParser().ParseUnverified(tokenString, &claims)
+		require.NoError(t, err)
+		assert.Equal(t, jwt.ClaimStrings{"web-app"}, claims.Audience)
+		assert.Equal(t, "web-app", claims.AuthorizedParty)
+		assert.Equal(t, "n-0S6_WzA2Mj", claims.Nonce)
+		assert.Equal(t, authTime.Unix(), claims.AuthTime.Unix())
+		assert.Equal(t, "Ada Lovelace", claims.Name)
+		assert.Empty(t, claims.Email)
+		// Valores de exemplo da seção A.4 do OIDC Core.
+		assert.Equal(t, "77QmUPtjPfzWtF2AnpK9RQ", claims.AccessTokenHash)
+		assert.Equal(t, "LDktKdoQak3Pk0cnXxCltA", claims.CodeHash)
+	})
+
+	t.Run("should return error for unsupported algorithm", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewJWTService(mocks.NewKeyServiceMock(t), &configs.Environment{})
+
+		// Act
+		_, err := service.GenerateIDTokenJWT(ctx, models.IDTokenInput{Algorithm: "HS256", UserID: "user-id", ExpiresIn: time.Hour})
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported algorithm")
+	})
+}
+
+func TestValidateAccessTokenJWT(t *testing.T) {
+	t.Run("should verify token signed by a key that has since been retired", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+			UserID:    "user-id",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		retiredKey := *signingKey
+		retiredKey.Signer = nil
+		retiredKey.ExpiresAt = time.Now().Add(time.Hour)
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(&retiredKey, nil)
+
+		// Act
+		claims, err := service.ValidateAccessTokenJWT(ctx, tokenString)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "user-id", claims.Subject)
+	})
+
+	t.Run("should reject token signed by an unknown key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+			UserID:    "user-id",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(nil, domain.ErrSigningKeyNotFound)
+
+		// Act
+		_, err = service.ValidateAccessTokenJWT(ctx, tokenString)
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrSigningKeyNotFound)
+	})
+
+	t.Run("should reject a session token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateSessionTokenJWT(ctx, "user-id", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		// Act
+		_, err = service.ValidateAccessTokenJWT(ctx, tokenString)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "session tokens are not access tokens")
+	})
+
+	t.Run("should reject token whose alg does not match the key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKeyWithAlgorithm(t, signer.RS256)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.RS256).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateIDTokenJWT(ctx, models.IDTokenInput{Algorithm: signer.RS256, UserID: "user-id", ExpiresIn: time.Hour})
+		require.NoError(t, err)
+
+		psKey := *signingKey
+		psKey.Algorithm = signer.PS256
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(&psKey, nil)
+
+		// Act
+		_, err = service.ValidateAccessTokenJWT(ctx, tokenString)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected signing method")
+	})
+}
+
+func TestValidateSessionTokenJWT(t *testing.T) {
+	t.Run("should accept a session token issued at login", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateSessionTokenJWT(ctx, "user-id", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		// Act
+		claims, err := service.ValidateSessionTokenJWT(ctx, tokenString)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "user-id", claims.Subject)
+		assert.Equal(t, models.SessionTokenType, claims.TokenType)
+		assert.Empty(t, claims.ClientID)
+	})
+
+	t.Run("should reject an access token issued to a client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+			UserID:    "user-id",
+			ClientID:  "web-app",
+			Audience:  []string{"aetheris-id"},
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		// Act
+		_, err = service.ValidateSessionTokenJWT(ctx, tokenString)
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrInvalidSessionToken)
+	})
+
+	t.Run("should reject an id token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateIDTokenJWT(ctx, models.IDTokenInput{
+			Algorithm: signer.ES256,
+			UserID:    "user-id",
+			ClientID:  "web-app",
+			ExpiresIn: time.Hour,
+		})
+		require.NoError(t, err)
+
+		// Act
+		_, err = service.ValidateSessionTokenJWT(ctx, tokenString)
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, jwt.ErrTokenInvalidAudience)
+	})
+}
+
+func TestValidateIDTokenHint(t *testing.T) {
+	t.Run("should accept an expired id token issued by the server", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateIDTokenJWT(ctx, models.IDTokenInput{
+			Algorithm: signer.ES256,
+			UserID:    "user-id",
+			ClientID:  "web-app",
+			ExpiresIn: -time.Hour,
+		})
+		require.NoError(t, err)
+
+		// Act
+		claims, err := service.ValidateIDTokenHint(ctx, tokenString)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "user-id", claims.Subject)
+		assert.Equal(t, jwt.ClaimStrings{"web-app"}, claims.Audience)
+	})
+
+	t.Run("should reject an access token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetActiveSigningKey(ctx, signer.ES256).Return(signingKey, nil)
+		mockKeyService.EXPECT().GetVerificationKey(ctx, signingKey.KID).Return(signingKey, nil)
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+		tokenString, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+			UserID:    "user-id",
+			ClientID:  "web-app",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		// Act
+		_, err = service.ValidateIDTokenHint(ctx, tokenString)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not an id token")
+	})
+}
+
+func TestGetJWKS(t *testing.T) {
+	t.Run("should publish every verification key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		pendingKey := newTestSigningKey(t)
+		activeKey := newTestSigningKey(t)
+
+		mockKeyService := mocks.NewKeyServiceMock(t)
+		mockKeyService.EXPECT().GetVerificationKeys(ctx).Return([]*signer.Key{pendingKey, activeKey})
+
+		service := NewJWTService(mockKeyService, &configs.Environment{})
+
+		// Act
+		jwks, err := service.GetJWKS(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, jwks.Keys, 2)
+		assert.Equal(t, pendingKey.KID, jwks.Keys[0].Kid)
+		assert.Equal(t, activeKey.KID, jwks.Keys[1].Kid)
+	})
+}
+
+func newBenchmarkJWTService(b *testing.B) (JWTService, string) {
+	b.Helper()
+
+	ctx := context.Background()
+	privateKeyPEM, publicKeyPEM, err := signer.GenerateKeyPEM(signer.ES256)
+	require.NoError(b, err)
+
+	mockRepo := mocks.NewSigningKeyRepositoryMock(b)
+	mockRepo.EXPECT().FindVerifiable(ctx).Return([]*entities.SigningKey{{
+		KID:        "benchmark",
+		Algorithm:  "ES256",
+		PrivateKey: privateKeyPEM,
+		PublicKey:  publicKeyPEM,
+		Status:     entities.SigningKeyStatusActive,
+	}}, nil)
+
+	keyService := NewKeyService(mockRepo, nil, &configs.Environment{})
+	require.NoError(b, keyService.ReloadSigningKeys(ctx))
+
+	service := NewJWTService(keyService, &configs.Environment{})
+	token, err := service.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+		UserID:    "user-id",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(b, err)
+
+	return service, token
+}
+
+func BenchmarkGenerateAccessTokenJWT(b *testing.B) {
+	ctx := context.Background()
+	service, _ := newBenchmarkJWTService(b)
+	input := models.AccessTokenInput{UserID: "user-id", ExpiresAt: time.Now().Add(time.Hour)}
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := service.GenerateAccessTokenJWT(ctx, input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkValidateAccessTokenJWT(b *testing.B) {
+	ctx := context.Background()
+	service, token := newBenchmarkJWTService(b)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := service.ValidateAccessTokenJWT(ctx, token); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/ecdsa"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+)
+
+type KeyService interface {
+	EnsureSigningKeys(ctx context.Context) error
+	RotateSigningKeys(ctx context.Context, algorithm string) (*entities.SigningKey, error)
+	ReloadSigningKeys(ctx context.Context) error
+	WatchSigningKeys(ctx context.Context)
+	GetActiveSigningKey(ctx context.Context, algorithm string) (*signer.Key, error)
+	GetVerificationKey(ctx context.Context, kid string) (*signer.Key, error)
+	GetVerificationKeys(ctx context.Context) []*signer.Key
+	ListVerificationKeys(ctx context.Context) ([]*entities.SigningKey, error)
+}
+
+type keyService struct {
+	signingKeyRepo repositories.SigningKeyRepository
+	ecdsaKeyPair   ecdsa.EcdsaKeyPair
+	keySet         *signer.KeySet
+	config         *configs.Environment
+}
+
+func NewKeyService(
+	signingKeyRepo repositories.SigningKeyRepository,
+	ecdsaKeyPair ecdsa.EcdsaKeyPair,
+	config *configs.Environment,
+) KeyService {
+	return &keyService{
+		signingKeyRepo: signingKeyRepo,
+		ecdsaKeyPair:   ecdsaKeyPair,
+		keySet:         signer.NewKeySet(),
+		config:         config,
+	}
+}
+
+// EnsureSigningKeys garante que existe uma chave ativa e uma pendente para cada
+// algoritmo suportado. Na primeira execução a chave configurada em configs.Key
+// vira a chave ES256 ativa, preservando a validade dos tokens já emitidos com ela.
+func (s *keyService) EnsureSigningKeys(ctx context.Context) error {
+	if err := s.ensureSigningKeys(ctx); err != nil {
+		return err
+	}
+
+	return s.ReloadSigningKeys(ctx)
+}
+
+func (s *keyService) ensureSigningKeys(ctx context.Context) error {
+	for _, algorithm := range signer.SupportedAlgorithms {
+		if err := s.ensureAlgorithmSigningKeys(ctx, algorithm); err != nil {
+			return fmt.Errorf("ensure %s signing keys: %w", algorithm, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *keyService) ensureAlgorithmSigningKeys(ctx context.Context, algorithm string) error {
+	if _, err := s.signingKeyRepo.FindByStatus(ctx, algorithm, entities.SigningKeyStatusActive); err != nil {
+		if !errors.Is(err, domain.ErrSigningKeyNotFound) {
+			return fmt.Errorf("find active signing key: %w", err)
+		}
+
+		if err := s.seedActiveSigningKey(ctx, algorithm); err != nil {
+			return fmt.Errorf("seed active signing key: %w", err)
+		}
+	}
+
+	if _, err := s.signingKeyRepo.FindByStatus(ctx, algorithm, entities.SigningKeyStatusPending); err != nil {
+		if !errors.Is(err, domain.ErrSigningKeyNotFound) {
+			return fmt.Errorf("find pending signing key: %w", err)
+		}
+
+		if _, err := s.createSigningKey(ctx, algorithm, entities.SigningKeyStatusPending); err != nil {
+			return fmt.Errorf("create pending signing key: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RotateSigningKeys promove a chave pendente do algoritmo a ativa, aposenta a chave
+// ativa atual e cria uma nova chave pendente para a próxima rotação.
+func (s *keyService) RotateSigningKeys(ctx context.Context, algorithm string) (*entities.SigningKey, error) {
+	if !signer.IsSupportedAlgorithm(algorithm) {
+		return nil, fmt.Errorf("rotate signing keys: unsupported algorithm %s", algorithm)
+	}
+
+	if err := s.ensureAlgorithmSigningKeys(ctx, algorithm); err != nil {
+		return nil, fmt.Errorf("ensure signing keys: %w", err)
+	}
+
+	currentKey, err := s.signingKeyRepo.FindByStatus(ctx, algorithm, entities.SigningKeyStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("find active signing key: %w", err)
+	}
+
+	nextKey, err := s.signingKeyRepo.FindByStatus(ctx, algorithm, entities.SigningKeyStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("find pending signing key: %w", err)
+	}
+
+	if err := s.signingKeyRepo.Activate(ctx, nextKey.KID); err != nil {
+		return nil, fmt.Errorf("activate signing key: %w", err)
+	}
+
+	// Instâncias que ainda não recarregaram o cache continuam assinando com a chave
+	// aposentada por até um intervalo de recarga depois da rotação.
+	verifyUntil := time.Now().UTC().Add(s.maxTokenLifetime() + s.config.Security.SigningKeyReloadInterval)
+	if err := s.signingKeyRepo.Retire(ctx, currentKey.KID, verifyUntil); err != nil {
+		return nil, fmt.Errorf("retire signing key: %w", err)
+	}
+
+	if _, err := s.createSigningKey(ctx, algorithm, entities.SigningKeyStatusPending); err != nil {
+		return nil, fmt.Errorf("create pending signing key: %w", err)
+	}
+
+	if err := s.ReloadSigningKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	nextKey.Status = entities.SigningKeyStatusActive
+	return nextKey, nil
+}
+
+// ReloadSigningKeys recarrega do banco as chaves em cache. Se alguma chave
+// estiver malformada o cache atual é mantido.
+func (s *keyService) ReloadSigningKeys(ctx context.Context) error {
+	signingKeys, err := s.signingKeyRepo.FindVerifiable(ctx)
+	if err != nil {
+		return fmt.Errorf("find verifiable signing keys: %w", err)
+	}
+
+	materials := make([]signer.KeyMaterial, len(signingKeys))
+	for i, signingKey := range signingKeys {
+		materials[i] = signer.KeyMaterial{
+			KID:           signingKey.KID,
+			Algorithm:     signingKey.Algorithm,
+			PrivateKeyPEM: signingKey.PrivateKey,
+			PublicKeyPEM:  signingKey.PublicKey,
+			Signing:       signingKey.IsActive(),
+		}
+
+		if signingKey.VerifyUntil != nil {
+			materials[i].ExpiresAt = *signingKey.VerifyUntil
+		}
+	}
+
+	if err := s.keySet.Reload(materials); err != nil {
+		return fmt.Errorf("reload key set: %w", err)
+	}
+
+	return nil
+}
+
+// WatchSigningKeys recarrega o cache periodicamente até o contexto ser cancelado,
+// para que instâncias em execução percebam rotações feitas por outro processo.
+func (s *keyService) WatchSigningKeys(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Security.SigningKeyReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ReloadSigningKeys(ctx); err != nil {
+				slog.Error("failed to reload signing keys", "error", err)
+			}
+		}
+	}
+}
+
+func (s *keyService) GetActiveSigningKey(ctx context.Context, algorithm string) (*signer.Key, error) {
+	key, ok := s.keySet.SigningKey(algorithm)
+	if !ok {
+		return nil, fmt.Errorf("active %s signing key not loaded: %w", algorithm, domain.ErrSigningKeyNotFound)
+	}
+
+	return key, nil
+}
+
+func (s *keyService) GetVerificationKey(ctx context.Context, kid string) (*signer.Key, error) {
+	key, ok := s.keySet.VerificationKey(kid)
+	if !ok {
+		return nil, fmt.Errorf("verification key %s: %w", kid, domain.ErrSigningKeyNotFound)
+	}
+
+	return key, nil
+}
+
+func (s *keyService) GetVerificationKeys(ctx context.Context) []*signer.Key {
+	return s.keySet.VerificationKeys()
+}
+
+func (s *keyService) ListVerificationKeys(ctx context.Context) ([]*entities.SigningKey, error) {
+	signingKeys, err := s.signingKeyRepo.FindVerifiable(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find verifiable signing keys: %w", err)
+	}
+
+	return signingKeys, nil
+}
+
+// seedActiveSigningKey cria a chave ativa do algoritmo. Apenas ES256 reaproveita a
+// chave configurada; os demais algoritmos sempre geram uma chave nova.
+func (s *keyService) seedActiveSigningKey(ctx context.Context, algorithm string) error {
+	if algorithm != signer.ES256 {
+		_, err := s.createSigningKey(ctx, algorithm, entities.SigningKeyStatusActive)
+		return err
+	}
+
+	jwk, err := signer.NewJWK(s.ecdsaKeyPair.PublicKey(), algorithm)
+	if err != nil {
+		return fmt.Errorf("new jwk: %w", err)
+	}
+
+	_, err = s.signingKeyRepo.FindByKID(ctx, jwk.Kid)
+	if err == nil {
+		// A chave configurada já foi usada e aposentada: gera uma nova em vez de reativá-la.
+		_, err := s.createSigningKey(ctx, algorithm, entities.SigningKeyStatusActive)
+		return err
+	}
+
+	if !errors.Is(err, domain.ErrSigningKeyNotFound) {
+		return fmt.Errorf("find signing key by kid: %w", err)
+	}
+
+	now := time.Now().UTC()
+	signingKey := &entities.SigningKey{
+		KID:         jwk.Kid,
+		Algorithm:   algorithm,
+		PrivateKey:  s.config.Key.PrivateKey,
+		PublicKey:   s.config.Key.PublicKey,
+		Status:      entities.SigningKeyStatusActive,
+		ActivatedAt: &now,
+	}
+
+	if err := s.signingKeyRepo.Create(ctx, signingKey); err != nil {
+		return fmt.Errorf("create signing key: %w", err)
+	}
+
+	return nil
+}
+
+func (s *keyService) createSigningKey(ctx context.Context, algorithm string, status entities.SigningKeyStatus) (*entities.SigningKey, error) {
+	privateKeyPEM, publicKeyPEM, err := signer.GenerateKeyPEM(algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("generate key pem: %w", err)
+	}
+
+	publicKey, err := signer.ParsePublicKeyPEM(publicKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key pem: %w", err)
+	}
+
+	jwk, err := signer.NewJWK(publicKey, algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("new jwk: %w", err)
+	}
+
+	signingKey := &entities.SigningKey{
+		KID:        jwk.Kid,
+		Algorithm:  algorithm,
+		PrivateKey: privateKeyPEM,
+		PublicKey:  publicKeyPEM,
+		Status:     status,
+	}
+
+	if status == entities.SigningKeyStatusActive {
+		now := time.Now().UTC()
+		signingKey.ActivatedAt = &now
+	}
+
+	if err := s.signingKeyRepo.Create(ctx, signingKey); err != nil {
+		return nil, fmt.Errorf("create signing key: %w", err)
+	}
+
+	return signingKey, nil
+}
+
+// maxTokenLifetime é o maior tempo de vida entre os JWTs assinados, usado para
+// manter uma chave aposentada verificando até o último token emitido com ela expirar.
+// Refresh tokens são opacos e não dependem das chaves.
+func (s *keyService) maxTokenLifetime() time.Duration {
+	return max(
+		s.config.Security.AccessTokenExpirationHours,
+		s.config.Security.IDTokenExpirationMinutes,
+		s.config.OTP.JWTExpirationMinutes,
+	)
+}
@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	stdecdsa "crypto/ecdsa"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureSigningKeys(t *testing.T) {
+	t.Run("should seed the configured key as active and create a pending key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		privateKeyPEM, publicKeyPEM, err := signer.GenerateKeyPEM(signer.ES256)
+		require.NoError(t, err)
+		publicKey, err := signer.ParsePublicKeyPEM(publicKeyPEM)
+		require.NoError(t, err)
+		jwk, err := signer.NewJWK(publicKey, signer.ES256)
+		require.NoError(t, err)
+
+		config := &configs.Environment{Key: configs.Key{PrivateKey: privateKeyPEM, PublicKey: publicKeyPEM}}
+
+		mockKeyPair := mocks.NewEcdsaKeyPairMock(t)
+		mockKeyPair.EXPECT().PublicKey().Return(publicKey.(*stdecdsa.PublicKey))
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.ES256, entities.SigningKeyStatusActive).Return(nil, domain.ErrSigningKeyNotFound)
+		mockRepo.EXPECT().FindByKID(ctx, jwk.Kid).Return(nil, domain.ErrSigningKeyNotFound)
+		mockRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(signingKey *entities.SigningKey) bool {
+				return signingKey.IsActive() && signingKey.KID == jwk.Kid && signingKey.PrivateKey == privateKeyPEM
+			})).
+			Return(nil)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.ES256, entities.SigningKeyStatusPending).Return(nil, domain.ErrSigningKeyNotFound)
+		mockRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(signingKey *entities.SigningKey) bool {
+				return signingKey.Algorithm == signer.ES256 && signingKey.Status == entities.SigningKeyStatusPending && signingKey.KID != jwk.Kid
+			})).
+			Return(nil)
+		for _, algorithm := range []string{signer.RS256, signer.PS256, signer.EdDSA} {
+			mockRepo.EXPECT().FindByStatus(ctx, algorithm, entities.SigningKeyStatusActive).Return(&entities.SigningKey{KID: algorithm}, nil)
+			mockRepo.EXPECT().FindByStatus(ctx, algorithm, entities.SigningKeyStatusPending).Return(&entities.SigningKey{KID: algorithm}, nil)
+		}
+		mockRepo.EXPECT().FindVerifiable(ctx).Return([]*entities.SigningKey{{
+			KID:        jwk.Kid,
+			Algorithm:  "ES256",
+			PrivateKey: privateKeyPEM,
+			PublicKey:  publicKeyPEM,
+			Status:     entities.SigningKeyStatusActive,
+		}}, nil)
+
+		service := NewKeyService(mockRepo, mockKeyPair, config)
+
+		// Act
+		err = service.EnsureSigningKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		activeKey, err := service.GetActiveSigningKey(ctx, signer.ES256)
+		require.NoError(t, err)
+		assert.Equal(t, jwk.Kid, activeKey.KID)
+	})
+
+	t.Run("should generate new keys for algorithms other than ES256", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		for _, algorithm := range []string{signer.ES256, signer.RS256, signer.PS256} {
+			mockRepo.EXPECT().FindByStatus(ctx, algorithm, entities.SigningKeyStatusActive).Return(&entities.SigningKey{KID: algorithm}, nil)
+			mockRepo.EXPECT().FindByStatus(ctx, algorithm, entities.SigningKeyStatusPending).Return(&entities.SigningKey{KID: algorithm}, nil)
+		}
+		mockRepo.EXPECT().FindByStatus(ctx, signer.EdDSA, entities.SigningKeyStatusActive).Return(nil, domain.ErrSigningKeyNotFound)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.EdDSA, entities.SigningKeyStatusPending).Return(nil, domain.ErrSigningKeyNotFound)
+		mockRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(signingKey *entities.SigningKey) bool {
+				return signingKey.Algorithm == signer.EdDSA
+			})).
+			Return(nil).Twice()
+		mockRepo.EXPECT().FindVerifiable(ctx).Return(nil, nil)
+
+		service := NewKeyService(mockRepo, nil, &configs.Environment{})
+
+		// Act
+		err := service.EnsureSigningKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should only load keys when active and pending keys exist", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		for _, algorithm := range signer.SupportedAlgorithms {
+			mockRepo.EXPECT().FindByStatus(ctx, algorithm, entities.SigningKeyStatusActive).Return(&entities.SigningKey{KID: "active"}, nil)
+			mockRepo.EXPECT().FindByStatus(ctx, algorithm, entities.SigningKeyStatusPending).Return(&entities.SigningKey{KID: "pending"}, nil)
+		}
+		mockRepo.EXPECT().FindVerifiable(ctx).Return(nil, nil)
+
+		service := NewKeyService(mockRepo, nil, &configs.Environment{})
+
+		// Act
+		err := service.EnsureSigningKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when repository fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.ES256, entities.SigningKeyStatusActive).Return(nil, errors.New("database error"))
+
+		service := NewKeyService(mockRepo, nil, &configs.Environment{})
+
+		// Act
+		err := service.EnsureSigningKeys(ctx)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "find active signing key")
+	})
+}
+
+func TestRotateSigningKeys(t *testing.T) {
+	t.Run("should promote pending key and retire active key until its tokens expire", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{
+			Security: configs.Security{
+				AccessTokenExpirationHours:  time.Hour,
+				RefreshTokenExpirationHours: 24 * time.Hour,
+				SigningKeyReloadInterval:    2 * time.Hour,
+			},
+		}
+		activeKey := &entities.SigningKey{KID: "active", Status: entities.SigningKeyStatusActive}
+		pendingKey := &entities.SigningKey{KID: "pending", Status: entities.SigningKeyStatusPending}
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.ES256, entities.SigningKeyStatusActive).Return(activeKey, nil)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.ES256, entities.SigningKeyStatusPending).Return(pendingKey, nil)
+		mockRepo.EXPECT().Activate(ctx, "pending").Return(nil)
+		mockRepo.EXPECT().
+			Retire(ctx, "active", mock.MatchedBy(func(verifyUntil time.Time) bool {
+				return verifyUntil.After(time.Now().Add(2*time.Hour)) && verifyUntil.Before(time.Now().Add(4*time.Hour))
+			})).
+			Return(nil)
+		mockRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(signingKey *entities.SigningKey) bool {
+				return signingKey.Algorithm == signer.ES256 && signingKey.Status == entities.SigningKeyStatusPending
+			})).
+			Return(nil)
+		mockRepo.EXPECT().FindVerifiable(ctx).Return(nil, nil)
+
+		service := NewKeyService(mockRepo, nil, config)
+
+		// Act
+		result, err := service.RotateSigningKeys(ctx, signer.ES256)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "pending", result.KID)
+		assert.True(t, result.IsActive())
+	})
+
+	t.Run("should return error when pending key cannot be activated", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.ES256, entities.SigningKeyStatusActive).Return(&entities.SigningKey{KID: "active"}, nil)
+		mockRepo.EXPECT().FindByStatus(ctx, signer.ES256, entities.SigningKeyStatusPending).Return(&entities.SigningKey{KID: "pending"}, nil)
+		mockRepo.EXPECT().Activate(ctx, "pending").Return(domain.ErrSigningKeyNotFound)
+
+		service := NewKeyService(mockRepo, nil, &configs.Environment{})
+
+		// Act
+		result, err := service.RotateSigningKeys(ctx, signer.ES256)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrSigningKeyNotFound)
+	})
+
+	t.Run("should return error for unsupported algorithm", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewKeyService(mocks.NewSigningKeyRepositoryMock(t), nil, &configs.Environment{})
+
+		// Act
+		result, err := service.RotateSigningKeys(ctx, "HS256")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "unsupported algorithm")
+	})
+}
+
+func TestReloadSigningKeys(t *testing.T) {
+	t.Run("should serve keys from cache after reload", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		activeKey := newTestSigningKeyEntity(t, "active", entities.SigningKeyStatusActive)
+		pendingKey := newTestSigningKeyEntity(t, "pending", entities.SigningKeyStatusPending)
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		mockRepo.EXPECT().FindVerifiable(ctx).Return([]*entities.SigningKey{pendingKey, activeKey}, nil).Once()
+
+		service := NewKeyService(mockRepo, nil, &configs.Environment{})
+
+		// Act
+		err := service.ReloadSigningKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+
+		signingKey, err := service.GetActiveSigningKey(ctx, signer.ES256)
+		require.NoError(t, err)
+		assert.Equal(t, "active", signingKey.KID)
+		assert.NotNil(t, signingKey.Signer)
+
+		verificationKey, err := service.GetVerificationKey(ctx, "pending")
+		require.NoError(t, err)
+		assert.Nil(t, verificationKey.Signer)
+		assert.Len(t, service.GetVerificationKeys(ctx), 2)
+	})
+
+	t.Run("should keep current keys when a key is malformed", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		activeKey := newTestSigningKeyEntity(t, "active", entities.SigningKeyStatusActive)
+		malformedKey := &entities.SigningKey{KID: "malformed", Algorithm: "ES256", PublicKey: "invalid", Status: entities.SigningKeyStatusActive}
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		mockRepo.EXPECT().FindVerifiable(ctx).Return([]*entities.SigningKey{activeKey}, nil).Once()
+		mockRepo.EXPECT().FindVerifiable(ctx).Return([]*entities.SigningKey{malformedKey}, nil).Once()
+
+		service := NewKeyService(mockRepo, nil, &configs.Environment{})
+		require.NoError(t, service.ReloadSigningKeys(ctx))
+
+		// Act
+		err := service.ReloadSigningKeys(ctx)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reload key set")
+
+		signingKey, err := service.GetActiveSigningKey(ctx, signer.ES256)
+		require.NoError(t, err)
+		assert.Equal(t, "active", signingKey.KID)
+	})
+}
+
+func TestGetVerificationKey(t *testing.T) {
+	t.Run("should return not found when retired key expired", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		verifyUntil := time.Now().Add(-time.Minute)
+		retiredKey := newTestSigningKeyEntity(t, "retired", entities.SigningKeyStatusRetired)
+		retiredKey.VerifyUntil = &verifyUntil
+
+		mockRepo := mocks.NewSigningKeyRepositoryMock(t)
+		mockRepo.EXPECT().FindVerifiable(ctx).Return([]*entities.SigningKey{retiredKey}, nil)
+
+		service := NewKeyService(mockRepo, nil, &configs.Environment{})
+		require.NoError(t, service.ReloadSigningKeys(ctx))
+
+		// Act
+		result, err := service.GetVerificationKey(ctx, "retired")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrSigningKeyNotFound)
+	})
+
+	t.Run("should return not found when kid is unknown", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewKeyService(nil, nil, &configs.Environment{})
+
+		// Act
+		result, err := service.GetVerificationKey(ctx, "unknown")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrSigningKeyNotFound)
+	})
+}
+
+func newTestSigningKeyEntity(t *testing.T, kid string, status entities.SigningKeyStatus) *entities.SigningKey {
+	t.Helper()
+
+	privateKeyPEM, publicKeyPEM, err := signer.GenerateKeyPEM(signer.ES256)
+	require.NoError(t, err)
+
+	return &entities.SigningKey{
+		KID:        kid,
+		Algorithm:  "ES256",
+		PrivateKey: privateKeyPEM,
+		PublicKey:  publicKeyPEM,
+		Status:     status,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,15 +20,23 @@ import (
 type OAuthService interface {
 	Authorize(ctx context.Context, input models.AuthorizeInput) (*models.AuthorizeResponse, error)
 	ExchangeCodeForToken(ctx context.Context, input models.ExchangeAuthorizationCodeInput) (*models.TokenResponse, error)
+	RefreshAccessToken(ctx context.Context, input models.RefreshTokenInput) (*models.TokenResponse, error)
+	ClientCredentials(ctx context.Context, input models.ClientCredentialsInput) (*models.TokenResponse, error)
+	ExchangeDeviceCode(ctx context.Context, input models.DeviceCodeInput) (*models.TokenResponse, error)
+	PushAuthorizationRequest(ctx context.Context, input models.AuthorizeInput) (*models.PushedAuthorizationResponse, error)
 }
 
 type oauthService struct {
-	clientService       ClientService
-	authCodeService     AuthorizationCodeService
-	jwtService          JWTService
-	userRepo            repositories.UserRepository
-	refreshTokenService RefreshTokenService
-	config              *configs.Environment
+	clientService         ClientService
+	authCodeService       AuthorizationCodeService
+	jwtService            JWTService
+	userRepo              repositories.UserRepository
+	refreshTokenService   RefreshTokenService
+	config                *configs.Environment
+	deviceService         DeviceAuthorizationService
+	parRepo               repositories.PushedAuthorizationRequestRepository
+	resourceServerService ResourceServerService
+	consentService        ConsentService
 }
 
 func NewOAuthService(
@@ -36,19 +46,68 @@ func NewOAuthService(
 	userRepo repositories.UserRepository,
 	refreshTokenService RefreshTokenService,
 	config *configs.Environment,
+	deviceService DeviceAuthorizationService,
+	parRepo repositories.PushedAuthorizationRequestRepository,
+	resourceServerService ResourceServerService,
+	consentService ConsentService,
 ) OAuthService {
 	return &oauthService{
-		clientService:       clientService,
-		authCodeService:     authCodeService,
-		jwtService:          jwtService,
-		userRepo:            userRepo,
-		refreshTokenService: refreshTokenService,
-		config:              config,
+		clientService:         clientService,
+		authCodeService:       authCodeService,
+		jwtService:            jwtService,
+		userRepo:              userRepo,
+		refreshTokenService:   refreshTokenService,
+		config:                config,
+		deviceService:         deviceService,
+		parRepo:               parRepo,
+		resourceServerService: resourceServerService,
+		consentService:        consentService,
 	}
 }
 
 func (s *oauthService) Authorize(ctx context.Context, input models.AuthorizeInput) (*models.AuthorizeResponse, error) {
-	if input.UserID == "" {
+	if input.RequestURI != "" {
+		pushedInput, err := s.pushedAuthorizeInput(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("find request_uri: %w", err)
+		}
+
+		input = pushedInput
+	}
+
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if input.RequestObject != "" {
+		signedInput, err := s.verifyRequestObject(client, input)
+		if err != nil {
+			return nil, fmt.Errorf("verify request object: %w", err)
+		}
+
+		input = signedInput
+	}
+
+	if client.RequirePushedAuthorizationRequests && input.RequestURI == "" {
+		return nil, fmt.Errorf("validate request: %w", domain.ErrPARRequired)
+	}
+
+	if err := client.ValidateRedirectURI(input.RedirectURI); err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	loginRequired, err := s.loginRequired(ctx, client, input)
+	if err != nil {
+		return nil, authorizationRedirectError(input, err)
+	}
+
+	if loginRequired {
+		// prompt=none pede uma autenticação silenciosa; sem sessão válida o erro volta ao cliente.
+		if input.HasPrompt(models.PromptNone) {
+			return nil, authorizationRedirectError(input, domain.ErrLoginRequired)
+		}
+
 		loginRedirectURL, err := s.loginRedirectURL(input)
 		if err != nil {
 			return nil, fmt.Errorf("login redirect url: %w", err)
@@ -59,16 +118,123 @@ func (s *oauthService) Authorize(ctx context.Context, input models.AuthorizeInpu
 		}, nil
 	}
 
-	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	response, err := s.authorize(ctx, client, input)
 	if err != nil {
-		return nil, fmt.Errorf("get client by client_id: %w", err)
+		return nil, authorizationRedirectError(input, err)
+	}
+
+	return response, nil
+}
+
+func authorizationRedirectError(input models.AuthorizeInput, err error) error {
+	return &domain.ErrAuthorizationRedirect{
+		RedirectURI: input.RedirectURI,
+		State:       input.State,
+		Err:         err,
+	}
+}
+
+// loginRequired decide se o usuário precisa se autenticar, ou se autenticar de novo,
+// antes da autorização (OIDC Core, seção 3.1.2.1). Um id_token_hint de outro usuário
+// não leva ao login: o erro volta ao cliente.
+func (s *oauthService) loginRequired(ctx context.Context, client *entities.Client, input models.AuthorizeInput) (bool, error) {
+	if err := validatePrompt(input.Prompt); err != nil {
+		return false, err
 	}
 
-	if err := s.validateOAuthParameters(client, input); err != nil {
+	if input.IDTokenHint != "" {
+		claims, err := s.jwtService.ValidateIDTokenHint(ctx, input.IDTokenHint)
+		if err != nil {
+			return false, fmt.Errorf("%w: %s", domain.ErrInvalidIDTokenHint, err)
+		}
+
+		if !slices.Contains(claims.Audience, client.ClientID) {
+			return false, fmt.Errorf("%w: issued to another client", domain.ErrInvalidIDTokenHint)
+		}
+
+		if input.UserID != "" && claims.Subject != input.UserID {
+			return false, fmt.Errorf("%w: session belongs to another user", domain.ErrLoginRequired)
+		}
+	}
+
+	if input.UserID == "" {
+		return true, nil
+	}
+
+	// max_age=0 equivale a prompt=login. O auth_time da sessão tem precisão de segundos.
+	if input.HasPrompt(models.PromptLogin) || (input.MaxAge != nil && *input.MaxAge == 0) {
+		return input.RequestedAt.IsZero() || input.AuthTime.Before(input.RequestedAt.Truncate(time.Second)), nil
+	}
+
+	if input.MaxAge != nil {
+		return time.Since(input.AuthTime) > time.Duration(*input.MaxAge)*time.Second, nil
+	}
+
+	return false, nil
+}
+
+// validatePrompt aceita apenas os valores do OIDC Core; none não pode ser combinado.
+func validatePrompt(prompt []string) error {
+	for _, value := range prompt {
+		if !slices.Contains(models.SupportedPrompts, value) {
+			return fmt.Errorf("%w: %s", domain.ErrInvalidPrompt, value)
+		}
+	}
+
+	if slices.Contains(prompt, models.PromptNone) && len(prompt) > 1 {
+		return fmt.Errorf("%w: none cannot be combined with other values", domain.ErrInvalidPrompt)
+	}
+
+	return nil
+}
+
+// authorize executa as etapas que ocorrem após o redirect_uri ser validado;
+// seus erros podem ser devolvidos ao cliente via redirect.
+func (s *oauthService) authorize(ctx context.Context, client *entities.Client, input models.AuthorizeInput) (*models.AuthorizeResponse, error) {
+	if err := s.validateOAuthParameters(ctx, client, input); err != nil {
 		return nil, fmt.Errorf("validate request: %w", err)
 	}
 
-	fmt.Println("input.scope", input.Scope)
+	consentRequired, err := s.consentService.ConsentRequired(ctx, client, input)
+	if err != nil {
+		return nil, fmt.Errorf("check consent: %w", err)
+	}
+
+	if consentRequired {
+		if input.HasPrompt(models.PromptNone) {
+			return nil, fmt.Errorf("check consent: %w", domain.ErrConsentRequired)
+		}
+
+		continueURL, err := s.authorizeURL(input)
+		if err != nil {
+			return nil, fmt.Errorf("authorize url: %w", err)
+		}
+
+		consentURL, err := s.consentService.CreateConsentRequest(ctx, input, continueURL)
+		if err != nil {
+			return nil, fmt.Errorf("create consent request: %w", err)
+		}
+
+		return &models.AuthorizeResponse{
+			RedirectURL: consentURL,
+		}, nil
+	}
+
+	// Clientes first-party não passam pela tela; o consentimento é registrado aqui para
+	// aparecer nos aplicativos conectados.
+	if client.FirstParty {
+		if err := s.consentService.GrantScopes(ctx, input.UserID, client.ClientID, input.Scope); err != nil {
+			return nil, fmt.Errorf("grant first party consent: %w", err)
+		}
+	}
+
+	// O request_uri só é consumido quando o código é emitido, pois login e consentimento
+	// voltam ao /authorize com ele.
+	if input.RequestURI != "" {
+		if _, err := s.parRepo.Consume(ctx, hashToken(input.RequestURI)); err != nil {
+			return nil, fmt.Errorf("consume request_uri: %w", err)
+		}
+	}
 
 	authorizationCodeInput := models.CreateAuthorizationCodeInput{
 		UserID:              input.UserID,
@@ -77,6 +243,9 @@ func (s *oauthService) Authorize(ctx context.Context, input models.AuthorizeInpu
 		CodeChallenge:       input.CodeChallenge,
 		CodeChallengeMethod: input.CodeChallengeMethod,
 		Scopes:              input.Scope,
+		Resources:           input.Resource,
+		Nonce:               input.Nonce,
+		AuthTime:            input.AuthTime,
 	}
 	authorizationCode, err := s.authCodeService.CreateAuthorizationCode(ctx, authorizationCodeInput)
 	if err != nil {
@@ -94,68 +263,422 @@ func (s *oauthService) Authorize(ctx context.Context, input models.AuthorizeInpu
 }
 
 func (s *oauthService) ExchangeCodeForToken(ctx context.Context, input models.ExchangeAuthorizationCodeInput) (*models.TokenResponse, error) {
-	authorizationCode, err := s.authCodeService.ValidateAuthorizationCode(ctx, input.Code, input.CodeVerifier)
+	authorizationCode, err := s.authCodeService.ValidateAuthorizationCode(ctx, input.Code, input.CodeVerifier, input.ClientID, input.RedirectURI)
 	if err != nil {
 		return nil, fmt.Errorf("validate authorization code: %w", err)
 	}
 
-	if !authorizationCode.IsValidClientID(input.ClientID) {
-		return nil, fmt.Errorf("exchange code for token %w: %s", domain.ErrUnauthorizedClient, input.ClientID)
+	client, err := s.clientService.GetClientByClientID(ctx, authorizationCode.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
 	}
 
-	if !authorizationCode.IsValidRedirectURI(input.RedirectURI) {
-		return nil, fmt.Errorf("exchange code for token %w", domain.ErrUnauthorizedRedirectURI)
+	audience, accessTokenScopes, err := accessTokenTarget(ctx, s.resourceServerService, input.Resource, authorizationCode.Resources, authorizationCode.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("exchange code for token: %w", err)
 	}
 
-	client, err := s.clientService.GetClientByClientID(ctx, authorizationCode.ClientID)
-	if err != nil {
-		return nil, fmt.Errorf("get client by client_id: %w", err)
+	if err := s.consentService.RecordGrantUse(ctx, authorizationCode.UserID, client.ClientID, authorizationCode.Scopes); err != nil {
+		return nil, fmt.Errorf("record grant use: %w", err)
 	}
 
-	hasRefreshToken := client.IsValidGrantType("refresh_token")
+	hasRefreshToken := client.IsValidGrantType(models.GrantTypeRefreshToken)
 	accessTokenExpiresAt := time.Now().Add(s.config.Security.AccessTokenExpirationHours)
-	if hasRefreshToken {
-		accessTokenExpiresAt = time.Now().Add(s.config.Security.RefreshTokenExpirationHours)
-	}
 
-	accessToken, err := s.jwtService.GenerateAccessTokenJWT(ctx, authorizationCode.UserID, accessTokenExpiresAt)
+	accessToken, err := s.jwtService.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+		ID:                    authorizationCode.AccessTokenID,
+		UserID:                authorizationCode.UserID,
+		ClientID:              authorizationCode.ClientID,
+		Scopes:                accessTokenScopes,
+		Audience:              audience,
+		ExpiresAt:             accessTokenExpiresAt,
+		DPoPJKT:               input.DPoPJKT,
+		CertificateThumbprint: input.CertificateThumbprint,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("generate access token: %w", err)
 	}
 
-	var refreshTokenHash string
+	var refreshTokenValue string
 	if hasRefreshToken {
-		refreshToken, err := s.refreshTokenService.CreateRefreshToken(ctx, authorizationCode.UserID, client.ClientID, authorizationCode.Scopes)
+		refreshToken, err := s.refreshTokenService.CreateRefreshToken(ctx, models.CreateRefreshTokenInput{
+			UserID:    authorizationCode.UserID,
+			ClientID:  client.ClientID,
+			FamilyID:  authorizationCode.RefreshTokenFamilyID,
+			Scopes:    authorizationCode.Scopes,
+			Resources: authorizationCode.Resources,
+			AuthTime:  authorizationCode.AuthTime,
+			DPoPJKT:   refreshTokenDPoPJKT(client, input.DPoPJKT),
+		})
 		if err != nil {
 			return nil, fmt.Errorf("create refresh token: %w", err)
 		}
 
-		refreshTokenHash = refreshToken.TokenHash
+		refreshTokenValue = refreshToken.Token
 	}
 
-	hasOpenID := scopes.HasScope(authorizationCode.Scopes, "openid")
-	var idToken string
-	if hasOpenID {
-		user, err := s.userRepo.FindByID(ctx, authorizationCode.UserID)
-		if err != nil {
-			return nil, fmt.Errorf("find user by id: %w", err)
+	idToken, err := s.idToken(ctx, client, authorizationCode.Scopes, models.IDTokenInput{
+		UserID:      authorizationCode.UserID,
+		Nonce:       authorizationCode.Nonce,
+		AuthTime:    authorizationCode.AuthTime,
+		AccessToken: accessToken,
+		Code:        input.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TokenResponse{
+		AccessToken:  accessToken,
+		IDToken:      idToken,
+		RefreshToken: refreshTokenValue,
+		TokenType:    accessTokenType(input.DPoPJKT),
+		ExpiresIn:    int64(time.Until(accessTokenExpiresAt).Seconds()),
+		Scope:        restrictedScope(authorizationCode.Scopes, accessTokenScopes),
+	}, nil
+}
+
+func (s *oauthService) RefreshAccessToken(ctx context.Context, input models.RefreshTokenInput) (*models.TokenResponse, error) {
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if !client.IsValidGrantType(models.GrantTypeRefreshToken) {
+		return nil, fmt.Errorf("refresh access token %w: client does not support refresh_token grant type", domain.ErrUnauthorizedClient)
+	}
+
+	refreshToken, err := s.refreshTokenService.ValidateRefreshToken(ctx, input.RefreshToken, client.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("validate refresh token: %w", err)
+	}
+
+	if refreshToken.DPoPJKT != "" && refreshToken.DPoPJKT != input.DPoPJKT {
+		return nil, fmt.Errorf("refresh access token: %w", domain.ErrDPoPKeyMismatch)
+	}
+
+	grantedScopes := refreshToken.Scopes
+	if len(input.Scope) > 0 {
+		if !scopes.HasAllScopes(refreshToken.Scopes, input.Scope) {
+			return nil, fmt.Errorf("refresh access token %w: requested scope exceeds the original grant", domain.ErrInvalidScope)
 		}
 
-		idToken, err = s.jwtService.GenerateIDTokenJWT(ctx, authorizationCode.UserID, user.GetFullName(), user.Email, s.config.Security.IDTokenExpirationMinutes)
+		grantedScopes = input.Scope
+	}
+
+	audience, accessTokenScopes, err := accessTokenTarget(ctx, s.resourceServerService, input.Resource, refreshToken.Resources, grantedScopes)
+	if err != nil {
+		return nil, fmt.Errorf("refresh access token: %w", err)
+	}
+
+	if err := s.consentService.RecordGrantUse(ctx, refreshToken.UserID, client.ClientID, refreshToken.Scopes); err != nil {
+		return nil, fmt.Errorf("record grant use: %w", err)
+	}
+
+	rotatedRefreshToken, err := s.refreshTokenService.RotateRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("rotate refresh token: %w", err)
+	}
+
+	accessTokenExpiresAt := time.Now().Add(s.config.Security.AccessTokenExpirationHours)
+	accessToken, err := s.jwtService.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+		UserID:                refreshToken.UserID,
+		ClientID:              client.ClientID,
+		Scopes:                accessTokenScopes,
+		Audience:              audience,
+		ExpiresAt:             accessTokenExpiresAt,
+		DPoPJKT:               input.DPoPJKT,
+		CertificateThumbprint: input.CertificateThumbprint,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	idToken, err := s.idToken(ctx, client, grantedScopes, models.IDTokenInput{
+		UserID:      refreshToken.UserID,
+		AuthTime:    refreshToken.AuthTime,
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TokenResponse{
+		AccessToken:  accessToken,
+		IDToken:      idToken,
+		RefreshToken: rotatedRefreshToken.Token,
+		TokenType:    accessTokenType(input.DPoPJKT),
+		ExpiresIn:    int64(time.Until(accessTokenExpiresAt).Seconds()),
+		Scope:        restrictedScope(grantedScopes, accessTokenScopes),
+	}, nil
+}
+
+// ClientCredentials emite um access token em nome do próprio cliente (RFC 6749, seção 4.4),
+// sem refresh token nem ID token. O cliente já foi autenticado no endpoint de token. O
+// token é marcado com sub_type=client para nunca ser tratado como sessão de um usuário.
+func (s *oauthService) ClientCredentials(ctx context.Context, input models.ClientCredentialsInput) (*models.TokenResponse, error) {
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if !client.IsConfidential() {
+		return nil, fmt.Errorf("client credentials %w: public clients cannot use client_credentials", domain.ErrUnauthorizedClient)
+	}
+
+	if !client.IsValidGrantType(models.GrantTypeClientCredentials) {
+		return nil, fmt.Errorf("client credentials %w: client does not support client_credentials grant type", domain.ErrUnauthorizedClient)
+	}
+
+	grantedScopes := client.Scopes
+	if len(input.Scope) > 0 {
+		if !scopes.HasAllScopes(client.Scopes, input.Scope) {
+			return nil, fmt.Errorf("client credentials %w: requested scope exceeds the client scopes", domain.ErrInvalidScope)
+		}
+
+		grantedScopes = input.Scope
+	}
+
+	audience, accessTokenScopes, err := accessTokenTarget(ctx, s.resourceServerService, input.Resource, nil, grantedScopes)
+	if err != nil {
+		return nil, fmt.Errorf("client credentials: %w", err)
+	}
+
+	accessTokenExpiresAt := time.Now().Add(s.config.Security.AccessTokenExpirationHours)
+	accessToken, err := s.jwtService.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+		UserID:                client.ClientID,
+		ClientID:              client.ClientID,
+		Scopes:                accessTokenScopes,
+		Audience:              audience,
+		ExpiresAt:             accessTokenExpiresAt,
+		DPoPJKT:               input.DPoPJKT,
+		CertificateThumbprint: input.CertificateThumbprint,
+		SubjectType:           models.SubjectTypeClient,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	return &models.TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   accessTokenType(input.DPoPJKT),
+		ExpiresIn:   int64(time.Until(accessTokenExpiresAt).Seconds()),
+		Scope:       restrictedScope(grantedScopes, accessTokenScopes),
+	}, nil
+}
+
+// ExchangeDeviceCode troca um device_code aprovado pelo usuário por tokens (RFC 8628, seção 3.4).
+func (s *oauthService) ExchangeDeviceCode(ctx context.Context, input models.DeviceCodeInput) (*models.TokenResponse, error) {
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if !client.IsValidGrantType(models.GrantTypeDeviceCode) {
+		return nil, fmt.Errorf("exchange device code %w: client does not support device_code grant type", domain.ErrUnauthorizedClient)
+	}
+
+	deviceCode, err := s.deviceService.ConsumeDeviceCode(ctx, input.DeviceCode, client.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("consume device code: %w", err)
+	}
+
+	audience, accessTokenScopes, err := accessTokenTarget(ctx, s.resourceServerService, input.Resource, nil, deviceCode.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("exchange device code: %w", err)
+	}
+
+	if err := s.consentService.RecordGrantUse(ctx, deviceCode.UserID, client.ClientID, deviceCode.Scopes); err != nil {
+		return nil, fmt.Errorf("record grant use: %w", err)
+	}
+
+	accessTokenExpiresAt := time.Now().Add(s.config.Security.AccessTokenExpirationHours)
+	accessToken, err := s.jwtService.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+		UserID:                deviceCode.UserID,
+		ClientID:              client.ClientID,
+		Scopes:                accessTokenScopes,
+		Audience:              audience,
+		ExpiresAt:             accessTokenExpiresAt,
+		DPoPJKT:               input.DPoPJKT,
+		CertificateThumbprint: input.CertificateThumbprint,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	var refreshTokenValue string
+	if client.IsValidGrantType(models.GrantTypeRefreshToken) {
+		refreshToken, err := s.refreshTokenService.CreateRefreshToken(ctx, models.CreateRefreshTokenInput{
+			UserID:   deviceCode.UserID,
+			ClientID: client.ClientID,
+			Scopes:   deviceCode.Scopes,
+			DPoPJKT:  refreshTokenDPoPJKT(client, input.DPoPJKT),
+		})
 		if err != nil {
-			return nil, fmt.Errorf("generate id token: %w", err)
+			return nil, fmt.Errorf("create refresh token: %w", err)
 		}
+
+		refreshTokenValue = refreshToken.Token
+	}
+
+	idToken, err := s.idToken(ctx, client, deviceCode.Scopes, models.IDTokenInput{
+		UserID:      deviceCode.UserID,
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &models.TokenResponse{
 		AccessToken:  accessToken,
 		IDToken:      idToken,
-		RefreshToken: refreshTokenHash,
-		TokenType:    "Bearer",
+		RefreshToken: refreshTokenValue,
+		TokenType:    accessTokenType(input.DPoPJKT),
 		ExpiresIn:    int64(time.Until(accessTokenExpiresAt).Seconds()),
+		Scope:        restrictedScope(deviceCode.Scopes, accessTokenScopes),
+	}, nil
+}
+
+// PushAuthorizationRequest valida os parâmetros de autorização enviados por um cliente
+// já autenticado e os guarda sob um request_uri de uso único (RFC 9126, seção 2).
+func (s *oauthService) PushAuthorizationRequest(ctx context.Context, input models.AuthorizeInput) (*models.PushedAuthorizationResponse, error) {
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if err := client.ValidateRedirectURI(input.RedirectURI); err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	if err := s.validateOAuthParameters(ctx, client, input); err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	if err := validatePrompt(input.Prompt); err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	reference, err := generateSecureRandomString(32)
+	if err != nil {
+		return nil, fmt.Errorf("generate request_uri: %w", err)
+	}
+
+	requestURI := models.RequestURIPrefix + reference
+	expiresAt := time.Now().Add(s.config.PAR.RequestURIExpiration)
+
+	if err := s.parRepo.Create(ctx, &entities.PushedAuthorizationRequest{
+		RequestURIHash:      hashToken(requestURI),
+		ClientID:            client.ClientID,
+		RedirectURI:         input.RedirectURI,
+		ResponseType:        input.ResponseType,
+		Scopes:              input.Scope,
+		State:               input.State,
+		CodeChallenge:       input.CodeChallenge,
+		CodeChallengeMethod: input.CodeChallengeMethod,
+		Resources:           input.Resource,
+		Nonce:               input.Nonce,
+		Prompt:              input.Prompt,
+		MaxAge:              input.MaxAge,
+		LoginHint:           input.LoginHint,
+		IDTokenHint:         input.IDTokenHint,
+		ExpiresAt:           expiresAt,
+	}); err != nil {
+		return nil, fmt.Errorf("create pushed authorization request: %w", err)
+	}
+
+	return &models.PushedAuthorizationResponse{
+		RequestURI: requestURI,
+		ExpiresIn:  int64(time.Until(expiresAt).Seconds()),
+	}, nil
+}
+
+// pushedAuthorizeInput substitui os parâmetros da query pelos enviados via PAR; o
+// request_uri só vale para o cliente que o obteve e é consumido após o login. O primeiro
+// uso estende a validade para PAR_AUTHORIZATION_EXPIRATION, já que o login por OTP e o
+// consentimento costumam passar dos segundos de PAR_REQUEST_URI_EXPIRATION.
+func (s *oauthService) pushedAuthorizeInput(ctx context.Context, input models.AuthorizeInput) (models.AuthorizeInput, error) {
+	if !strings.HasPrefix(input.RequestURI, models.RequestURIPrefix) {
+		return models.AuthorizeInput{}, fmt.Errorf("%w: unknown request_uri scheme", domain.ErrInvalidRequestURI)
+	}
+
+	request, err := s.parRepo.Redeem(ctx, hashToken(input.RequestURI), time.Now().Add(s.config.PAR.AuthorizationExpiration))
+	if err != nil {
+		return models.AuthorizeInput{}, err
+	}
+
+	if request.ClientID != input.ClientID {
+		return models.AuthorizeInput{}, fmt.Errorf("%w: issued to another client", domain.ErrInvalidRequestURI)
+	}
+
+	return models.AuthorizeInput{
+		ClientID:            request.ClientID,
+		RedirectURI:         request.RedirectURI,
+		ResponseType:        request.ResponseType,
+		CodeChallenge:       request.CodeChallenge,
+		CodeChallengeMethod: request.CodeChallengeMethod,
+		Scope:               request.Scopes,
+		State:               request.State,
+		UserID:              input.UserID,
+		RequestURI:          input.RequestURI,
+		Resource:            request.Resources,
+		Nonce:               request.Nonce,
+		Prompt:              request.Prompt,
+		MaxAge:              request.MaxAge,
+		LoginHint:           request.LoginHint,
+		IDTokenHint:         request.IDTokenHint,
+		ConsentChallenge:    input.ConsentChallenge,
+		AuthTime:            input.AuthTime,
+		RequestedAt:         request.CreatedAt,
 	}, nil
 }
 
+// accessTokenType informa DPoP quando o access token foi vinculado a uma chave.
+func accessTokenType(dpopJKT string) string {
+	if dpopJKT != "" {
+		return models.TokenTypeDPoP
+	}
+
+	return "Bearer"
+}
+
+// refreshTokenDPoPJKT só vincula refresh tokens de clientes públicos; os confidenciais
+// já são restritos pela autenticação do cliente (RFC 9449, seção 5).
+func refreshTokenDPoPJKT(client *entities.Client, dpopJKT string) string {
+	if client.IsConfidential() {
+		return ""
+	}
+
+	return dpopJKT
+}
+
+// idToken completa o input com os dados do cliente e as claims do usuário liberadas
+// pelos escopos concedidos.
+func (s *oauthService) idToken(ctx context.Context, client *entities.Client, grantedScopes []string, input models.IDTokenInput) (string, error) {
+	if !scopes.HasScope(grantedScopes, "openid") {
+		return "", nil
+	}
+
+	user, err := s.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return "", fmt.Errorf("find user by id: %w", err)
+	}
+
+	claims := userClaims(user, grantedScopes)
+	input.Algorithm = client.IDTokenSigningAlgorithm()
+	input.ClientID = client.ClientID
+	input.Name = claims.Name
+	input.Email = claims.Email
+	input.ExpiresIn = s.config.Security.IDTokenExpirationMinutes
+
+	idToken, err := s.jwtService.GenerateIDTokenJWT(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("generate id token: %w", err)
+	}
+
+	return idToken, nil
+}
+
 func (s *oauthService) loginRedirectURL(input models.AuthorizeInput) (string, error) {
 	originalURL, err := s.authorizeURL(input)
 	if err != nil {
@@ -169,6 +692,16 @@ func (s *oauthService) loginRedirectURL(input models.AuthorizeInput) (string, er
 
 	query := loginURL.Query()
 	query.Set("continue", originalURL)
+
+	if input.LoginHint != "" {
+		query.Set("login_hint", input.LoginHint)
+	}
+
+	// Com uma sessão ativa o login só ocorre para reautenticar; a tela não pode reaproveitá-la.
+	if input.UserID != "" {
+		query.Set("prompt", models.PromptLogin)
+	}
+
 	loginURL.RawQuery = query.Encode()
 
 	return loginURL.String(), nil
@@ -184,6 +717,23 @@ func (s *oauthService) authorizeURL(input models.AuthorizeInput) (string, error)
 
 	query := baseURL.Query()
 	query.Set("client_id", input.ClientID)
+
+	// Com PAR os parâmetros ficam no servidor; o continue carrega apenas a referência.
+	if input.RequestURI != "" {
+		query.Set("request_uri", input.RequestURI)
+		baseURL.RawQuery = query.Encode()
+
+		return baseURL.String(), nil
+	}
+
+	// O request object já foi verificado e é verificado de novo após o login.
+	if input.RequestObject != "" {
+		query.Set("request", input.RequestObject)
+		baseURL.RawQuery = query.Encode()
+
+		return baseURL.String(), nil
+	}
+
 	query.Set("redirect_uri", input.RedirectURI)
 	query.Set("response_type", input.ResponseType)
 	query.Set("code_challenge", input.CodeChallenge)
@@ -191,6 +741,34 @@ func (s *oauthService) authorizeURL(input models.AuthorizeInput) (string, error)
 	query.Set("scope", strings.Join(input.Scope, " "))
 	query.Set("state", input.State)
 
+	for _, resource := range input.Resource {
+		query.Add("resource", resource)
+	}
+
+	if input.Nonce != "" {
+		query.Set("nonce", input.Nonce)
+	}
+
+	// O login atende prompt=login e max_age=0; repeti-los no continue levaria a outro login.
+	prompt := slices.DeleteFunc(slices.Clone(input.Prompt), func(value string) bool {
+		return value == models.PromptLogin
+	})
+	if len(prompt) > 0 {
+		query.Set("prompt", strings.Join(prompt, " "))
+	}
+
+	if input.MaxAge != nil && *input.MaxAge > 0 {
+		query.Set("max_age", strconv.FormatInt(*input.MaxAge, 10))
+	}
+
+	if input.LoginHint != "" {
+		query.Set("login_hint", input.LoginHint)
+	}
+
+	if input.IDTokenHint != "" {
+		query.Set("id_token_hint", input.IDTokenHint)
+	}
+
 	baseURL.RawQuery = query.Encode()
 
 	return baseURL.String(), nil
@@ -211,11 +789,7 @@ func (s *oauthService) callbackURL(code, state, redirectURI string) (string, err
 	return redirectURL.String(), nil
 }
 
-func (s *oauthService) validateOAuthParameters(client *entities.Client, input models.AuthorizeInput) error {
-	if !client.IsValidRedirectURI(input.RedirectURI) {
-		return fmt.Errorf("authorize: %w", domain.ErrInvalidRedirectURI)
-	}
-
+func (s *oauthService) validateOAuthParameters(ctx context.Context, client *entities.Client, input models.AuthorizeInput) error {
 	if err := client.ValidateResponseType(input.ResponseType); err != nil {
 		return err
 	}
@@ -224,5 +798,21 @@ func (s *oauthService) validateOAuthParameters(client *entities.Client, input mo
 		return err
 	}
 
+	if len(input.Resource) > 0 {
+		if _, err := s.resourceServerService.GetResourceServers(ctx, input.Resource); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// restrictedScope devolve o scope do access token quando ele difere do concedido
+// (RFC 6749, seção 5.1).
+func restrictedScope(grantedScopes, accessTokenScopes []string) string {
+	if slices.Equal(grantedScopes, accessTokenScopes) {
+		return ""
+	}
+
+	return scopes.JoinScopes(accessTokenScopes)
+}
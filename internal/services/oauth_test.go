@@ -2,14 +2,22 @@ package services
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aetheris-lab/aetheris-id/api/configs"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
 	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -40,6 +48,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 
 		input := models.AuthorizeInput{
@@ -53,6 +65,15 @@ func TestAuthorize(t *testing.T) {
 			UserID:              "", // Empty UserID
 		}
 
+		mockClientService.EXPECT().
+			GetClientByClientID(ctx, "test-client-id").
+			Return(&entities.Client{
+				ClientID:     "test-client-id",
+				GrantTypes:   []string{"authorization_code"},
+				RedirectURIs: []string{"https://example.com/callback"},
+				Scopes:       []string{"read", "write"},
+			}, nil)
+
 		// Act
 		result, err := oauthService.Authorize(ctx, input)
 
@@ -79,6 +100,7 @@ func TestAuthorize(t *testing.T) {
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockUserRepo := mocks.NewUserRepositoryMock(t)
 		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
 
 		oauthService := NewOAuthService(
 			mockClientService,
@@ -87,6 +109,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			mockConsentService,
 		)
 
 		input := models.AuthorizeInput{
@@ -98,6 +124,8 @@ func TestAuthorize(t *testing.T) {
 			Scope:               []string{"read", "write"},
 			State:               "test-state",
 			UserID:              "test-user-id",
+			Nonce:               "test-nonce",
+			AuthTime:            time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
 		}
 
 		client := &entities.Client{
@@ -124,6 +152,10 @@ func TestAuthorize(t *testing.T) {
 			GetClientByClientID(ctx, "test-client-id").
 			Return(client, nil)
 
+		mockConsentService.EXPECT().
+			ConsentRequired(ctx, client, input).
+			Return(false, nil)
+
 		mockAuthCodeService.EXPECT().
 			CreateAuthorizationCode(ctx, models.CreateAuthorizationCodeInput{
 				UserID:              "test-user-id",
@@ -132,6 +164,8 @@ func TestAuthorize(t *testing.T) {
 				CodeChallenge:       "test-challenge",
 				CodeChallengeMethod: "S256",
 				Scopes:              []string{"read", "write"},
+				Nonce:               "test-nonce",
+				AuthTime:            time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
 			}).
 			Return(authorizationCode, nil)
 
@@ -170,6 +204,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 
 		input := models.AuthorizeInput{
@@ -219,6 +257,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 
 		input := models.AuthorizeInput{
@@ -252,6 +294,8 @@ func TestAuthorize(t *testing.T) {
 		require.Error(t, err)
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "validate request")
+		var redirectErr *domain.ErrAuthorizationRedirect
+		assert.False(t, errors.As(err, &redirectErr))
 	})
 
 	t.Run("should return error when creating authorization code fails", func(t *testing.T) {
@@ -269,6 +313,7 @@ func TestAuthorize(t *testing.T) {
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockUserRepo := mocks.NewUserRepositoryMock(t)
 		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
 
 		oauthService := NewOAuthService(
 			mockClientService,
@@ -277,6 +322,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			mockConsentService,
 		)
 
 		input := models.AuthorizeInput{
@@ -303,6 +352,10 @@ func TestAuthorize(t *testing.T) {
 			GetClientByClientID(ctx, "test-client-id").
 			Return(client, nil)
 
+		mockConsentService.EXPECT().
+			ConsentRequired(ctx, client, input).
+			Return(false, nil)
+
 		mockAuthCodeService.EXPECT().
 			CreateAuthorizationCode(ctx, models.CreateAuthorizationCodeInput{
 				UserID:              "test-user-id",
@@ -346,6 +399,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 
 		input := models.AuthorizeInput{
@@ -404,6 +461,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 
 		input := models.AuthorizeInput{
@@ -437,6 +498,10 @@ func TestAuthorize(t *testing.T) {
 		require.Error(t, err)
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "validate request")
+		var redirectErr *domain.ErrAuthorizationRedirect
+		require.ErrorAs(t, err, &redirectErr)
+		assert.Equal(t, input.RedirectURI, redirectErr.RedirectURI)
+		assert.Equal(t, input.State, redirectErr.State)
 	})
 
 	t.Run("should return error when scope validation fails", func(t *testing.T) {
@@ -462,6 +527,10 @@ func TestAuthorize(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 
 		input := models.AuthorizeInput{
@@ -498,15 +567,277 @@ func TestAuthorize(t *testing.T) {
 	})
 }
 
+func TestAuthorizeWithPrompt(t *testing.T) {
+	config := &configs.Environment{
+		URLs: configs.URLs{
+			ClientLoginURL: "https://example.com/login",
+			APIBaseURL:     "https://api.example.com",
+		},
+	}
+
+	client := &entities.Client{
+		ClientID:     "spa",
+		GrantTypes:   []string{"authorization_code"},
+		RedirectURIs: []string{"https://spa.example.com/callback"},
+		Scopes:       []string{"openid"},
+	}
+
+	newInput := func() models.AuthorizeInput {
+		return models.AuthorizeInput{
+			ClientID:            "spa",
+			RedirectURI:         "https://spa.example.com/callback",
+			ResponseType:        "code",
+			CodeChallenge:       "test-challenge",
+			CodeChallengeMethod: "S256",
+			Scope:               []string{"openid"},
+			State:               "test-state",
+		}
+	}
+
+	maxAge := func(seconds int64) *int64 { return &seconds }
+
+	t.Run("should return login_required when prompt is none and there is no session", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+
+		input := newInput()
+		input.Prompt = []string{models.PromptNone}
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrLoginRequired)
+
+		var redirectErr *domain.ErrAuthorizationRedirect
+		require.ErrorAs(t, err, &redirectErr)
+		assert.Equal(t, "https://spa.example.com/callback", redirectErr.RedirectURI)
+		assert.Equal(t, "test-state", redirectErr.State)
+	})
+
+	t.Run("should force a new login when prompt is login", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+
+		input := newInput()
+		input.UserID = "user-id"
+		input.AuthTime = time.Now().Add(-time.Minute)
+		input.Prompt = []string{models.PromptLogin, models.PromptConsent}
+		input.LoginHint = "ada@example.com"
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		loginURL, err := url.Parse(result.RedirectURL)
+		require.NoError(t, err)
+		assert.Equal(t, models.PromptLogin, loginURL.Query().Get("prompt"))
+		assert.Equal(t, "ada@example.com", loginURL.Query().Get("login_hint"))
+
+		continueURL, err := url.Parse(loginURL.Query().Get("continue"))
+		require.NoError(t, err)
+		assert.Equal(t, models.PromptConsent, continueURL.Query().Get("prompt"))
+	})
+
+	t.Run("should force a new login when the session is older than max_age", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+
+		input := newInput()
+		input.UserID = "user-id"
+		input.AuthTime = time.Now().Add(-10 * time.Minute)
+		input.MaxAge = maxAge(300)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(result.RedirectURL, "https://example.com/login"))
+
+		loginURL, err := url.Parse(result.RedirectURL)
+		require.NoError(t, err)
+		continueURL, err := url.Parse(loginURL.Query().Get("continue"))
+		require.NoError(t, err)
+		assert.Equal(t, "300", continueURL.Query().Get("max_age"))
+	})
+
+	t.Run("should authorize when the session is within max_age", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, nil, nil, nil, config, nil, nil, nil, mockConsentService)
+
+		input := newInput()
+		input.UserID = "user-id"
+		input.AuthTime = time.Now().Add(-time.Minute)
+		input.MaxAge = maxAge(300)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, input).Return(false, nil)
+		mockAuthCodeService.EXPECT().CreateAuthorizationCode(ctx, mock.AnythingOfType("models.CreateAuthorizationCodeInput")).
+			Return(&entities.AuthorizationCode{Code: "auth-code"}, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Contains(t, result.RedirectURL, "code=auth-code")
+	})
+
+	t.Run("should return login_required when id_token_hint belongs to another user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+		mockJWTService.EXPECT().ValidateIDTokenHint(ctx, "id-token").Return(models.IDTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "other-user-id", Audience: jwt.ClaimStrings{"spa"}},
+		}, nil)
+
+		input := newInput()
+		input.UserID = "user-id"
+		input.AuthTime = time.Now()
+		input.Prompt = []string{models.PromptNone}
+		input.IDTokenHint = "id-token"
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrLoginRequired)
+	})
+
+	t.Run("should return error when id_token_hint was issued to another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+		mockJWTService.EXPECT().ValidateIDTokenHint(ctx, "id-token").Return(models.IDTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id", Audience: jwt.ClaimStrings{"other-app"}},
+		}, nil)
+
+		input := newInput()
+		input.UserID = "user-id"
+		input.IDTokenHint = "id-token"
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidIDTokenHint)
+	})
+
+	t.Run("should return error when prompt none is combined with other values", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+
+		input := newInput()
+		input.Prompt = []string{models.PromptNone, models.PromptLogin}
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidPrompt)
+	})
+
+	t.Run("should redirect to the consent screen when consent is required", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, mockConsentService)
+
+		input := newInput()
+		input.UserID = "user-id"
+		input.AuthTime = time.Now()
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, input).Return(true, nil)
+		mockConsentService.EXPECT().CreateConsentRequest(ctx, input, mock.MatchedBy(func(continueURL string) bool {
+			return strings.HasPrefix(continueURL, "https://api.example.com/api/v1/oauth/authorize?")
+		})).Return("https://example.com/consent?consent_challenge=challenge", nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/consent?consent_challenge=challenge", result.RedirectURL)
+	})
+
+	t.Run("should return consent_required when prompt is none and consent is missing", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, mockConsentService)
+
+		input := newInput()
+		input.UserID = "user-id"
+		input.AuthTime = time.Now()
+		input.Prompt = []string{models.PromptNone}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, input).Return(true, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrConsentRequired)
+
+		var redirectErr *domain.ErrAuthorizationRedirect
+		require.ErrorAs(t, err, &redirectErr)
+		assert.Equal(t, "https://spa.example.com/callback", redirectErr.RedirectURI)
+	})
+}
+
 func TestExchangeCodeForToken(t *testing.T) {
 	t.Run("should return token response when all validations pass", func(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
 		config := &configs.Environment{
 			Security: configs.Security{
-				AccessTokenExpirationHours:  1,
-				RefreshTokenExpirationHours: 24,
-				IDTokenExpirationMinutes:    15,
+				AccessTokenExpirationHours:  time.Hour,
+				RefreshTokenExpirationHours: 24 * time.Hour,
+				IDTokenExpirationMinutes:    15 * time.Minute,
 			},
 		}
 
@@ -515,6 +846,7 @@ func TestExchangeCodeForToken(t *testing.T) {
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockUserRepo := mocks.NewUserRepositoryMock(t)
 		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
 
 		oauthService := NewOAuthService(
 			mockClientService,
@@ -523,6 +855,10 @@ func TestExchangeCodeForToken(t *testing.T) {
 			mockUserRepo,
 			mockRefreshTokenService,
 			config,
+			nil,
+			nil,
+			nil,
+			mockConsentService,
 		)
 
 		input := models.ExchangeAuthorizationCodeInput{
@@ -533,10 +869,14 @@ func TestExchangeCodeForToken(t *testing.T) {
 		}
 
 		authCode := &entities.AuthorizationCode{
-			UserID:      "test-user-id",
-			ClientID:    "test-client-id",
-			RedirectURI: "https://example.com/callback",
-			Scopes:      []string{"openid", "profile", "email"},
+			UserID:               "test-user-id",
+			ClientID:             "test-client-id",
+			RedirectURI:          "https://example.com/callback",
+			Scopes:               []string{"openid", "profile", "email"},
+			Nonce:                "n-0S6_WzA2Mj",
+			AuthTime:             time.Now().Add(-time.Minute).Truncate(time.Second),
+			AccessTokenID:        "access-token-id",
+			RefreshTokenFamilyID: "refresh-token-family-id",
 		}
 
 		client := &entities.Client{
@@ -551,15 +891,36 @@ func TestExchangeCodeForToken(t *testing.T) {
 		}
 
 		refreshToken := &entities.RefreshToken{
+			Token:     "new-refresh-token",
 			TokenHash: "hashed-refresh-token",
 		}
 
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, input.Code, input.CodeVerifier).Return(authCode, nil)
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, input.Code, input.CodeVerifier, input.ClientID, input.RedirectURI).Return(authCode, nil)
 		mockClientService.EXPECT().GetClientByClientID(ctx, authCode.ClientID).Return(client, nil)
-		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, authCode.UserID, mock.AnythingOfType("time.Time")).Return("new-access-token", nil)
-		mockRefreshTokenService.EXPECT().CreateRefreshToken(ctx, authCode.UserID, client.ClientID, authCode.Scopes).Return(refreshToken, nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, authCode.UserID, client.ClientID, authCode.Scopes).Return(nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.ID == authCode.AccessTokenID && input.UserID == authCode.UserID && input.ClientID == authCode.ClientID
+		})).Return("new-access-token", nil)
+		mockRefreshTokenService.EXPECT().CreateRefreshToken(ctx, models.CreateRefreshTokenInput{
+			UserID:   authCode.UserID,
+			ClientID: client.ClientID,
+			FamilyID: authCode.RefreshTokenFamilyID,
+			Scopes:   authCode.Scopes,
+			AuthTime: authCode.AuthTime,
+		}).Return(refreshToken, nil)
 		mockUserRepo.EXPECT().FindByID(ctx, authCode.UserID).Return(user, nil)
-		mockJWTService.EXPECT().GenerateIDTokenJWT(ctx, authCode.UserID, user.GetFullName(), user.Email, config.Security.IDTokenExpirationMinutes).Return("new-id-token", nil)
+		mockJWTService.EXPECT().GenerateIDTokenJWT(ctx, models.IDTokenInput{
+			Algorithm:   "ES256",
+			UserID:      authCode.UserID,
+			ClientID:    client.ClientID,
+			Name:        user.GetFullName(),
+			Email:       user.Email,
+			Nonce:       authCode.Nonce,
+			AuthTime:    authCode.AuthTime,
+			AccessToken: "new-access-token",
+			Code:        input.Code,
+			ExpiresIn:   config.Security.IDTokenExpirationMinutes,
+		}).Return("new-id-token", nil)
 
 		// Act
 		result, err := oauthService.ExchangeCodeForToken(ctx, input)
@@ -568,10 +929,10 @@ func TestExchangeCodeForToken(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, "new-access-token", result.AccessToken)
-		assert.Equal(t, "hashed-refresh-token", result.RefreshToken)
+		assert.Equal(t, "new-refresh-token", result.RefreshToken)
 		assert.Equal(t, "new-id-token", result.IDToken)
 		assert.Equal(t, "Bearer", result.TokenType)
-		assert.InDelta(t, int(config.Security.RefreshTokenExpirationHours.Seconds()), result.ExpiresIn, 1)
+		assert.InDelta(t, int(config.Security.AccessTokenExpirationHours.Seconds()), result.ExpiresIn, 1)
 	})
 
 	t.Run("should return error when authorization code is invalid", func(t *testing.T) {
@@ -580,10 +941,10 @@ func TestExchangeCodeForToken(t *testing.T) {
 		config := &configs.Environment{}
 
 		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
-		oauthService := NewOAuthService(nil, mockAuthCodeService, nil, nil, nil, config)
+		oauthService := NewOAuthService(nil, mockAuthCodeService, nil, nil, nil, config, nil, nil, nil, nil)
 
 		input := models.ExchangeAuthorizationCodeInput{Code: "invalid-code"}
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "invalid-code", "").Return(nil, errors.New("invalid code"))
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "invalid-code", "", "", "").Return(nil, errors.New("invalid code"))
 
 		// Act
 		result, err := oauthService.ExchangeCodeForToken(ctx, input)
@@ -599,12 +960,10 @@ func TestExchangeCodeForToken(t *testing.T) {
 		ctx := context.Background()
 		config := &configs.Environment{}
 		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
-		oauthService := NewOAuthService(nil, mockAuthCodeService, nil, nil, nil, config)
+		oauthService := NewOAuthService(nil, mockAuthCodeService, nil, nil, nil, config, nil, nil, nil, nil)
 
 		input := models.ExchangeAuthorizationCodeInput{ClientID: "wrong-client-id", Code: "valid-code"}
-		authCode := &entities.AuthorizationCode{ClientID: "correct-client-id"}
-
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "valid-code", "").Return(authCode, nil)
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "valid-code", "", "wrong-client-id", "").Return(nil, domain.ErrClientMismatch)
 
 		// Act
 		result, err := oauthService.ExchangeCodeForToken(ctx, input)
@@ -612,7 +971,7 @@ func TestExchangeCodeForToken(t *testing.T) {
 		// Assert
 		require.Error(t, err)
 		assert.Nil(t, result)
-		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+		assert.ErrorIs(t, err, domain.ErrClientMismatch)
 	})
 
 	t.Run("should return error when redirect URI is invalid", func(t *testing.T) {
@@ -620,12 +979,10 @@ func TestExchangeCodeForToken(t *testing.T) {
 		ctx := context.Background()
 		config := &configs.Environment{}
 		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
-		oauthService := NewOAuthService(nil, mockAuthCodeService, nil, nil, nil, config)
+		oauthService := NewOAuthService(nil, mockAuthCodeService, nil, nil, nil, config, nil, nil, nil, nil)
 
 		input := models.ExchangeAuthorizationCodeInput{RedirectURI: "wrong-uri", ClientID: "client-id", Code: "valid-code"}
-		authCode := &entities.AuthorizationCode{ClientID: "client-id", RedirectURI: "correct-uri"}
-
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "valid-code", "").Return(authCode, nil)
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "valid-code", "", "client-id", "wrong-uri").Return(nil, domain.ErrUnauthorizedRedirectURI)
 
 		// Act
 		result, err := oauthService.ExchangeCodeForToken(ctx, input)
@@ -642,10 +999,10 @@ func TestExchangeCodeForToken(t *testing.T) {
 		config := &configs.Environment{}
 		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
 		mockClientService := mocks.NewClientServiceMock(t)
-		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, nil, nil, nil, config)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, nil, nil, nil, config, nil, nil, nil, nil)
 
 		authCode := &entities.AuthorizationCode{ClientID: "client-id", RedirectURI: "uri", Code: "code"}
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "").Return(authCode, nil)
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "", "client-id", "uri").Return(authCode, nil)
 		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(nil, errors.New("db error"))
 
 		// Act
@@ -664,14 +1021,18 @@ func TestExchangeCodeForToken(t *testing.T) {
 		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
 		mockClientService := mocks.NewClientServiceMock(t)
 		mockJWTService := mocks.NewJWTServiceMock(t)
-		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, mockJWTService, nil, nil, config)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, mockJWTService, nil, nil, config, nil, nil, nil, mockConsentService)
 
 		authCode := &entities.AuthorizationCode{UserID: "user-id", ClientID: "client-id", RedirectURI: "uri", Scopes: []string{}}
 		client := &entities.Client{GrantTypes: []string{"authorization_code"}} // No refresh_token
 
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "").Return(authCode, nil)
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "", "client-id", "uri").Return(authCode, nil)
 		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
-		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, "user-id", mock.AnythingOfType("time.Time")).Return("access-token", nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, authCode.UserID, client.ClientID, authCode.Scopes).Return(nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "user-id"
+		})).Return("access-token", nil)
 
 		// Act
 		result, err := oauthService.ExchangeCodeForToken(ctx, models.ExchangeAuthorizationCodeInput{Code: "code", ClientID: "client-id", RedirectURI: "uri"})
@@ -691,15 +1052,23 @@ func TestExchangeCodeForToken(t *testing.T) {
 		mockClientService := mocks.NewClientServiceMock(t)
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
-		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, mockJWTService, nil, mockRefreshTokenService, config)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, mockJWTService, nil, mockRefreshTokenService, config, nil, nil, nil, mockConsentService)
 
 		authCode := &entities.AuthorizationCode{UserID: "user-id", ClientID: "client-id", RedirectURI: "uri", Scopes: []string{}}
 		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"refresh_token"}}
 
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "").Return(authCode, nil)
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "", "client-id", "uri").Return(authCode, nil)
 		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
-		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, "user-id", mock.AnythingOfType("time.Time")).Return("access-token", nil)
-		mockRefreshTokenService.EXPECT().CreateRefreshToken(ctx, "user-id", "client-id", []string{}).Return(nil, errors.New("failed to create refresh token"))
+		mockConsentService.EXPECT().RecordGrantUse(ctx, authCode.UserID, client.ClientID, authCode.Scopes).Return(nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "user-id"
+		})).Return("access-token", nil)
+		mockRefreshTokenService.EXPECT().CreateRefreshToken(ctx, models.CreateRefreshTokenInput{
+			UserID:   "user-id",
+			ClientID: "client-id",
+			Scopes:   []string{},
+		}).Return(nil, errors.New("failed to create refresh token"))
 
 		// Act
 		result, err := oauthService.ExchangeCodeForToken(ctx, models.ExchangeAuthorizationCodeInput{Code: "code", ClientID: "client-id", RedirectURI: "uri"})
@@ -718,14 +1087,18 @@ func TestExchangeCodeForToken(t *testing.T) {
 		mockClientService := mocks.NewClientServiceMock(t)
 		mockJWTService := mocks.NewJWTServiceMock(t)
 		mockUserRepo := mocks.NewUserRepositoryMock(t)
-		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, mockJWTService, mockUserRepo, nil, config)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, mockJWTService, mockUserRepo, nil, config, nil, nil, nil, mockConsentService)
 
 		authCode := &entities.AuthorizationCode{UserID: "user-id", ClientID: "client-id", RedirectURI: "uri", Scopes: []string{"openid"}}
 		client := &entities.Client{GrantTypes: []string{}}
 
-		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "").Return(authCode, nil)
+		mockAuthCodeService.EXPECT().ValidateAuthorizationCode(ctx, "code", "", "client-id", "uri").Return(authCode, nil)
 		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
-		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, "user-id", mock.AnythingOfType("time.Time")).Return("access-token", nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, authCode.UserID, client.ClientID, authCode.Scopes).Return(nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "user-id"
+		})).Return("access-token", nil)
 		mockUserRepo.EXPECT().FindByID(ctx, "user-id").Return(nil, errors.New("user not found"))
 
 		// Act
@@ -737,3 +1110,1002 @@ func TestExchangeCodeForToken(t *testing.T) {
 		assert.Contains(t, err.Error(), "find user by id")
 	})
 }
+
+func TestRefreshAccessToken(t *testing.T) {
+	t.Run("should return new access token and id token when refresh token is valid", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{
+			Security: configs.Security{
+				AccessTokenExpirationHours: time.Hour,
+				IDTokenExpirationMinutes:   15 * time.Minute,
+			},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, mockUserRepo, mockRefreshTokenService, config, nil, nil, nil, mockConsentService)
+
+		input := models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id"}
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"authorization_code", "refresh_token"}}
+		refreshToken := &entities.RefreshToken{UserID: "user-id", ClientID: "client-id", Scopes: []string{"openid", "profile:read"}}
+		user := &entities.User{FirstName: "Test", LastName: "User", Email: "test@example.com"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(refreshToken, nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, "user-id", "client-id", refreshToken.Scopes).Return(nil)
+		mockRefreshTokenService.EXPECT().RotateRefreshToken(ctx, refreshToken).Return(&entities.RefreshToken{Token: "rotated-refresh-token"}, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "user-id" && input.ClientID == "client-id" && assert.ObjectsAreEqual(refreshToken.Scopes, input.Scopes)
+		})).Return("access-token", nil)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-id").Return(user, nil)
+		mockJWTService.EXPECT().GenerateIDTokenJWT(ctx, models.IDTokenInput{
+			Algorithm:   "ES256",
+			UserID:      "user-id",
+			ClientID:    "client-id",
+			Name:        user.GetFullName(),
+			AccessToken: "access-token",
+			ExpiresIn:   config.Security.IDTokenExpirationMinutes,
+		}).Return("id-token", nil)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", result.AccessToken)
+		assert.Equal(t, "id-token", result.IDToken)
+		assert.Equal(t, "rotated-refresh-token", result.RefreshToken)
+		assert.Equal(t, "Bearer", result.TokenType)
+		assert.InDelta(t, int(time.Hour.Seconds()), result.ExpiresIn, 1)
+	})
+
+	t.Run("should sign id token with the algorithm registered for the client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, mockUserRepo, mockRefreshTokenService, config, nil, nil, nil, mockConsentService)
+
+		input := models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id"}
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"refresh_token"}, IDTokenSignedResponseAlg: "EdDSA"}
+		refreshToken := &entities.RefreshToken{UserID: "user-id", ClientID: "client-id", Scopes: []string{"openid"}}
+		user := &entities.User{FirstName: "Test", LastName: "User", Email: "test@example.com"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(refreshToken, nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, "user-id", "client-id", refreshToken.Scopes).Return(nil)
+		mockRefreshTokenService.EXPECT().RotateRefreshToken(ctx, refreshToken).Return(&entities.RefreshToken{Token: "rotated-refresh-token"}, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.AnythingOfType("models.AccessTokenInput")).Return("access-token", nil)
+		mockUserRepo.EXPECT().FindByID(ctx, "user-id").Return(user, nil)
+		mockJWTService.EXPECT().GenerateIDTokenJWT(ctx, mock.MatchedBy(func(input models.IDTokenInput) bool {
+			return input.Algorithm == "EdDSA" && input.UserID == "user-id" && input.ClientID == "client-id"
+		})).Return("id-token", nil)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "id-token", result.IDToken)
+	})
+
+	t.Run("should issue down-scoped access token when requested scope is a subset", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, mockRefreshTokenService, config, nil, nil, nil, mockConsentService)
+
+		input := models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id", Scope: []string{"profile:read"}}
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"refresh_token"}}
+		refreshToken := &entities.RefreshToken{UserID: "user-id", ClientID: "client-id", Scopes: []string{"openid", "profile:read"}}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(refreshToken, nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, "user-id", "client-id", refreshToken.Scopes).Return(nil)
+		mockRefreshTokenService.EXPECT().RotateRefreshToken(ctx, refreshToken).Return(&entities.RefreshToken{Token: "rotated-refresh-token"}, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return assert.ObjectsAreEqual([]string{"profile:read"}, input.Scopes)
+		})).Return("access-token", nil)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", result.AccessToken)
+		assert.Empty(t, result.IDToken)
+	})
+
+	t.Run("should return error when requested scope exceeds the original grant", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, mockRefreshTokenService, config, nil, nil, nil, nil)
+
+		input := models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id", Scope: []string{"profile:write"}}
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"refresh_token"}}
+		refreshToken := &entities.RefreshToken{UserID: "user-id", ClientID: "client-id", Scopes: []string{"profile:read"}}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(refreshToken, nil)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("should return error when client does not support refresh_token grant", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"authorization_code"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+
+	t.Run("should return error when refresh token validation fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, mockRefreshTokenService, config, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"refresh_token"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(nil, domain.ErrRefreshTokenExpired)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenExpired)
+	})
+
+	t.Run("should return error when refresh token rotation detects reuse", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, mockRefreshTokenService, config, nil, nil, nil, mockConsentService)
+
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"refresh_token"}}
+		refreshToken := &entities.RefreshToken{UserID: "user-id", ClientID: "client-id"}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(refreshToken, nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, "user-id", "client-id", refreshToken.Scopes).Return(nil)
+		mockRefreshTokenService.EXPECT().RotateRefreshToken(ctx, refreshToken).Return(nil, domain.ErrRefreshTokenReused)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("should return error when refresh token is bound to another DPoP key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, mockRefreshTokenService, config, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"refresh_token"}}
+		refreshToken := &entities.RefreshToken{UserID: "user-id", ClientID: "client-id", DPoPJKT: "bound-key"}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(refreshToken, nil)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id", DPoPJKT: "other-key"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrDPoPKeyMismatch)
+	})
+
+	t.Run("should return invalid_target when resource was not granted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, mockRefreshTokenService, &configs.Environment{}, nil, nil, nil, nil)
+
+		input := models.RefreshTokenInput{RefreshToken: "refresh-token", ClientID: "client-id", Resource: []string{"https://admin.aetheris-lab.com"}}
+		client := &entities.Client{ClientID: "client-id", GrantTypes: []string{"authorization_code", "refresh_token"}}
+		refreshToken := &entities.RefreshToken{UserID: "user-id", ClientID: "client-id", Scopes: []string{"users:read"}, Resources: []string{"https://users.aetheris-lab.com"}}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "client-id").Return(client, nil)
+		mockRefreshTokenService.EXPECT().ValidateRefreshToken(ctx, "refresh-token", "client-id").Return(refreshToken, nil)
+
+		// Act
+		result, err := oauthService.RefreshAccessToken(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidResource)
+	})
+}
+
+func TestClientCredentials(t *testing.T) {
+	t.Run("should issue an access token with the client as subject and its scopes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, nil, config, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "batch-job", TokenEndpointAuthMethod: "client_secret_basic", GrantTypes: []string{"client_credentials"}, Scopes: []string{"users:read", "users:write"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "batch-job").Return(client, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "batch-job" && input.ClientID == "batch-job" && input.SubjectType == models.SubjectTypeClient &&
+				assert.ObjectsAreEqual(client.Scopes, input.Scopes)
+		})).Return("access-token", nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "batch-job"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", result.AccessToken)
+		assert.Equal(t, "Bearer", result.TokenType)
+		assert.Empty(t, result.RefreshToken)
+		assert.Empty(t, result.IDToken)
+	})
+
+	t.Run("should issue a DPoP bound token when a proof key is presented", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, nil, config, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "batch-job", TokenEndpointAuthMethod: "client_secret_basic", GrantTypes: []string{"client_credentials"}, Scopes: []string{"users:read"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "batch-job").Return(client, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.DPoPJKT == "key-thumbprint"
+		})).Return("access-token", nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "batch-job", DPoPJKT: "key-thumbprint"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.TokenTypeDPoP, result.TokenType)
+	})
+
+	t.Run("should narrow the token to the requested scopes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, nil, config, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "batch-job", TokenEndpointAuthMethod: "client_secret_basic", GrantTypes: []string{"client_credentials"}, Scopes: []string{"users:read", "users:write"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "batch-job").Return(client, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return assert.ObjectsAreEqual([]string{"users:read"}, input.Scopes)
+		})).Return("access-token", nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "batch-job", Scope: []string{"users:read"}})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", result.AccessToken)
+	})
+
+	t.Run("should return error when requested scope exceeds the client scopes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, &configs.Environment{}, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "batch-job", TokenEndpointAuthMethod: "client_secret_basic", GrantTypes: []string{"client_credentials"}, Scopes: []string{"users:read"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "batch-job").Return(client, nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "batch-job", Scope: []string{"users:write"}})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("should return error when client does not support client_credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, &configs.Environment{}, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "web-app", TokenEndpointAuthMethod: "client_secret_post", GrantTypes: []string{"authorization_code"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "web-app"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+
+	t.Run("should return error when client is public", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, &configs.Environment{}, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "spa", GrantTypes: []string{"client_credentials"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "spa").Return(client, nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "spa"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+
+	t.Run("should restrict audience and scopes to the requested resource", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockResourceServerService := mocks.NewResourceServerServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, nil, config, nil, nil, mockResourceServerService, nil)
+
+		client := &entities.Client{ClientID: "batch-job", TokenEndpointAuthMethod: "client_secret_basic", GrantTypes: []string{"client_credentials"}, Scopes: []string{"users:read", "clients:read"}}
+		resourceServer := &entities.ResourceServer{Identifier: "https://users.aetheris-lab.com", Scopes: []string{"users:read", "users:update"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "batch-job").Return(client, nil)
+		mockResourceServerService.EXPECT().GetResourceServers(ctx, []string{resourceServer.Identifier}).Return([]*entities.ResourceServer{resourceServer}, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return assert.ObjectsAreEqual([]string{"users:read"}, input.Scopes) &&
+				assert.ObjectsAreEqual([]string{resourceServer.Identifier}, input.Audience)
+		})).Return("access-token", nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "batch-job", Resource: []string{resourceServer.Identifier}})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", result.AccessToken)
+		assert.Equal(t, "users:read", result.Scope)
+	})
+
+	t.Run("should return invalid_scope when no granted scope belongs to the resource", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockResourceServerService := mocks.NewResourceServerServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, &configs.Environment{}, nil, nil, mockResourceServerService, nil)
+
+		client := &entities.Client{ClientID: "batch-job", TokenEndpointAuthMethod: "client_secret_basic", GrantTypes: []string{"client_credentials"}, Scopes: []string{"clients:read"}}
+		resourceServer := &entities.ResourceServer{Identifier: "https://users.aetheris-lab.com", Scopes: []string{"users:read"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "batch-job").Return(client, nil)
+		mockResourceServerService.EXPECT().GetResourceServers(ctx, []string{resourceServer.Identifier}).Return([]*entities.ResourceServer{resourceServer}, nil)
+
+		// Act
+		result, err := oauthService.ClientCredentials(ctx, models.ClientCredentialsInput{ClientID: "batch-job", Resource: []string{resourceServer.Identifier}})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+}
+
+func TestExchangeDeviceCode(t *testing.T) {
+	t.Run("should issue tokens for the user who approved the device", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRefreshTokenService := mocks.NewRefreshTokenServiceMock(t)
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, mockJWTService, nil, mockRefreshTokenService, config, mockDeviceService, nil, nil, mockConsentService)
+
+		client := &entities.Client{ClientID: "tv-app", GrantTypes: []string{"urn:ietf:params:oauth:grant-type:device_code", "refresh_token"}}
+		deviceCode := &entities.DeviceCode{ClientID: "tv-app", UserID: "user-id", Scopes: []string{"profile"}}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "tv-app").Return(client, nil)
+		mockDeviceService.EXPECT().ConsumeDeviceCode(ctx, "device-code", "tv-app").Return(deviceCode, nil)
+		mockConsentService.EXPECT().RecordGrantUse(ctx, "user-id", "tv-app", deviceCode.Scopes).Return(nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "user-id" && input.ClientID == "tv-app"
+		})).Return("access-token", nil)
+		mockRefreshTokenService.EXPECT().CreateRefreshToken(ctx, models.CreateRefreshTokenInput{
+			UserID:   "user-id",
+			ClientID: "tv-app",
+			Scopes:   []string{"profile"},
+		}).Return(&entities.RefreshToken{Token: "refresh-token"}, nil)
+
+		// Act
+		result, err := oauthService.ExchangeDeviceCode(ctx, models.DeviceCodeInput{DeviceCode: "device-code", ClientID: "tv-app"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", result.AccessToken)
+		assert.Equal(t, "refresh-token", result.RefreshToken)
+		assert.Empty(t, result.IDToken)
+	})
+
+	t.Run("should propagate authorization_pending while the user has not decided", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockDeviceService := mocks.NewDeviceAuthorizationServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, &configs.Environment{}, mockDeviceService, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "tv-app", GrantTypes: []string{"urn:ietf:params:oauth:grant-type:device_code"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "tv-app").Return(client, nil)
+		mockDeviceService.EXPECT().ConsumeDeviceCode(ctx, "device-code", "tv-app").Return(nil, domain.ErrAuthorizationPending)
+
+		// Act
+		result, err := oauthService.ExchangeDeviceCode(ctx, models.DeviceCodeInput{DeviceCode: "device-code", ClientID: "tv-app"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrAuthorizationPending)
+	})
+
+	t.Run("should return error when client does not support the device grant", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, &configs.Environment{}, nil, nil, nil, nil)
+
+		client := &entities.Client{ClientID: "web-app", GrantTypes: []string{"authorization_code"}}
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+
+		// Act
+		result, err := oauthService.ExchangeDeviceCode(ctx, models.DeviceCodeInput{DeviceCode: "device-code", ClientID: "web-app"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+}
+
+func TestPushAuthorizationRequest(t *testing.T) {
+	config := &configs.Environment{PAR: configs.PAR{RequestURIExpiration: time.Minute}}
+
+	client := &entities.Client{
+		ClientID:     "web-app",
+		GrantTypes:   []string{"authorization_code"},
+		RedirectURIs: []string{"https://example.com/callback"},
+		Scopes:       []string{"openid", "profile"},
+	}
+
+	newInput := func() models.AuthorizeInput {
+		return models.AuthorizeInput{
+			ClientID:            "web-app",
+			RedirectURI:         "https://example.com/callback",
+			ResponseType:        "code",
+			CodeChallenge:       "test-challenge",
+			CodeChallengeMethod: "S256",
+			Scope:               []string{"openid"},
+			State:               "test-state",
+		}
+	}
+
+	t.Run("should store the request and return a request_uri", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockPARRepo := mocks.NewPushedAuthorizationRequestRepositoryMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, mockPARRepo, nil, nil)
+
+		var stored *entities.PushedAuthorizationRequest
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+		mockPARRepo.EXPECT().Create(ctx, mock.AnythingOfType("*entities.PushedAuthorizationRequest")).
+			Run(func(_ context.Context, request *entities.PushedAuthorizationRequest) { stored = request }).
+			Return(nil)
+
+		// Act
+		result, err := oauthService.PushAuthorizationRequest(ctx, newInput())
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(result.RequestURI, models.RequestURIPrefix))
+		assert.InDelta(t, 60, result.ExpiresIn, 1)
+		require.NotNil(t, stored)
+		assert.Equal(t, hashToken(result.RequestURI), stored.RequestURIHash)
+		assert.Equal(t, "test-state", stored.State)
+		assert.Equal(t, []string{"openid"}, stored.Scopes)
+	})
+
+	t.Run("should return error when redirect uri is not registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+
+		input := newInput()
+		input.RedirectURI = "https://attacker.example.com/callback"
+
+		// Act
+		result, err := oauthService.PushAuthorizationRequest(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidRedirectURI)
+	})
+
+	t.Run("should return error when scope is not allowed", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+
+		input := newInput()
+		input.Scope = []string{"admin"}
+
+		// Act
+		result, err := oauthService.PushAuthorizationRequest(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+}
+
+func TestAuthorizeWithRequestURI(t *testing.T) {
+	const requestURI = models.RequestURIPrefix + "reference"
+
+	config := &configs.Environment{
+		URLs: configs.URLs{
+			ClientLoginURL: "https://example.com/login",
+			APIBaseURL:     "https://api.example.com",
+		},
+		PAR: configs.PAR{
+			RequestURIExpiration:    time.Minute,
+			AuthorizationExpiration: 10 * time.Minute,
+		},
+	}
+
+	client := &entities.Client{
+		ClientID:                           "web-app",
+		GrantTypes:                         []string{"authorization_code"},
+		RedirectURIs:                       []string{"https://example.com/callback"},
+		Scopes:                             []string{"openid"},
+		RequirePushedAuthorizationRequests: true,
+	}
+
+	pushedRequest := &entities.PushedAuthorizationRequest{
+		ClientID:            "web-app",
+		RedirectURI:         "https://example.com/callback",
+		ResponseType:        "code",
+		Scopes:              []string{"openid"},
+		State:               "pushed-state",
+		CodeChallenge:       "test-challenge",
+		CodeChallengeMethod: "S256",
+	}
+
+	t.Run("should keep only the request_uri in the login continue url", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockPARRepo := mocks.NewPushedAuthorizationRequestRepositoryMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, mockPARRepo, nil, nil)
+
+		mockPARRepo.EXPECT().Redeem(ctx, hashToken(requestURI), mock.AnythingOfType("time.Time")).Return(pushedRequest, nil)
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{ClientID: "web-app", RequestURI: requestURI})
+
+		// Assert
+		require.NoError(t, err)
+		loginURL, err := url.Parse(result.RedirectURL)
+		require.NoError(t, err)
+		continueURL, err := url.Parse(loginURL.Query().Get("continue"))
+		require.NoError(t, err)
+		assert.Equal(t, url.Values{"client_id": {"web-app"}, "request_uri": {requestURI}}, continueURL.Query())
+	})
+
+	t.Run("should authorize with the pushed parameters", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
+		mockPARRepo := mocks.NewPushedAuthorizationRequestRepositoryMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, nil, nil, nil, config, nil, mockPARRepo, nil, mockConsentService)
+
+		mockPARRepo.EXPECT().Redeem(ctx, hashToken(requestURI), mock.AnythingOfType("time.Time")).Return(pushedRequest, nil)
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+		mockPARRepo.EXPECT().Consume(ctx, hashToken(requestURI)).Return(pushedRequest, nil)
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, mock.AnythingOfType("models.AuthorizeInput")).Return(false, nil)
+		mockAuthCodeService.EXPECT().CreateAuthorizationCode(ctx, models.CreateAuthorizationCodeInput{
+			UserID:              "user-id",
+			ClientID:            "web-app",
+			RedirectURI:         "https://example.com/callback",
+			CodeChallenge:       "test-challenge",
+			CodeChallengeMethod: "S256",
+			Scopes:              []string{"openid"},
+		}).Return(&entities.AuthorizationCode{Code: "auth-code"}, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{ClientID: "web-app", RequestURI: requestURI, UserID: "user-id"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Contains(t, result.RedirectURL, "code=auth-code")
+		assert.Contains(t, result.RedirectURL, "state=pushed-state")
+	})
+
+	t.Run("should accept prompt=login when the login happened after the push", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
+		mockPARRepo := mocks.NewPushedAuthorizationRequestRepositoryMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, nil, nil, nil, config, nil, mockPARRepo, nil, mockConsentService)
+
+		pushedAt := time.Now().Add(-time.Minute)
+		forcedLogin := *pushedRequest
+		forcedLogin.Prompt = []string{models.PromptLogin}
+		forcedLogin.CreatedAt = pushedAt
+
+		mockPARRepo.EXPECT().Redeem(ctx, hashToken(requestURI), mock.AnythingOfType("time.Time")).Return(&forcedLogin, nil).Twice()
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil).Twice()
+		mockPARRepo.EXPECT().Consume(ctx, hashToken(requestURI)).Return(&forcedLogin, nil)
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, mock.AnythingOfType("models.AuthorizeInput")).Return(false, nil)
+		mockAuthCodeService.EXPECT().CreateAuthorizationCode(ctx, mock.AnythingOfType("models.CreateAuthorizationCodeInput")).
+			Return(&entities.AuthorizationCode{Code: "auth-code"}, nil)
+
+		// Act
+		staleSession, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:   "web-app",
+			RequestURI: requestURI,
+			UserID:     "user-id",
+			AuthTime:   pushedAt.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		freshSession, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:   "web-app",
+			RequestURI: requestURI,
+			UserID:     "user-id",
+			AuthTime:   time.Now(),
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(staleSession.RedirectURL, "https://example.com/login"))
+		assert.Contains(t, freshSession.RedirectURL, "code=auth-code")
+	})
+
+	t.Run("should keep the request_uri valid for the consent after its push lifetime", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
+		mockPARRepo := mocks.NewPushedAuthorizationRequestRepositoryMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, nil, nil, nil, config, nil, mockPARRepo, nil, mockConsentService)
+
+		// Enviado há dois minutos: o prazo de PAR_REQUEST_URI_EXPIRATION já passou
+		// enquanto o usuário estava na tela de consentimento.
+		pushedAt := time.Now().Add(-2 * time.Minute)
+		redeemedAt := pushedAt.Add(time.Second)
+		redeemed := *pushedRequest
+		redeemed.CreatedAt = pushedAt
+		redeemed.RedeemedAt = &redeemedAt
+		redeemed.ExpiresAt = redeemedAt.Add(config.PAR.AuthorizationExpiration)
+
+		mockPARRepo.EXPECT().
+			Redeem(ctx, hashToken(requestURI), mock.MatchedBy(func(expiresAt time.Time) bool {
+				return expiresAt.After(time.Now().Add(config.PAR.RequestURIExpiration))
+			})).
+			Return(&redeemed, nil).Twice()
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil).Twice()
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, mock.MatchedBy(func(input models.AuthorizeInput) bool {
+			return input.ConsentChallenge == ""
+		})).Return(true, nil).Once()
+		mockConsentService.EXPECT().CreateConsentRequest(ctx, mock.AnythingOfType("models.AuthorizeInput"), mock.AnythingOfType("string")).
+			Return("https://example.com/consent?consent_challenge=challenge", nil).Once()
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, mock.MatchedBy(func(input models.AuthorizeInput) bool {
+			return input.ConsentChallenge == "challenge"
+		})).Return(false, nil).Once()
+		mockPARRepo.EXPECT().Consume(ctx, hashToken(requestURI)).Return(&redeemed, nil)
+		mockAuthCodeService.EXPECT().CreateAuthorizationCode(ctx, mock.AnythingOfType("models.CreateAuthorizationCodeInput")).
+			Return(&entities.AuthorizationCode{Code: "auth-code"}, nil)
+
+		// Act
+		consent, err := oauthService.Authorize(ctx, models.AuthorizeInput{ClientID: "web-app", RequestURI: requestURI, UserID: "user-id"})
+		require.NoError(t, err)
+
+		approved, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:         "web-app",
+			RequestURI:       requestURI,
+			UserID:           "user-id",
+			ConsentChallenge: "challenge",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(consent.RedirectURL, "https://example.com/consent"))
+		assert.Contains(t, approved.RedirectURL, "code=auth-code")
+		assert.Contains(t, approved.RedirectURL, "state=pushed-state")
+	})
+
+	t.Run("should return error when request_uri was issued to another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockPARRepo := mocks.NewPushedAuthorizationRequestRepositoryMock(t)
+		oauthService := NewOAuthService(nil, nil, nil, nil, nil, config, nil, mockPARRepo, nil, nil)
+
+		mockPARRepo.EXPECT().Redeem(ctx, hashToken(requestURI), mock.AnythingOfType("time.Time")).Return(pushedRequest, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{ClientID: "other-app", RequestURI: requestURI, UserID: "user-id"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidRequestURI)
+	})
+
+	t.Run("should return error when client requires pushed authorization requests", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(client, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:     "web-app",
+			RedirectURI:  "https://example.com/callback",
+			ResponseType: "code",
+			Scope:        []string{"openid"},
+			UserID:       "user-id",
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrPARRequired)
+	})
+}
+
+func TestAuthorizeWithRequestObject(t *testing.T) {
+	config := &configs.Environment{URLs: configs.URLs{APIBaseURL: "https://id.example.com"}}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwk, err := signer.NewJWK(&privateKey.PublicKey, signer.ES256)
+	require.NoError(t, err)
+
+	client := &entities.Client{
+		ClientID:     "partner-app",
+		GrantTypes:   []string{"authorization_code"},
+		RedirectURIs: []string{"https://partner.example.com/callback"},
+		Scopes:       []string{"openid", "profile"},
+		JWKS:         []signer.JWK{jwk},
+	}
+
+	newRequestObject := func(t *testing.T, audience string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, models.RequestObjectClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "partner-app",
+				Audience:  jwt.ClaimStrings{audience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			ClientID:            "partner-app",
+			RedirectURI:         "https://partner.example.com/callback",
+			ResponseType:        "code",
+			Scope:               "openid profile",
+			State:               "signed-state",
+			CodeChallenge:       "signed-challenge",
+			CodeChallengeMethod: "S256",
+		})
+		token.Header["kid"] = jwk.Kid
+
+		requestObject, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		return requestObject
+	}
+
+	t.Run("should authorize with the signed parameters", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockAuthCodeService := mocks.NewAuthorizationCodeServiceMock(t)
+		mockConsentService := mocks.NewConsentServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, mockAuthCodeService, nil, nil, nil, config, nil, nil, nil, mockConsentService)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "partner-app").Return(client, nil)
+		mockConsentService.EXPECT().ConsentRequired(ctx, client, mock.AnythingOfType("models.AuthorizeInput")).Return(false, nil)
+		mockAuthCodeService.EXPECT().CreateAuthorizationCode(ctx, models.CreateAuthorizationCodeInput{
+			UserID:              "user-id",
+			ClientID:            "partner-app",
+			RedirectURI:         "https://partner.example.com/callback",
+			CodeChallenge:       "signed-challenge",
+			CodeChallengeMethod: "S256",
+			Scopes:              []string{"openid", "profile"},
+		}).Return(&entities.AuthorizationCode{Code: "auth-code"}, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:      "partner-app",
+			ResponseType:  "code",
+			Scope:         []string{""},
+			UserID:        "user-id",
+			RequestObject: newRequestObject(t, "https://id.example.com"),
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Contains(t, result.RedirectURL, "code=auth-code")
+		assert.Contains(t, result.RedirectURL, "state=signed-state")
+	})
+
+	t.Run("should return error when a query value conflicts with the signed one", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "partner-app").Return(client, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:      "partner-app",
+			RedirectURI:   "https://attacker.example.com/callback",
+			UserID:        "user-id",
+			RequestObject: newRequestObject(t, "https://id.example.com"),
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidRequestObject)
+		assert.ErrorContains(t, err, "redirect_uri")
+	})
+
+	t.Run("should return error when prompt=login is signed without iat", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "partner-app").Return(client, nil)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, models.RequestObjectClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "partner-app",
+				Audience:  jwt.ClaimStrings{"https://id.example.com"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			RedirectURI:  "https://partner.example.com/callback",
+			ResponseType: "code",
+			Scope:        "openid",
+			Prompt:       models.PromptLogin,
+		})
+		token.Header["kid"] = jwk.Kid
+		requestObject, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:      "partner-app",
+			UserID:        "user-id",
+			RequestObject: requestObject,
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidRequestObject)
+		assert.ErrorContains(t, err, "iat")
+	})
+
+	t.Run("should return error when audience is not the issuer", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "partner-app").Return(client, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:      "partner-app",
+			UserID:        "user-id",
+			RequestObject: newRequestObject(t, "https://other.example.com"),
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidRequestObject)
+	})
+
+	t.Run("should return error when signed with a key the client did not register", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		oauthService := NewOAuthService(mockClientService, nil, nil, nil, nil, config, nil, nil, nil, nil)
+
+		otherClient := *client
+		otherClient.JWKS = nil
+		mockClientService.EXPECT().GetClientByClientID(ctx, "partner-app").Return(&otherClient, nil)
+
+		// Act
+		result, err := oauthService.Authorize(ctx, models.AuthorizeInput{
+			ClientID:      "partner-app",
+			UserID:        "user-id",
+			RequestObject: newRequestObject(t, "https://id.example.com"),
+		})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidRequestObject)
+	})
+}
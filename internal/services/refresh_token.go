@@ -4,16 +4,24 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
 	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
 	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type RefreshTokenService interface {
-	CreateRefreshToken(ctx context.Context, userID, clientID string, scopes []string) (*entities.RefreshToken, error)
+	CreateRefreshToken(ctx context.Context, input models.CreateRefreshTokenInput) (*entities.RefreshToken, error)
+	ValidateRefreshToken(ctx context.Context, token, clientID string) (*entities.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeByUserAndClient(ctx context.Context, userID, clientID string) error
 }
 
 type refreshTokenService struct {
@@ -31,20 +39,109 @@ func NewRefreshTokenService(
 	}
 }
 
-func (s *refreshTokenService) CreateRefreshToken(ctx context.Context, userID, clientID string, scopes []string) (*entities.RefreshToken, error) {
+func (s *refreshTokenService) CreateRefreshToken(ctx context.Context, input models.CreateRefreshTokenInput) (*entities.RefreshToken, error) {
+	id := primitive.NewObjectID()
+
+	familyID := input.FamilyID
+	if familyID == "" {
+		familyID = id.Hex()
+	}
+
+	return s.issueRefreshToken(ctx, &entities.RefreshToken{
+		ID:        id,
+		UserID:    input.UserID,
+		ClientID:  input.ClientID,
+		FamilyID:  familyID,
+		Scopes:    input.Scopes,
+		Resources: input.Resources,
+		AuthTime:  input.AuthTime,
+		DPoPJKT:   input.DPoPJKT,
+	})
+}
+
+// ValidateRefreshToken trata a apresentação de um refresh token já revogado
+// como replay e revoga toda a família emitida a partir do mesmo login.
+func (s *refreshTokenService) ValidateRefreshToken(ctx context.Context, token, clientID string) (*entities.RefreshToken, error) {
+	refreshToken, err := s.refreshTokenRepo.FindByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("find refresh token by hash: %w", err)
+	}
+
+	// Um token de outro cliente não pode derrubar a família do dono legítimo.
+	if !refreshToken.IsValidClientID(clientID) {
+		return nil, fmt.Errorf("validate refresh token %w: %s", domain.ErrClientMismatch, clientID)
+	}
+
+	if refreshToken.IsRevoked() {
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, refreshToken.FamilyID); err != nil {
+			return nil, fmt.Errorf("revoke refresh token family: %w", err)
+		}
+
+		return nil, domain.ErrRefreshTokenReused
+	}
+
+	if refreshToken.IsExpired() {
+		return nil, domain.ErrRefreshTokenExpired
+	}
+
+	return refreshToken, nil
+}
+
+func (s *refreshTokenService) RotateRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error) {
+	if err := s.refreshTokenRepo.Revoke(ctx, refreshToken.ID.Hex()); err != nil {
+		if !errors.Is(err, domain.ErrRefreshTokenRevoked) {
+			return nil, fmt.Errorf("revoke refresh token: %w", err)
+		}
+
+		// Outra requisição consumiu o mesmo token primeiro.
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, refreshToken.FamilyID); err != nil {
+			return nil, fmt.Errorf("revoke refresh token family: %w", err)
+		}
+
+		return nil, domain.ErrRefreshTokenReused
+	}
+
+	return s.issueRefreshToken(ctx, &entities.RefreshToken{
+		ID:        primitive.NewObjectID(),
+		UserID:    refreshToken.UserID,
+		ClientID:  refreshToken.ClientID,
+		FamilyID:  refreshToken.FamilyID,
+		ParentID:  refreshToken.ID.Hex(),
+		Scopes:    refreshToken.Scopes,
+		Resources: refreshToken.Resources,
+		AuthTime:  refreshToken.AuthTime,
+		DPoPJKT:   refreshToken.DPoPJKT,
+		// A rotação não estende a sessão: a família expira junto com o primeiro token.
+		ExpiresAt: refreshToken.ExpiresAt,
+	})
+}
+
+func (s *refreshTokenService) RevokeFamily(ctx context.Context, familyID string) error {
+	if err := s.refreshTokenRepo.RevokeFamily(ctx, familyID); err != nil {
+		return fmt.Errorf("revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+func (s *refreshTokenService) RevokeByUserAndClient(ctx context.Context, userID, clientID string) error {
+	if err := s.refreshTokenRepo.RevokeByUserAndClient(ctx, userID, clientID); err != nil {
+		return fmt.Errorf("revoke refresh tokens by user and client: %w", err)
+	}
+
+	return nil
+}
+
+func (s *refreshTokenService) issueRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error) {
 	tokenVerifier, err := generateSecureRandomString(32)
 	if err != nil {
 		return nil, fmt.Errorf("generate secure random string: %w", err)
 	}
 
-	tokenHash := hashToken(tokenVerifier)
-
-	refreshToken := &entities.RefreshToken{
-		TokenHash: tokenHash,
-		UserID:    userID,
-		ClientID:  clientID,
-		Scopes:    scopes,
-		ExpiresAt: time.Now().Add(s.config.Security.RefreshTokenExpirationHours),
+	refreshToken.Token = tokenVerifier
+	refreshToken.TokenHash = hashToken(tokenVerifier)
+	if refreshToken.ExpiresAt.IsZero() {
+		refreshToken.ExpiresAt = time.Now().Add(s.config.Security.RefreshTokenExpirationHours)
 	}
 
 	if err := s.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCreateRefreshToken(t *testing.T) {
+	t.Run("should persist only the hash and return the plaintext token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{RefreshTokenExpirationHours: 24 * time.Hour}}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*entities.RefreshToken")).
+			Return(nil)
+
+		service := NewRefreshTokenService(mockRepo, config)
+
+		// Act
+		result, err := service.CreateRefreshToken(ctx, models.CreateRefreshTokenInput{
+			UserID:   "user-id",
+			ClientID: "client-id",
+			Scopes:   []string{"openid"},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.Token)
+		assert.Equal(t, hashToken(result.Token), result.TokenHash)
+		assert.Equal(t, "user-id", result.UserID)
+		assert.Equal(t, "client-id", result.ClientID)
+		assert.Equal(t, result.ID.Hex(), result.FamilyID)
+		assert.Empty(t, result.ParentID)
+		assert.Nil(t, result.RevokedAt)
+		assert.True(t, result.ExpiresAt.After(time.Now().Add(23*time.Hour)))
+	})
+
+	t.Run("should join the given family when one is provided", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*entities.RefreshToken")).
+			Return(nil)
+
+		service := NewRefreshTokenService(mockRepo, config)
+
+		// Act
+		result, err := service.CreateRefreshToken(ctx, models.CreateRefreshTokenInput{UserID: "user-id", ClientID: "client-id", FamilyID: "family-id"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "family-id", result.FamilyID)
+	})
+
+	t.Run("should return error when repository fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*entities.RefreshToken")).
+			Return(errors.New("database error"))
+
+		service := NewRefreshTokenService(mockRepo, config)
+
+		// Act
+		result, err := service.CreateRefreshToken(ctx, models.CreateRefreshTokenInput{UserID: "user-id", ClientID: "client-id"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "create refresh token")
+	})
+}
+
+func TestValidateRefreshToken(t *testing.T) {
+	t.Run("should return refresh token when it is valid", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{
+			TokenHash: hashToken("refresh-token"),
+			ClientID:  "client-id",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateRefreshToken(ctx, "refresh-token", "client-id")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, refreshToken, result)
+	})
+
+	t.Run("should return error when refresh token is not found", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("unknown")).Return(nil, domain.ErrRefreshTokenNotFound)
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateRefreshToken(ctx, "unknown", "client-id")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenNotFound)
+	})
+
+	t.Run("should return error when refresh token is expired", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{ClientID: "client-id", ExpiresAt: time.Now().Add(-time.Minute)}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateRefreshToken(ctx, "refresh-token", "client-id")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenExpired)
+	})
+
+	t.Run("should revoke the whole family when a revoked refresh token is replayed", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		revokedAt := time.Now().Add(-time.Minute)
+		refreshToken := &entities.RefreshToken{ClientID: "client-id", FamilyID: "family-id", ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+		mockRepo.EXPECT().RevokeFamily(ctx, "family-id").Return(nil)
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateRefreshToken(ctx, "refresh-token", "client-id")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("should return error when refresh token belongs to another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{ClientID: "other-client", ExpiresAt: time.Now().Add(time.Hour)}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateRefreshToken(ctx, "refresh-token", "client-id")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrClientMismatch)
+	})
+
+	t.Run("should not revoke the family when another client presents a revoked refresh token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		revokedAt := time.Now().Add(-time.Minute)
+		refreshToken := &entities.RefreshToken{ClientID: "other-client", FamilyID: "family-id", ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.ValidateRefreshToken(ctx, "refresh-token", "client-id")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrClientMismatch)
+		mockRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	t.Run("should revoke the presented token and issue a child in the same family", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		config := &configs.Environment{Security: configs.Security{RefreshTokenExpirationHours: 24 * time.Hour}}
+		refreshToken := &entities.RefreshToken{
+			ID:        primitive.NewObjectID(),
+			UserID:    "user-id",
+			ClientID:  "client-id",
+			FamilyID:  "family-id",
+			Scopes:    []string{"openid"},
+			ExpiresAt: time.Now().Add(2 * time.Hour).Truncate(time.Second),
+		}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().Revoke(ctx, refreshToken.ID.Hex()).Return(nil)
+		mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*entities.RefreshToken")).Return(nil)
+
+		service := NewRefreshTokenService(mockRepo, config)
+
+		// Act
+		result, err := service.RotateRefreshToken(ctx, refreshToken)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.Token)
+		assert.NotEqual(t, refreshToken.ID, result.ID)
+		assert.Equal(t, "family-id", result.FamilyID)
+		assert.Equal(t, refreshToken.ID.Hex(), result.ParentID)
+		assert.Equal(t, "user-id", result.UserID)
+		assert.Equal(t, "client-id", result.ClientID)
+		assert.Equal(t, []string{"openid"}, result.Scopes)
+		assert.Equal(t, refreshToken.ExpiresAt, result.ExpiresAt)
+	})
+
+	t.Run("should revoke the family when the token was already consumed concurrently", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{ID: primitive.NewObjectID(), FamilyID: "family-id"}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().Revoke(ctx, refreshToken.ID.Hex()).Return(domain.ErrRefreshTokenRevoked)
+		mockRepo.EXPECT().RevokeFamily(ctx, "family-id").Return(nil)
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.RotateRefreshToken(ctx, refreshToken)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("should return error when revoking the presented token fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{ID: primitive.NewObjectID(), FamilyID: "family-id"}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().Revoke(ctx, refreshToken.ID.Hex()).Return(errors.New("database error"))
+
+		service := NewRefreshTokenService(mockRepo, &configs.Environment{})
+
+		// Act
+		result, err := service.RotateRefreshToken(ctx, refreshToken)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "revoke refresh token")
+	})
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// verifyRequestObject valida o request object com as chaves registradas pelo cliente
+// (RFC 9101, seção 6) e retorna os parâmetros assinados no lugar dos da query. Valores
+// da query só são tolerados quando iguais aos assinados.
+func (s *oauthService) verifyRequestObject(client *entities.Client, input models.AuthorizeInput) (models.AuthorizeInput, error) {
+	var claims models.RequestObjectClaims
+	_, err := jwt.ParseWithClaims(input.RequestObject, &claims, func(token *jwt.Token) (any, error) {
+		return clientAssertionKey(client, token)
+	},
+		jwt.WithValidMethods(signer.SupportedAlgorithms),
+		jwt.WithIssuer(client.ClientID),
+		jwt.WithAudience(Issuer(s.config)),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return models.AuthorizeInput{}, fmt.Errorf("%w: %s", domain.ErrInvalidRequestObject, err)
+	}
+
+	if claims.ClientID != "" && claims.ClientID != input.ClientID {
+		return models.AuthorizeInput{}, fmt.Errorf("%w: client_id does not match", domain.ErrInvalidRequestObject)
+	}
+
+	parameters := []struct {
+		name   string
+		query  string
+		signed string
+	}{
+		{"redirect_uri", input.RedirectURI, claims.RedirectURI},
+		{"response_type", input.ResponseType, claims.ResponseType},
+		{"scope", scopes.JoinScopes(input.Scope), claims.Scope},
+		{"state", input.State, claims.State},
+		{"code_challenge", input.CodeChallenge, claims.CodeChallenge},
+		{"code_challenge_method", input.CodeChallengeMethod, claims.CodeChallengeMethod},
+		{"nonce", input.Nonce, claims.Nonce},
+		{"prompt", strings.Join(input.Prompt, " "), claims.Prompt},
+		{"login_hint", input.LoginHint, claims.LoginHint},
+		{"id_token_hint", input.IDTokenHint, claims.IDTokenHint},
+	}
+
+	for _, parameter := range parameters {
+		if parameter.query != "" && parameter.query != parameter.signed {
+			return models.AuthorizeInput{}, fmt.Errorf("%w: %s conflicts with the signed value", domain.ErrInvalidRequestObject, parameter.name)
+		}
+	}
+
+	if len(input.Resource) > 0 && !slices.Equal(input.Resource, []string(claims.Resource)) {
+		return models.AuthorizeInput{}, fmt.Errorf("%w: resource conflicts with the signed value", domain.ErrInvalidRequestObject)
+	}
+
+	if input.MaxAge != nil && (claims.MaxAge == nil || *input.MaxAge != *claims.MaxAge) {
+		return models.AuthorizeInput{}, fmt.Errorf("%w: max_age conflicts with the signed value", domain.ErrInvalidRequestObject)
+	}
+
+	// O iat marca quando a requisição foi criada; sem ele um login posterior não atende prompt=login.
+	var requestedAt time.Time
+	if claims.IssuedAt != nil {
+		requestedAt = claims.IssuedAt.Time
+	}
+
+	prompt := models.ParsePrompt(claims.Prompt)
+	forceLogin := slices.Contains(prompt, models.PromptLogin) || (claims.MaxAge != nil && *claims.MaxAge == 0)
+	if forceLogin && requestedAt.IsZero() {
+		return models.AuthorizeInput{}, fmt.Errorf("%w: iat is required to force a new login", domain.ErrInvalidRequestObject)
+	}
+
+	return models.AuthorizeInput{
+		ClientID:            client.ClientID,
+		RedirectURI:         claims.RedirectURI,
+		ResponseType:        claims.ResponseType,
+		CodeChallenge:       claims.CodeChallenge,
+		CodeChallengeMethod: claims.CodeChallengeMethod,
+		Scope:               scopes.ParseScopes(claims.Scope),
+		State:               claims.State,
+		UserID:              input.UserID,
+		RequestObject:       input.RequestObject,
+		Resource:            claims.Resource,
+		Nonce:               claims.Nonce,
+		Prompt:              prompt,
+		MaxAge:              claims.MaxAge,
+		LoginHint:           claims.LoginHint,
+		IDTokenHint:         claims.IDTokenHint,
+		ConsentChallenge:    input.ConsentChallenge,
+		AuthTime:            input.AuthTime,
+		RequestedAt:         requestedAt,
+	}, nil
+}
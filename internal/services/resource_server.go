@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+)
+
+// ResourceServerService mantém o registro das APIs que podem ser pedidas no
+// parâmetro resource (RFC 8707).
+type ResourceServerService interface {
+	CreateResourceServer(ctx context.Context, input models.CreateResourceServerInput) (*models.ResourceServerResponse, error)
+	GetResourceServers(ctx context.Context, identifiers []string) ([]*entities.ResourceServer, error)
+}
+
+type resourceServerService struct {
+	resourceServerRepo repositories.ResourceServerRepository
+}
+
+func NewResourceServerService(resourceServerRepo repositories.ResourceServerRepository) ResourceServerService {
+	return &resourceServerService{
+		resourceServerRepo: resourceServerRepo,
+	}
+}
+
+func (s *resourceServerService) CreateResourceServer(ctx context.Context, input models.CreateResourceServerInput) (*models.ResourceServerResponse, error) {
+	identifier, err := url.Parse(input.Identifier)
+	if err != nil || !identifier.IsAbs() || identifier.Fragment != "" {
+		return nil, fmt.Errorf("create resource server %w: identifier must be an absolute URI without fragment", domain.ErrInvalidResourceServer)
+	}
+
+	if err := scopes.ValidateScopes(input.Scopes); err != nil {
+		return nil, fmt.Errorf("create resource server %w: %s", domain.ErrInvalidResourceServer, err)
+	}
+
+	existing, err := s.resourceServerRepo.GetByIdentifier(ctx, input.Identifier)
+	if err != nil && !errors.Is(err, domain.ErrResourceServerNotFound) {
+		return nil, fmt.Errorf("get resource server by identifier: %w", err)
+	}
+
+	if existing != nil {
+		return nil, fmt.Errorf("create resource server: %w", domain.ErrResourceServerAlreadyExists)
+	}
+
+	resourceServer := &entities.ResourceServer{
+		Identifier: input.Identifier,
+		Name:       input.Name,
+		Scopes:     input.Scopes,
+	}
+
+	if err := s.resourceServerRepo.Create(ctx, resourceServer); err != nil {
+		return nil, fmt.Errorf("create resource server: %w", err)
+	}
+
+	return models.ResourceServerToResponse(resourceServer), nil
+}
+
+// GetResourceServers busca os resource servers pedidos; qualquer identificador não
+// registrado resulta em invalid_target.
+func (s *resourceServerService) GetResourceServers(ctx context.Context, identifiers []string) ([]*entities.ResourceServer, error) {
+	resourceServers := make([]*entities.ResourceServer, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		resourceServer, err := s.resourceServerRepo.GetByIdentifier(ctx, identifier)
+		if err != nil {
+			if errors.Is(err, domain.ErrResourceServerNotFound) {
+				return nil, fmt.Errorf("%w: %s", domain.ErrInvalidResource, identifier)
+			}
+
+			return nil, fmt.Errorf("get resource server by identifier: %w", err)
+		}
+
+		resourceServers = append(resourceServers, resourceServer)
+	}
+
+	return resourceServers, nil
+}
+
+// accessTokenTarget restringe o aud e os escopos do access token aos resource servers
+// pedidos (RFC 8707, seção 2.2). Sem resource o token mantém a audiência padrão e
+// todos os escopos concedidos; quando a concessão já tem resources, só eles podem
+// ser pedidos. É o mesmo caminho para o /token e para o token exchange.
+func accessTokenTarget(ctx context.Context, resourceServerService ResourceServerService, requested, granted, grantedScopes []string) ([]string, []string, error) {
+	if len(requested) == 0 {
+		requested = granted
+	}
+
+	if len(requested) == 0 {
+		return nil, grantedScopes, nil
+	}
+
+	for _, resource := range requested {
+		if len(granted) > 0 && !slices.Contains(granted, resource) {
+			return nil, nil, fmt.Errorf("%w: %s", domain.ErrInvalidResource, resource)
+		}
+	}
+
+	resourceServers, err := resourceServerService.GetResourceServers(ctx, requested)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var targetScopes []string
+	for _, resourceServer := range resourceServers {
+		for _, scope := range resourceServer.OwnedScopes(grantedScopes) {
+			if !slices.Contains(targetScopes, scope) {
+				targetScopes = append(targetScopes, scope)
+			}
+		}
+	}
+
+	if len(targetScopes) == 0 {
+		return nil, nil, fmt.Errorf("%w: no granted scope belongs to the requested resource", domain.ErrInvalidScope)
+	}
+
+	return requested, targetScopes, nil
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateResourceServer(t *testing.T) {
+	t.Run("should register the resource server", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewResourceServerRepositoryMock(t)
+		service := NewResourceServerService(mockRepo)
+
+		input := models.CreateResourceServerInput{Identifier: "https://users.aetheris-lab.com", Name: "Users API", Scopes: []string{"users:read"}}
+		mockRepo.EXPECT().GetByIdentifier(ctx, input.Identifier).Return(nil, domain.ErrResourceServerNotFound)
+		mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*entities.ResourceServer")).Return(nil)
+
+		// Act
+		result, err := service.CreateResourceServer(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, input.Identifier, result.Identifier)
+		assert.Equal(t, input.Scopes, result.Scopes)
+	})
+
+	t.Run("should return error when identifier has a fragment", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewResourceServerService(nil)
+
+		// Act
+		result, err := service.CreateResourceServer(ctx, models.CreateResourceServerInput{Identifier: "https://users.aetheris-lab.com#v1", Scopes: []string{"users:read"}})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidResourceServer)
+	})
+
+	t.Run("should return error when scope is not in the catalog", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		service := NewResourceServerService(nil)
+
+		// Act
+		result, err := service.CreateResourceServer(ctx, models.CreateResourceServerInput{Identifier: "https://users.aetheris-lab.com", Scopes: []string{"orders:read"}})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidResourceServer)
+	})
+
+	t.Run("should return error when resource server already exists", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewResourceServerRepositoryMock(t)
+		service := NewResourceServerService(mockRepo)
+
+		input := models.CreateResourceServerInput{Identifier: "https://users.aetheris-lab.com", Name: "Users API", Scopes: []string{"users:read"}}
+		mockRepo.EXPECT().GetByIdentifier(ctx, input.Identifier).Return(&entities.ResourceServer{Identifier: input.Identifier}, nil)
+
+		// Act
+		result, err := service.CreateResourceServer(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrResourceServerAlreadyExists)
+	})
+}
+
+func TestGetResourceServers(t *testing.T) {
+	t.Run("should return invalid resource when an identifier is not registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockRepo := mocks.NewResourceServerRepositoryMock(t)
+		service := NewResourceServerService(mockRepo)
+
+		mockRepo.EXPECT().GetByIdentifier(ctx, "https://unknown.aetheris-lab.com").Return(nil, domain.ErrResourceServerNotFound)
+
+		// Act
+		result, err := service.GetResourceServers(ctx, []string{"https://unknown.aetheris-lab.com"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidResource)
+	})
+}
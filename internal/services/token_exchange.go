@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+)
+
+// TokenExchangeService troca um access token de usuário por outro mais restrito
+// (RFC 8693), para que serviços internos não repassem o token original adiante.
+type TokenExchangeService interface {
+	Exchange(ctx context.Context, input models.TokenExchangeInput) (*models.TokenResponse, error)
+}
+
+type tokenExchangeService struct {
+	clientService          ClientService
+	jwtService             JWTService
+	tokenRevocationService TokenRevocationService
+	resourceServerService  ResourceServerService
+	config                 *configs.Environment
+}
+
+func NewTokenExchangeService(
+	clientService ClientService,
+	jwtService JWTService,
+	tokenRevocationService TokenRevocationService,
+	resourceServerService ResourceServerService,
+	config *configs.Environment,
+) TokenExchangeService {
+	return &tokenExchangeService{
+		clientService:          clientService,
+		jwtService:             jwtService,
+		tokenRevocationService: tokenRevocationService,
+		resourceServerService:  resourceServerService,
+		config:                 config,
+	}
+}
+
+// Exchange emite um access token para o sub do subject_token com audiência e escopos
+// limitados pela política do cliente. Sem actor_token o token é de impersonação; com
+// actor_token, a claim act registra quem age em nome do usuário.
+func (s *tokenExchangeService) Exchange(ctx context.Context, input models.TokenExchangeInput) (*models.TokenResponse, error) {
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if !client.IsConfidential() {
+		return nil, fmt.Errorf("token exchange %w: public clients cannot exchange tokens", domain.ErrUnauthorizedClient)
+	}
+
+	if !client.IsValidGrantType(models.GrantTypeTokenExchange) || client.TokenExchangePolicy == nil {
+		return nil, fmt.Errorf("token exchange %w: client does not support token-exchange grant type", domain.ErrUnauthorizedClient)
+	}
+
+	if input.RequestedTokenType != "" && input.RequestedTokenType != models.TokenTypeAccessToken {
+		return nil, fmt.Errorf("token exchange %w: requested_token_type %s", domain.ErrUnsupportedTokenType, input.RequestedTokenType)
+	}
+
+	policy := client.TokenExchangePolicy
+
+	subject, err := s.validateToken(ctx, input.SubjectToken, input.SubjectTokenType, domain.ErrInvalidSubjectToken)
+	if err != nil {
+		return nil, fmt.Errorf("token exchange: %w", err)
+	}
+
+	if err := requireProofOfPossession(subject, input, domain.ErrInvalidSubjectToken); err != nil {
+		return nil, fmt.Errorf("token exchange: %w", err)
+	}
+
+	// Sem isso, qualquer cliente com token exchange reaproveitaria tokens emitidos a outros clientes.
+	if subject.ClientID != client.ClientID && !slices.Contains(subject.Audience, client.ClientID) && !policy.IsAllowedSubjectAudience(subject.Audience) {
+		return nil, fmt.Errorf("token exchange: %w: issued to another client", domain.ErrInvalidSubjectToken)
+	}
+
+	actor := subject.Act
+	if input.ActorToken != "" {
+		if !policy.AllowDelegation {
+			return nil, fmt.Errorf("token exchange %w: client is not allowed to delegate", domain.ErrUnauthorizedClient)
+		}
+
+		actorClaims, err := s.validateToken(ctx, input.ActorToken, input.ActorTokenType, domain.ErrInvalidActorToken)
+		if err != nil {
+			return nil, fmt.Errorf("token exchange: %w", err)
+		}
+
+		if err := requireProofOfPossession(actorClaims, input, domain.ErrInvalidActorToken); err != nil {
+			return nil, fmt.Errorf("token exchange: %w", err)
+		}
+
+		actor = &models.ActorClaim{
+			Subject:  actorClaims.Subject,
+			ClientID: actorClaims.ClientID,
+			Act:      actorClaims.Act,
+		}
+	}
+
+	requestedAudience := append(slices.Clone(input.Audience), input.Resource...)
+	for _, requested := range requestedAudience {
+		if !policy.IsAllowedAudience(requested) {
+			return nil, fmt.Errorf("token exchange %w: %s", domain.ErrInvalidTarget, requested)
+		}
+	}
+
+	audience := policy.Audiences
+	if len(requestedAudience) > 0 {
+		audience = requestedAudience
+	}
+
+	grantedScopes := policy.AllowedScopes(scopes.ParseScopes(subject.Scope))
+	if len(input.Scope) > 0 {
+		if !scopes.HasAllScopes(grantedScopes, input.Scope) {
+			return nil, fmt.Errorf("token exchange %w: requested scope exceeds the subject token or the client policy", domain.ErrInvalidScope)
+		}
+
+		grantedScopes = input.Scope
+	}
+
+	// resource segue o mesmo registro do /authorize e do /token: o token leva apenas
+	// os escopos que pertencem aos resource servers pedidos.
+	if len(input.Resource) > 0 {
+		_, grantedScopes, err = accessTokenTarget(ctx, s.resourceServerService, input.Resource, nil, grantedScopes)
+		if err != nil {
+			return nil, fmt.Errorf("token exchange: %w", err)
+		}
+	}
+
+	// O token trocado nunca vive mais que o subject token.
+	accessTokenExpiresAt := time.Now().Add(s.config.Security.AccessTokenExpirationHours)
+	if subject.ExpiresAt != nil && subject.ExpiresAt.Before(accessTokenExpiresAt) {
+		accessTokenExpiresAt = subject.ExpiresAt.Time
+	}
+
+	// Um subject token vinculado continua vinculado à mesma chave ou certificado.
+	certificateThumbprint := input.CertificateThumbprint
+	if subject.Cnf != nil && subject.Cnf.X5TS256 != "" {
+		certificateThumbprint = subject.Cnf.X5TS256
+	}
+
+	accessToken, err := s.jwtService.GenerateAccessTokenJWT(ctx, models.AccessTokenInput{
+		UserID:                subject.Subject,
+		ClientID:              client.ClientID,
+		Scopes:                grantedScopes,
+		Audience:              audience,
+		Actor:                 actor,
+		ExpiresAt:             accessTokenExpiresAt,
+		DPoPJKT:               input.DPoPJKT,
+		CertificateThumbprint: certificateThumbprint,
+		SubjectType:           subject.SubjectType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	return &models.TokenResponse{
+		AccessToken:     accessToken,
+		IssuedTokenType: models.TokenTypeAccessToken,
+		TokenType:       accessTokenType(input.DPoPJKT),
+		ExpiresIn:       int64(time.Until(accessTokenExpiresAt).Seconds()),
+	}, nil
+}
+
+// validateToken aceita apenas access tokens emitidos por este servidor e ainda não
+// revogados; tokens recusados são reportados com invalidErr.
+func (s *tokenExchangeService) validateToken(ctx context.Context, token, tokenType string, invalidErr error) (models.AccessTokenClaims, error) {
+	if tokenType != models.TokenTypeAccessToken && tokenType != models.TokenTypeJWT {
+		return models.AccessTokenClaims{}, fmt.Errorf("%w: %w %s", invalidErr, domain.ErrUnsupportedTokenType, tokenType)
+	}
+
+	claims, err := s.jwtService.ValidateAccessTokenJWT(ctx, token)
+	if err != nil {
+		return models.AccessTokenClaims{}, fmt.Errorf("%w: %s", invalidErr, err)
+	}
+
+	// ID tokens e tokens de OTP usam as mesmas chaves, mas não carregam client_id.
+	if claims.ClientID == "" {
+		return models.AccessTokenClaims{}, fmt.Errorf("%w: not an access token", invalidErr)
+	}
+
+	revoked, err := s.tokenRevocationService.IsAccessTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		return models.AccessTokenClaims{}, fmt.Errorf("is access token revoked: %w", err)
+	}
+
+	if revoked {
+		return models.AccessTokenClaims{}, fmt.Errorf("%w: %w", invalidErr, domain.ErrAccessTokenRevoked)
+	}
+
+	return claims, nil
+}
+
+// requireProofOfPossession exige, para tokens com cnf, a prova DPoP da mesma chave ou o
+// mesmo certificado mTLS na requisição; sem isso um token vinculado viraria bearer.
+func requireProofOfPossession(claims models.AccessTokenClaims, input models.TokenExchangeInput, invalidErr error) error {
+	if claims.Cnf == nil {
+		return nil
+	}
+
+	if claims.Cnf.JKT != "" && claims.Cnf.JKT != input.DPoPJKT {
+		return fmt.Errorf("%w: %w", invalidErr, domain.ErrDPoPKeyMismatch)
+	}
+
+	if claims.Cnf.X5TS256 != "" && claims.Cnf.X5TS256 != input.PresentedCertificateThumbprint {
+		return fmt.Errorf("%w: bound to another client certificate", invalidErr)
+	}
+
+	return nil
+}
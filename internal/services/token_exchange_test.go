@@ -0,0 +1,508 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenExchange(t *testing.T) {
+	config := &configs.Environment{Security: configs.Security{AccessTokenExpirationHours: time.Hour}}
+
+	client := &entities.Client{
+		ClientID:                "orders-api",
+		TokenEndpointAuthMethod: "client_secret_basic",
+		GrantTypes:              []string{"urn:ietf:params:oauth:grant-type:token-exchange"},
+		TokenExchangePolicy: &entities.TokenExchangePolicy{
+			Audiences:        []string{"https://payments.internal"},
+			Scopes:           []string{"payments:read"},
+			AllowDelegation:  true,
+			SubjectAudiences: []string{"https://orders.internal"},
+		},
+	}
+
+	subjectClaims := models.AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "subject-jti",
+			Subject:   "user-id",
+			Audience:  jwt.ClaimStrings{"https://orders.internal"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		},
+		ClientID: "web-app",
+		Scope:    "openid payments:read payments:write",
+	}
+
+	newInput := func() models.TokenExchangeInput {
+		return models.TokenExchangeInput{
+			ClientID:         "orders-api",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: models.TokenTypeAccessToken,
+		}
+	}
+
+	t.Run("should issue a narrower token for the allowed audience", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "user-id" &&
+				input.ClientID == "orders-api" &&
+				assert.ObjectsAreEqual([]string{"payments:read"}, input.Scopes) &&
+				assert.ObjectsAreEqual([]string{"https://payments.internal"}, input.Audience) &&
+				input.Actor == nil &&
+				!input.ExpiresAt.After(subjectClaims.ExpiresAt.Time)
+		})).Return("exchanged-token", nil)
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "exchanged-token", result.AccessToken)
+		assert.Equal(t, models.TokenTypeAccessToken, result.IssuedTokenType)
+		assert.Empty(t, result.RefreshToken)
+	})
+
+	t.Run("should add the act claim when an actor token is presented", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		actorClaims := models.AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{ID: "actor-jti", Subject: "orders-api"},
+			ClientID:         "orders-api",
+		}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "actor-token").Return(actorClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, mock.Anything).Return(false, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.Actor != nil && input.Actor.Subject == "orders-api" && input.UserID == "user-id"
+		})).Return("delegated-token", nil)
+
+		input := newInput()
+		input.ActorToken = "actor-token"
+		input.ActorTokenType = models.TokenTypeAccessToken
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "delegated-token", result.AccessToken)
+	})
+
+	t.Run("should keep the client subject marker of a client_credentials subject token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		machineClaims := subjectClaims
+		machineClaims.Subject = "batch-job"
+		machineClaims.ClientID = "batch-job"
+		machineClaims.SubjectType = models.SubjectTypeClient
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(machineClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.UserID == "batch-job" && input.SubjectType == models.SubjectTypeClient
+		})).Return("exchanged-token", nil)
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "exchanged-token", result.AccessToken)
+	})
+
+	t.Run("should reject a DPoP bound subject token without a proof of the same key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		boundClaims := subjectClaims
+		boundClaims.Cnf = &models.ConfirmationClaim{JKT: "bound-jkt"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(boundClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+		assert.ErrorIs(t, err, domain.ErrDPoPKeyMismatch)
+	})
+
+	t.Run("should keep the DPoP binding when the proof uses the bound key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		boundClaims := subjectClaims
+		boundClaims.Cnf = &models.ConfirmationClaim{JKT: "bound-jkt"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(boundClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.DPoPJKT == "bound-jkt"
+		})).Return("exchanged-token", nil)
+
+		input := newInput()
+		input.DPoPJKT = "bound-jkt"
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, models.TokenTypeDPoP, result.TokenType)
+	})
+
+	t.Run("should reject a certificate bound subject token presented without that certificate", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		boundClaims := subjectClaims
+		boundClaims.Cnf = &models.ConfirmationClaim{X5TS256: "bound-thumbprint"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(boundClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+
+		input := newInput()
+		input.PresentedCertificateThumbprint = "other-thumbprint"
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should keep the certificate binding of the subject token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		boundClaims := subjectClaims
+		boundClaims.Cnf = &models.ConfirmationClaim{X5TS256: "bound-thumbprint"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(boundClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return input.CertificateThumbprint == "bound-thumbprint"
+		})).Return("exchanged-token", nil)
+
+		input := newInput()
+		input.PresentedCertificateThumbprint = "bound-thumbprint"
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "exchanged-token", result.AccessToken)
+	})
+
+	t.Run("should reject a DPoP bound actor token without a proof of the same key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		actorClaims := models.AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{ID: "actor-jti", Subject: "orders-api"},
+			ClientID:         "orders-api",
+			Cnf:              &models.ConfirmationClaim{JKT: "actor-jkt"},
+		}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "actor-token").Return(actorClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, mock.Anything).Return(false, nil)
+
+		input := newInput()
+		input.ActorToken = "actor-token"
+		input.ActorTokenType = models.TokenTypeAccessToken
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidActorToken)
+	})
+
+	t.Run("should narrow the scopes to the resource server requested in resource", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		mockResourceServerService := mocks.NewResourceServerServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, mockResourceServerService, config)
+
+		unrestrictedClient := *client
+		unrestrictedClient.TokenExchangePolicy = &entities.TokenExchangePolicy{
+			Audiences:        []string{"https://payments.internal"},
+			SubjectAudiences: []string{"https://orders.internal"},
+		}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(&unrestrictedClient, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+		mockResourceServerService.EXPECT().GetResourceServers(ctx, []string{"https://payments.internal"}).Return([]*entities.ResourceServer{
+			{Identifier: "https://payments.internal", Scopes: []string{"payments:read"}},
+		}, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.MatchedBy(func(input models.AccessTokenInput) bool {
+			return assert.ObjectsAreEqual([]string{"payments:read"}, input.Scopes) &&
+				assert.ObjectsAreEqual([]string{"https://payments.internal"}, input.Audience)
+		})).Return("exchanged-token", nil)
+
+		input := newInput()
+		input.Resource = []string{"https://payments.internal"}
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "exchanged-token", result.AccessToken)
+	})
+
+	t.Run("should return invalid_target when resource is not registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		mockResourceServerService := mocks.NewResourceServerServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, mockResourceServerService, config)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+		mockResourceServerService.EXPECT().GetResourceServers(ctx, []string{"https://payments.internal"}).
+			Return(nil, domain.ErrInvalidResource)
+
+		input := newInput()
+		input.Resource = []string{"https://payments.internal"}
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidResource)
+	})
+
+	t.Run("should return invalid_target when audience is not in the policy", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+
+		input := newInput()
+		input.Audience = []string{"https://admin.internal"}
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidTarget)
+	})
+
+	t.Run("should return invalid_scope when scope exceeds the policy", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+
+		input := newInput()
+		input.Scope = []string{"payments:write"}
+
+		// Act
+		result, err := service.Exchange(ctx, input)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("should exchange a token issued to the exchanging client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		ownClaims := subjectClaims
+		ownClaims.ClientID = "orders-api"
+		ownClaims.Audience = jwt.ClaimStrings{"https://app.aetheris-lab.com"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(ownClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+		mockJWTService.EXPECT().GenerateAccessTokenJWT(ctx, mock.AnythingOfType("models.AccessTokenInput")).Return("exchanged-token", nil)
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "exchanged-token", result.AccessToken)
+	})
+
+	t.Run("should return invalid subject token when it was issued to another client for another audience", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		foreignClaims := subjectClaims
+		foreignClaims.Audience = jwt.ClaimStrings{"https://app.aetheris-lab.com"}
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(foreignClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(false, nil)
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should return invalid subject token when it was revoked", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, mockRevocationService, nil, config)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(subjectClaims, nil)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "subject-jti").Return(true, nil)
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should return invalid subject token when it cannot be validated", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, mockJWTService, nil, nil, config)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(client, nil)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "subject-token").Return(models.AccessTokenClaims{}, errors.New("token is expired"))
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should return error when client has no token exchange policy", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockClientService := mocks.NewClientServiceMock(t)
+		service := NewTokenExchangeService(mockClientService, nil, nil, nil, config)
+
+		mockClientService.EXPECT().GetClientByClientID(ctx, "orders-api").Return(&entities.Client{
+			ClientID:                "orders-api",
+			TokenEndpointAuthMethod: "client_secret_basic",
+			GrantTypes:              []string{"urn:ietf:params:oauth:grant-type:token-exchange"},
+		}, nil)
+
+		// Act
+		result, err := service.Exchange(ctx, newInput())
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+)
+
+type TokenIntrospectionService interface {
+	Introspect(ctx context.Context, input models.IntrospectTokenInput) (*models.IntrospectionResponse, error)
+}
+
+type tokenIntrospectionService struct {
+	jwtService             JWTService
+	tokenRevocationService TokenRevocationService
+	refreshTokenRepo       repositories.RefreshTokenRepository
+}
+
+func NewTokenIntrospectionService(
+	jwtService JWTService,
+	tokenRevocationService TokenRevocationService,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+) TokenIntrospectionService {
+	return &tokenIntrospectionService{
+		jwtService:             jwtService,
+		tokenRevocationService: tokenRevocationService,
+		refreshTokenRepo:       refreshTokenRepo,
+	}
+}
+
+// Introspect usa o token_type_hint apenas para decidir a ordem das buscas; um
+// hint incorreto não impede que o token seja encontrado (RFC 7662, seção 2.1).
+func (s *tokenIntrospectionService) Introspect(ctx context.Context, input models.IntrospectTokenInput) (*models.IntrospectionResponse, error) {
+	lookups := []func(context.Context, string) (*models.IntrospectionResponse, error){
+		s.introspectAccessToken,
+		s.introspectRefreshToken,
+	}
+	if input.TokenTypeHint == models.TokenTypeHintRefreshToken {
+		lookups[0], lookups[1] = lookups[1], lookups[0]
+	}
+
+	for _, lookup := range lookups {
+		response, err := lookup(ctx, input.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		if response != nil {
+			return response, nil
+		}
+	}
+
+	return &models.IntrospectionResponse{Active: false}, nil
+}
+
+// introspectAccessToken retorna nil quando o token não é um access token ativo.
+func (s *tokenIntrospectionService) introspectAccessToken(ctx context.Context, token string) (*models.IntrospectionResponse, error) {
+	claims, err := s.jwtService.ValidateAccessTokenJWT(ctx, token)
+	if err != nil {
+		return nil, nil
+	}
+
+	// ID tokens e tokens de OTP são assinados com as mesmas chaves, mas não
+	// carregam client_id e não devem ser reportados como access tokens.
+	if claims.ClientID == "" {
+		return nil, nil
+	}
+
+	revoked, err := s.tokenRevocationService.IsAccessTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("is access token revoked: %w", err)
+	}
+
+	if revoked {
+		return nil, nil
+	}
+
+	response := &models.IntrospectionResponse{
+		Active:    true,
+		Scope:     claims.Scope,
+		ClientID:  claims.ClientID,
+		TokenType: claims.TokenType,
+		Sub:       claims.Subject,
+		Aud:       claims.Audience,
+		Iss:       claims.Issuer,
+		Jti:       claims.ID,
+		Act:       claims.Act,
+		Cnf:       claims.Cnf,
+	}
+
+	if claims.ExpiresAt != nil {
+		response.Exp = claims.ExpiresAt.Unix()
+	}
+
+	if claims.IssuedAt != nil {
+		response.Iat = claims.IssuedAt.Unix()
+	}
+
+	if claims.NotBefore != nil {
+		response.Nbf = claims.NotBefore.Unix()
+	}
+
+	return response, nil
+}
+
+// introspectRefreshToken consulta o repositório diretamente: ao contrário de
+// ValidateRefreshToken, apresentar um token já revogado aqui não é um replay e
+// não deve revogar a família.
+func (s *tokenIntrospectionService) introspectRefreshToken(ctx context.Context, token string) (*models.IntrospectionResponse, error) {
+	refreshToken, err := s.refreshTokenRepo.FindByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("find refresh token by hash: %w", err)
+	}
+
+	if refreshToken.IsRevoked() || refreshToken.IsExpired() {
+		return nil, nil
+	}
+
+	return &models.IntrospectionResponse{
+		Active:    true,
+		Scope:     scopes.JoinScopes(refreshToken.Scopes),
+		ClientID:  refreshToken.ClientID,
+		TokenType: models.TokenTypeHintRefreshToken,
+		Exp:       refreshToken.ExpiresAt.Unix(),
+		Iat:       refreshToken.CreatedAt.Unix(),
+		Sub:       refreshToken.UserID,
+	}, nil
+}
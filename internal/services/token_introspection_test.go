@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntrospect(t *testing.T) {
+	t.Run("should return active access token metadata", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		claims := models.AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti",
+				Subject:   "user-id",
+				Issuer:    "https://id.example.com",
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+			TokenType: "Bearer",
+			ClientID:  "client-id",
+			Scope:     "openid profile:read",
+		}
+
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "access-token").Return(claims, nil)
+
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "jti").Return(false, nil)
+
+		service := NewTokenIntrospectionService(mockJWTService, mockRevocationService, nil)
+
+		// Act
+		result, err := service.Introspect(ctx, models.IntrospectTokenInput{Token: "access-token"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.Active)
+		assert.Equal(t, "client-id", result.ClientID)
+		assert.Equal(t, "user-id", result.Sub)
+		assert.Equal(t, "openid profile:read", result.Scope)
+		assert.Equal(t, "Bearer", result.TokenType)
+		assert.Equal(t, expiresAt.Unix(), result.Exp)
+	})
+
+	t.Run("should report revoked access token as inactive", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		claims := models.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{ID: "jti"}, ClientID: "client-id"}
+
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "access-token").Return(claims, nil)
+
+		mockRevocationService := mocks.NewTokenRevocationServiceMock(t)
+		mockRevocationService.EXPECT().IsAccessTokenRevoked(ctx, "jti").Return(true, nil)
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("access-token")).Return(nil, domain.ErrRefreshTokenNotFound)
+
+		service := NewTokenIntrospectionService(mockJWTService, mockRevocationService, mockRepo)
+
+		// Act
+		result, err := service.Introspect(ctx, models.IntrospectTokenInput{Token: "access-token"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &models.IntrospectionResponse{Active: false}, result)
+	})
+
+	t.Run("should not report id tokens as access tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "id-token").Return(models.AccessTokenClaims{TokenType: "Bearer"}, nil)
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("id-token")).Return(nil, domain.ErrRefreshTokenNotFound)
+
+		service := NewTokenIntrospectionService(mockJWTService, nil, mockRepo)
+
+		// Act
+		result, err := service.Introspect(ctx, models.IntrospectTokenInput{Token: "id-token"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, result.Active)
+	})
+
+	t.Run("should look up refresh token first when hinted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{
+			UserID:    "user-id",
+			ClientID:  "client-id",
+			Scopes:    []string{"openid"},
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedAt: time.Now(),
+		}
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+
+		service := NewTokenIntrospectionService(mocks.NewJWTServiceMock(t), nil, mockRepo)
+
+		// Act
+		result, err := service.Introspect(ctx, models.IntrospectTokenInput{Token: "refresh-token", TokenTypeHint: "refresh_token"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.Active)
+		assert.Equal(t, "refresh_token", result.TokenType)
+		assert.Equal(t, "user-id", result.Sub)
+		assert.Equal(t, "openid", result.Scope)
+	})
+
+	t.Run("should report revoked refresh token as inactive without revoking the family", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		revokedAt := time.Now().Add(-time.Minute)
+		refreshToken := &entities.RefreshToken{FamilyID: "family-id", ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}
+
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "refresh-token").Return(models.AccessTokenClaims{}, errors.New("token is malformed"))
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+
+		service := NewTokenIntrospectionService(mockJWTService, nil, mockRepo)
+
+		// Act
+		result, err := service.Introspect(ctx, models.IntrospectTokenInput{Token: "refresh-token"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, result.Active)
+	})
+
+	t.Run("should return error when repository fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(nil, errors.New("database error"))
+
+		service := NewTokenIntrospectionService(nil, nil, mockRepo)
+
+		// Act
+		result, err := service.Introspect(ctx, models.IntrospectTokenInput{Token: "refresh-token", TokenTypeHint: "refresh_token"})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "find refresh token by hash")
+	})
+}
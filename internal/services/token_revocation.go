@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+)
+
+type TokenRevocationService interface {
+	RevokeToken(ctx context.Context, input models.RevokeTokenInput) error
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+type tokenRevocationService struct {
+	revokedTokenRepo repositories.RevokedTokenRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	jwtService       JWTService
+}
+
+func NewTokenRevocationService(
+	revokedTokenRepo repositories.RevokedTokenRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	jwtService JWTService,
+) TokenRevocationService {
+	return &tokenRevocationService{
+		revokedTokenRepo: revokedTokenRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		jwtService:       jwtService,
+	}
+}
+
+// RevokeToken implementa a RFC 7009. Tokens inválidos, expirados ou desconhecidos
+// não geram erro, pois o objetivo do cliente (invalidar o token) já foi atingido.
+func (s *tokenRevocationService) RevokeToken(ctx context.Context, input models.RevokeTokenInput) error {
+	revokers := []func(context.Context, models.RevokeTokenInput) (bool, error){
+		s.revokeAccessToken,
+		s.revokeRefreshToken,
+	}
+	if input.TokenTypeHint == models.TokenTypeHintRefreshToken {
+		revokers[0], revokers[1] = revokers[1], revokers[0]
+	}
+
+	for _, revoke := range revokers {
+		found, err := revoke(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (s *tokenRevocationService) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	revokedToken := &entities.RevokedToken{
+		JTI:       jti,
+		ExpiresAt: expiresAt,
+	}
+
+	if err := s.revokedTokenRepo.Create(ctx, revokedToken); err != nil {
+		return fmt.Errorf("create revoked token: %w", err)
+	}
+
+	return nil
+}
+
+func (s *tokenRevocationService) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	revoked, err := s.revokedTokenRepo.ExistsByJTI(ctx, jti)
+	if err != nil {
+		return false, fmt.Errorf("exists revoked token by jti: %w", err)
+	}
+
+	return revoked, nil
+}
+
+// revokeAccessToken retorna false quando o token não é um access token válido.
+func (s *tokenRevocationService) revokeAccessToken(ctx context.Context, input models.RevokeTokenInput) (bool, error) {
+	claims, err := s.jwtService.ValidateAccessTokenJWT(ctx, input.Token)
+	if err != nil || claims.ClientID == "" {
+		return false, nil
+	}
+
+	if claims.ClientID != input.ClientID {
+		return false, fmt.Errorf("revoke access token %w: %s", domain.ErrClientMismatch, input.ClientID)
+	}
+
+	var expiresAt time.Time
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	if err := s.RevokeAccessToken(ctx, claims.ID, expiresAt); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// revokeRefreshToken retorna false quando o token não é um refresh token conhecido.
+func (s *tokenRevocationService) revokeRefreshToken(ctx context.Context, input models.RevokeTokenInput) (bool, error) {
+	refreshToken, err := s.refreshTokenRepo.FindByTokenHash(ctx, hashToken(input.Token))
+	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("find refresh token by hash: %w", err)
+	}
+
+	if !refreshToken.IsValidClientID(input.ClientID) {
+		return false, fmt.Errorf("revoke refresh token %w: %s", domain.ErrClientMismatch, input.ClientID)
+	}
+
+	if err := s.refreshTokenRepo.Revoke(ctx, refreshToken.ID.Hex()); err != nil && !errors.Is(err, domain.ErrRefreshTokenRevoked) {
+		return false, fmt.Errorf("revoke refresh token: %w", err)
+	}
+
+	return true, nil
+}
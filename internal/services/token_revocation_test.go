@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("should add the access token jti to the revocation list", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		claims := models.AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{ID: "jti", ExpiresAt: jwt.NewNumericDate(expiresAt)},
+			ClientID:         "client-id",
+		}
+
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "access-token").Return(claims, nil)
+
+		mockRevokedTokenRepo := mocks.NewRevokedTokenRepositoryMock(t)
+		mockRevokedTokenRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(revokedToken *entities.RevokedToken) bool {
+				return revokedToken.JTI == "jti" && revokedToken.ExpiresAt.Equal(expiresAt)
+			})).
+			Return(nil)
+
+		service := NewTokenRevocationService(mockRevokedTokenRepo, nil, mockJWTService)
+
+		// Act
+		err := service.RevokeToken(ctx, models.RevokeTokenInput{Token: "access-token", ClientID: "client-id"})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should set revoked_at on the refresh token when hinted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{ID: primitive.NewObjectID(), ClientID: "client-id"}
+
+		mockRefreshTokenRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRefreshTokenRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+		mockRefreshTokenRepo.EXPECT().Revoke(ctx, refreshToken.ID.Hex()).Return(nil)
+
+		service := NewTokenRevocationService(nil, mockRefreshTokenRepo, mocks.NewJWTServiceMock(t))
+
+		// Act
+		err := service.RevokeToken(ctx, models.RevokeTokenInput{Token: "refresh-token", TokenTypeHint: "refresh_token", ClientID: "client-id"})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should succeed when refresh token was already revoked", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{ID: primitive.NewObjectID(), ClientID: "client-id"}
+
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "refresh-token").Return(models.AccessTokenClaims{}, errors.New("token is malformed"))
+
+		mockRefreshTokenRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRefreshTokenRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+		mockRefreshTokenRepo.EXPECT().Revoke(ctx, refreshToken.ID.Hex()).Return(domain.ErrRefreshTokenRevoked)
+
+		service := NewTokenRevocationService(nil, mockRefreshTokenRepo, mockJWTService)
+
+		// Act
+		err := service.RevokeToken(ctx, models.RevokeTokenInput{Token: "refresh-token", ClientID: "client-id"})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should succeed without changes when token is unknown", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		mockJWTService.EXPECT().ValidateAccessTokenJWT(ctx, "unknown").Return(models.AccessTokenClaims{}, errors.New("token is malformed"))
+
+		mockRefreshTokenRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRefreshTokenRepo.EXPECT().FindByTokenHash(ctx, hashToken("unknown")).Return(nil, domain.ErrRefreshTokenNotFound)
+
+		service := NewTokenRevocationService(nil, mockRefreshTokenRepo, mockJWTService)
+
+		// Act
+		err := service.RevokeToken(ctx, models.RevokeTokenInput{Token: "unknown", ClientID: "client-id"})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should refuse to revoke a token issued to another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		refreshToken := &entities.RefreshToken{ID: primitive.NewObjectID(), ClientID: "other-client"}
+
+		mockRefreshTokenRepo := mocks.NewRefreshTokenRepositoryMock(t)
+		mockRefreshTokenRepo.EXPECT().FindByTokenHash(ctx, hashToken("refresh-token")).Return(refreshToken, nil)
+
+		service := NewTokenRevocationService(nil, mockRefreshTokenRepo, nil)
+
+		// Act
+		err := service.RevokeToken(ctx, models.RevokeTokenInput{Token: "refresh-token", TokenTypeHint: "refresh_token", ClientID: "client-id"})
+
+		// Assert
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrClientMismatch)
+	})
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	t.Run("should store revoked jti until the token expires", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		expiresAt := time.Now().Add(time.Hour)
+
+		mockRepo := mocks.NewRevokedTokenRepositoryMock(t)
+		mockRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(revokedToken *entities.RevokedToken) bool {
+				return revokedToken.JTI == "jti" && revokedToken.ExpiresAt.Equal(expiresAt)
+			})).
+			Return(nil)
+
+		service := NewTokenRevocationService(mockRepo, nil, nil)
+
+		// Act
+		err := service.RevokeAccessToken(ctx, "jti", expiresAt)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when repository fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewRevokedTokenRepositoryMock(t)
+		mockRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*entities.RevokedToken")).
+			Return(errors.New("database error"))
+
+		service := NewTokenRevocationService(mockRepo, nil, nil)
+
+		// Act
+		err := service.RevokeAccessToken(ctx, "jti", time.Now())
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "create revoked token")
+	})
+}
+
+func TestIsAccessTokenRevoked(t *testing.T) {
+	t.Run("should return true when jti is revoked", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewRevokedTokenRepositoryMock(t)
+		mockRepo.EXPECT().ExistsByJTI(ctx, "jti").Return(true, nil)
+
+		service := NewTokenRevocationService(mockRepo, nil, nil)
+
+		// Act
+		revoked, err := service.IsAccessTokenRevoked(ctx, "jti")
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should return error when repository fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockRepo := mocks.NewRevokedTokenRepositoryMock(t)
+		mockRepo.EXPECT().ExistsByJTI(ctx, "jti").Return(false, errors.New("database error"))
+
+		service := NewTokenRevocationService(mockRepo, nil, nil)
+
+		// Act
+		revoked, err := service.IsAccessTokenRevoked(ctx, "jti")
+
+		// Assert
+		require.Error(t, err)
+		assert.False(t, revoked)
+	})
+}
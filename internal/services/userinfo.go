@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/scopes"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/internal/repositories"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type UserInfoService interface {
+	GetUserInfo(ctx context.Context, input models.UserInfoInput) (*models.UserInfoResponse, error)
+}
+
+type userInfoService struct {
+	userRepo      repositories.UserRepository
+	clientService ClientService
+	jwtService    JWTService
+}
+
+func NewUserInfoService(userRepo repositories.UserRepository, clientService ClientService, jwtService JWTService) UserInfoService {
+	return &userInfoService{
+		userRepo:      userRepo,
+		clientService: clientService,
+		jwtService:    jwtService,
+	}
+}
+
+// GetUserInfo devolve as claims do usuário liberadas pelos escopos do access token
+// (OIDC Core, seção 5.4). O sub é sempre retornado.
+func (s *userInfoService) GetUserInfo(ctx context.Context, input models.UserInfoInput) (*models.UserInfoResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user by id: %w", err)
+	}
+
+	claims := userClaims(user, input.Scopes)
+	claims.Subject = input.UserID
+
+	client, err := s.clientService.GetClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client by client_id: %w", err)
+	}
+
+	if client.UserinfoSignedResponseAlg == "" {
+		return &models.UserInfoResponse{Claims: &claims}, nil
+	}
+
+	claims.Audience = jwt.ClaimStrings{client.ClientID}
+	signed, err := s.jwtService.GenerateUserInfoJWT(ctx, client.UserinfoSignedResponseAlg, claims)
+	if err != nil {
+		return nil, fmt.Errorf("generate userinfo jwt: %w", err)
+	}
+
+	return &models.UserInfoResponse{JWT: signed}, nil
+}
+
+// userClaims preenche as claims de perfil e de email liberadas pelos escopos
+// (OIDC Core, seção 5.4); é usada no UserInfo e no ID token.
+func userClaims(user *entities.User, grantedScopes []string) models.UserInfoClaims {
+	var claims models.UserInfoClaims
+
+	if scopes.HasScope(grantedScopes, "profile") || scopes.HasScope(grantedScopes, "profile:read") {
+		claims.Name = user.GetFullName()
+		claims.GivenName = user.FirstName
+		claims.FamilyName = user.LastName
+	}
+
+	if scopes.HasScope(grantedScopes, "email") {
+		// O login é feito por código enviado ao email, então todo usuário com
+		// access token já comprovou o endereço.
+		emailVerified := true
+		claims.Email = user.Email
+		claims.EmailVerified = &emailVerified
+	}
+
+	return claims
+}
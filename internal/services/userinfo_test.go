@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain"
+	"github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	"github.com/aetheris-lab/aetheris-id/api/internal/models"
+	"github.com/aetheris-lab/aetheris-id/api/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserInfo(t *testing.T) {
+	user := &entities.User{FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}
+
+	t.Run("should return only the claims released by the token scopes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockClientService := mocks.NewClientServiceMock(t)
+		service := NewUserInfoService(mockUserRepo, mockClientService, nil)
+
+		mockUserRepo.EXPECT().FindByID(ctx, "user-id").Return(user, nil)
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(&entities.Client{ClientID: "web-app"}, nil)
+
+		// Act
+		result, err := service.GetUserInfo(ctx, models.UserInfoInput{UserID: "user-id", ClientID: "web-app", Scopes: []string{"openid", "profile"}})
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, result.Claims)
+		assert.Empty(t, result.JWT)
+		assert.Equal(t, "user-id", result.Claims.Subject)
+		assert.Equal(t, "Ada Lovelace", result.Claims.Name)
+		assert.Equal(t, "Ada", result.Claims.GivenName)
+		assert.Equal(t, "Lovelace", result.Claims.FamilyName)
+		assert.Empty(t, result.Claims.Email)
+		assert.Nil(t, result.Claims.EmailVerified)
+	})
+
+	t.Run("should return the email when the email scope was granted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockClientService := mocks.NewClientServiceMock(t)
+		service := NewUserInfoService(mockUserRepo, mockClientService, nil)
+
+		mockUserRepo.EXPECT().FindByID(ctx, "user-id").Return(user, nil)
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(&entities.Client{ClientID: "web-app"}, nil)
+
+		// Act
+		result, err := service.GetUserInfo(ctx, models.UserInfoInput{UserID: "user-id", ClientID: "web-app", Scopes: []string{"openid", "email"}})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, result.Claims.Name)
+		assert.Equal(t, "ada@example.com", result.Claims.Email)
+		require.NotNil(t, result.Claims.EmailVerified)
+		assert.True(t, *result.Claims.EmailVerified)
+	})
+
+	t.Run("should sign the response when the client registered an algorithm", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockJWTService := mocks.NewJWTServiceMock(t)
+		service := NewUserInfoService(mockUserRepo, mockClientService, mockJWTService)
+
+		mockUserRepo.EXPECT().FindByID(ctx, "user-id").Return(user, nil)
+		mockClientService.EXPECT().GetClientByClientID(ctx, "web-app").Return(&entities.Client{ClientID: "web-app", UserinfoSignedResponseAlg: "RS256"}, nil)
+		mockJWTService.EXPECT().GenerateUserInfoJWT(ctx, "RS256", mock.MatchedBy(func(claims models.UserInfoClaims) bool {
+			return claims.Subject == "user-id" && assert.ObjectsAreEqual(jwt.ClaimStrings{"web-app"}, claims.Audience)
+		})).Return("signed-userinfo", nil)
+
+		// Act
+		result, err := service.GetUserInfo(ctx, models.UserInfoInput{UserID: "user-id", ClientID: "web-app", Scopes: []string{"openid"}})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "signed-userinfo", result.JWT)
+		assert.Nil(t, result.Claims)
+	})
+
+	t.Run("should return error when user is not found", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		service := NewUserInfoService(mockUserRepo, nil, nil)
+
+		mockUserRepo.EXPECT().FindByID(ctx, "batch-job").Return(nil, domain.ErrInvalidObjectID)
+
+		// Act
+		result, err := service.GetUserInfo(ctx, models.UserInfoInput{UserID: "batch-job", ClientID: "batch-job", Scopes: []string{"openid"}})
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidObjectID)
+	})
+}
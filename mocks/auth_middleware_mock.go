@@ -115,6 +115,54 @@ func (_c *AuthMiddlewareMock_EnsureAuthenticated_Call) RunAndReturn(run func() e
 	return _c
 }
 
+// EnsureBearerScope provides a mock function with given fields: scope
+func (_m *AuthMiddlewareMock) EnsureBearerScope(scope string) echo.MiddlewareFunc {
+	ret := _m.Called(scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureBearerScope")
+	}
+
+	var r0 echo.MiddlewareFunc
+	if rf, ok := ret.Get(0).(func(string) echo.MiddlewareFunc); ok {
+		r0 = rf(scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(echo.MiddlewareFunc)
+		}
+	}
+
+	return r0
+}
+
+// AuthMiddlewareMock_EnsureBearerScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureBearerScope'
+type AuthMiddlewareMock_EnsureBearerScope_Call struct {
+	*mock.Call
+}
+
+// EnsureBearerScope is a helper method to define mock.On call
+//   - scope string
+func (_e *AuthMiddlewareMock_Expecter) EnsureBearerScope(scope interface{}) *AuthMiddlewareMock_EnsureBearerScope_Call {
+	return &AuthMiddlewareMock_EnsureBearerScope_Call{Call: _e.mock.On("EnsureBearerScope", scope)}
+}
+
+func (_c *AuthMiddlewareMock_EnsureBearerScope_Call) Run(run func(scope string)) *AuthMiddlewareMock_EnsureBearerScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AuthMiddlewareMock_EnsureBearerScope_Call) Return(_a0 echo.MiddlewareFunc) *AuthMiddlewareMock_EnsureBearerScope_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthMiddlewareMock_EnsureBearerScope_Call) RunAndReturn(run func(string) echo.MiddlewareFunc) *AuthMiddlewareMock_EnsureBearerScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnsureOTPAuthenticated provides a mock function with no fields
 func (_m *AuthMiddlewareMock) EnsureOTPAuthenticated() echo.MiddlewareFunc {
 	ret := _m.Called()
@@ -162,6 +210,53 @@ func (_c *AuthMiddlewareMock_EnsureOTPAuthenticated_Call) RunAndReturn(run func(
 	return _c
 }
 
+// RequireDPoP provides a mock function with no fields
+func (_m *AuthMiddlewareMock) RequireDPoP() echo.MiddlewareFunc {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequireDPoP")
+	}
+
+	var r0 echo.MiddlewareFunc
+	if rf, ok := ret.Get(0).(func() echo.MiddlewareFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(echo.MiddlewareFunc)
+		}
+	}
+
+	return r0
+}
+
+// AuthMiddlewareMock_RequireDPoP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequireDPoP'
+type AuthMiddlewareMock_RequireDPoP_Call struct {
+	*mock.Call
+}
+
+// RequireDPoP is a helper method to define mock.On call
+func (_e *AuthMiddlewareMock_Expecter) RequireDPoP() *AuthMiddlewareMock_RequireDPoP_Call {
+	return &AuthMiddlewareMock_RequireDPoP_Call{Call: _e.mock.On("RequireDPoP")}
+}
+
+func (_c *AuthMiddlewareMock_RequireDPoP_Call) Run(run func()) *AuthMiddlewareMock_RequireDPoP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AuthMiddlewareMock_RequireDPoP_Call) Return(_a0 echo.MiddlewareFunc) *AuthMiddlewareMock_RequireDPoP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthMiddlewareMock_RequireDPoP_Call) RunAndReturn(run func() echo.MiddlewareFunc) *AuthMiddlewareMock_RequireDPoP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuthMiddlewareMock creates a new instance of AuthMiddlewareMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthMiddlewareMock(t interface {
@@ -22,6 +22,69 @@ func (_m *AuthorizationCodeRepositoryMock) EXPECT() *AuthorizationCodeRepository
 	return &AuthorizationCodeRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// Consume provides a mock function with given fields: ctx, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID
+func (_m *AuthorizationCodeRepositoryMock) Consume(ctx context.Context, code string, clientID string, redirectURI string, accessTokenID string, refreshTokenFamilyID string) (*entities.AuthorizationCode, error) {
+	ret := _m.Called(ctx, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 *entities.AuthorizationCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (*entities.AuthorizationCode, error)); ok {
+		return rf(ctx, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) *entities.AuthorizationCode); ok {
+		r0 = rf(ctx, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuthorizationCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) error); ok {
+		r1 = rf(ctx, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizationCodeRepositoryMock_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type AuthorizationCodeRepositoryMock_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - clientID string
+//   - redirectURI string
+//   - accessTokenID string
+//   - refreshTokenFamilyID string
+func (_e *AuthorizationCodeRepositoryMock_Expecter) Consume(ctx interface{}, code interface{}, clientID interface{}, redirectURI interface{}, accessTokenID interface{}, refreshTokenFamilyID interface{}) *AuthorizationCodeRepositoryMock_Consume_Call {
+	return &AuthorizationCodeRepositoryMock_Consume_Call{Call: _e.mock.On("Consume", ctx, code, clientID, redirectURI, accessTokenID, refreshTokenFamilyID)}
+}
+
+func (_c *AuthorizationCodeRepositoryMock_Consume_Call) Run(run func(ctx context.Context, code string, clientID string, redirectURI string, accessTokenID string, refreshTokenFamilyID string)) *AuthorizationCodeRepositoryMock_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *AuthorizationCodeRepositoryMock_Consume_Call) Return(_a0 *entities.AuthorizationCode, _a1 error) *AuthorizationCodeRepositoryMock_Consume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthorizationCodeRepositoryMock_Consume_Call) RunAndReturn(run func(context.Context, string, string, string, string, string) (*entities.AuthorizationCode, error)) *AuthorizationCodeRepositoryMock_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, authorizationCode
 func (_m *AuthorizationCodeRepositoryMock) Create(ctx context.Context, authorizationCode *entities.AuthorizationCode) error {
 	ret := _m.Called(ctx, authorizationCode)
@@ -116,6 +179,54 @@ func (_c *AuthorizationCodeRepositoryMock_Delete_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DeleteUnconsumedByUserAndClient provides a mock function with given fields: ctx, userID, clientID
+func (_m *AuthorizationCodeRepositoryMock) DeleteUnconsumedByUserAndClient(ctx context.Context, userID string, clientID string) error {
+	ret := _m.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUnconsumedByUserAndClient")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUnconsumedByUserAndClient'
+type AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call struct {
+	*mock.Call
+}
+
+// DeleteUnconsumedByUserAndClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+func (_e *AuthorizationCodeRepositoryMock_Expecter) DeleteUnconsumedByUserAndClient(ctx interface{}, userID interface{}, clientID interface{}) *AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call {
+	return &AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call{Call: _e.mock.On("DeleteUnconsumedByUserAndClient", ctx, userID, clientID)}
+}
+
+func (_c *AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call) Run(run func(ctx context.Context, userID string, clientID string)) *AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call) Return(_a0 error) *AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call) RunAndReturn(run func(context.Context, string, string) error) *AuthorizationCodeRepositoryMock_DeleteUnconsumedByUserAndClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByCode provides a mock function with given fields: ctx, code
 func (_m *AuthorizationCodeRepositoryMock) FindByCode(ctx context.Context, code string) (*entities.AuthorizationCode, error) {
 	ret := _m.Called(ctx, code)
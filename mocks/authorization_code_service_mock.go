@@ -83,9 +83,9 @@ func (_c *AuthorizationCodeServiceMock_CreateAuthorizationCode_Call) RunAndRetur
 	return _c
 }
 
-// ValidateAuthorizationCode provides a mock function with given fields: ctx, code, codeVerifier
-func (_m *AuthorizationCodeServiceMock) ValidateAuthorizationCode(ctx context.Context, code string, codeVerifier string) (*entities.AuthorizationCode, error) {
-	ret := _m.Called(ctx, code, codeVerifier)
+// ValidateAuthorizationCode provides a mock function with given fields: ctx, code, codeVerifier, clientID, redirectURI
+func (_m *AuthorizationCodeServiceMock) ValidateAuthorizationCode(ctx context.Context, code string, codeVerifier string, clientID string, redirectURI string) (*entities.AuthorizationCode, error) {
+	ret := _m.Called(ctx, code, codeVerifier, clientID, redirectURI)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateAuthorizationCode")
@@ -93,19 +93,19 @@ func (_m *AuthorizationCodeServiceMock) ValidateAuthorizationCode(ctx context.Co
 
 	var r0 *entities.AuthorizationCode
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.AuthorizationCode, error)); ok {
-		return rf(ctx, code, codeVerifier)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*entities.AuthorizationCode, error)); ok {
+		return rf(ctx, code, codeVerifier, clientID, redirectURI)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.AuthorizationCode); ok {
-		r0 = rf(ctx, code, codeVerifier)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *entities.AuthorizationCode); ok {
+		r0 = rf(ctx, code, codeVerifier, clientID, redirectURI)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.AuthorizationCode)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, code, codeVerifier)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, code, codeVerifier, clientID, redirectURI)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -122,13 +122,15 @@ type AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call struct {
 //   - ctx context.Context
 //   - code string
 //   - codeVerifier string
-func (_e *AuthorizationCodeServiceMock_Expecter) ValidateAuthorizationCode(ctx interface{}, code interface{}, codeVerifier interface{}) *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call {
-	return &AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call{Call: _e.mock.On("ValidateAuthorizationCode", ctx, code, codeVerifier)}
+//   - clientID string
+//   - redirectURI string
+func (_e *AuthorizationCodeServiceMock_Expecter) ValidateAuthorizationCode(ctx interface{}, code interface{}, codeVerifier interface{}, clientID interface{}, redirectURI interface{}) *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call {
+	return &AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call{Call: _e.mock.On("ValidateAuthorizationCode", ctx, code, codeVerifier, clientID, redirectURI)}
 }
 
-func (_c *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call) Run(run func(ctx context.Context, code string, codeVerifier string)) *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call {
+func (_c *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call) Run(run func(ctx context.Context, code string, codeVerifier string, clientID string, redirectURI string)) *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -138,7 +140,7 @@ func (_c *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call) Return(_a
 	return _c
 }
 
-func (_c *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call) RunAndReturn(run func(context.Context, string, string) (*entities.AuthorizationCode, error)) *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call {
+func (_c *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*entities.AuthorizationCode, error)) *AuthorizationCodeServiceMock_ValidateAuthorizationCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
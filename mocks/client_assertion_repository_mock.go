@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClientAssertionRepositoryMock is an autogenerated mock type for the ClientAssertionRepository type
+type ClientAssertionRepositoryMock struct {
+	mock.Mock
+}
+
+type ClientAssertionRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClientAssertionRepositoryMock) EXPECT() *ClientAssertionRepositoryMock_Expecter {
+	return &ClientAssertionRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, assertion
+func (_m *ClientAssertionRepositoryMock) Create(ctx context.Context, assertion *entities.ClientAssertion) error {
+	ret := _m.Called(ctx, assertion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ClientAssertion) error); ok {
+		r0 = rf(ctx, assertion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientAssertionRepositoryMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ClientAssertionRepositoryMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assertion *entities.ClientAssertion
+func (_e *ClientAssertionRepositoryMock_Expecter) Create(ctx interface{}, assertion interface{}) *ClientAssertionRepositoryMock_Create_Call {
+	return &ClientAssertionRepositoryMock_Create_Call{Call: _e.mock.On("Create", ctx, assertion)}
+}
+
+func (_c *ClientAssertionRepositoryMock_Create_Call) Run(run func(ctx context.Context, assertion *entities.ClientAssertion)) *ClientAssertionRepositoryMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ClientAssertion))
+	})
+	return _c
+}
+
+func (_c *ClientAssertionRepositoryMock_Create_Call) Return(_a0 error) *ClientAssertionRepositoryMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientAssertionRepositoryMock_Create_Call) RunAndReturn(run func(context.Context, *entities.ClientAssertion) error) *ClientAssertionRepositoryMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClientAssertionRepositoryMock creates a new instance of ClientAssertionRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClientAssertionRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClientAssertionRepositoryMock {
+	mock := &ClientAssertionRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
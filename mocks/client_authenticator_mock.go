@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+)
+
+// ClientAuthenticatorMock is an autogenerated mock type for the ClientAuthenticator type
+type ClientAuthenticatorMock struct {
+	mock.Mock
+}
+
+type ClientAuthenticatorMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClientAuthenticatorMock) EXPECT() *ClientAuthenticatorMock_Expecter {
+	return &ClientAuthenticatorMock_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: ctx, input
+func (_m *ClientAuthenticatorMock) Authenticate(ctx context.Context, input models.ClientAuthenticationInput) (*entities.Client, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *entities.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ClientAuthenticationInput) (*entities.Client, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ClientAuthenticationInput) *entities.Client); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Client)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ClientAuthenticationInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientAuthenticatorMock_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type ClientAuthenticatorMock_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.ClientAuthenticationInput
+func (_e *ClientAuthenticatorMock_Expecter) Authenticate(ctx interface{}, input interface{}) *ClientAuthenticatorMock_Authenticate_Call {
+	return &ClientAuthenticatorMock_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, input)}
+}
+
+func (_c *ClientAuthenticatorMock_Authenticate_Call) Run(run func(ctx context.Context, input models.ClientAuthenticationInput)) *ClientAuthenticatorMock_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ClientAuthenticationInput))
+	})
+	return _c
+}
+
+func (_c *ClientAuthenticatorMock_Authenticate_Call) Return(_a0 *entities.Client, _a1 error) *ClientAuthenticatorMock_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientAuthenticatorMock_Authenticate_Call) RunAndReturn(run func(context.Context, models.ClientAuthenticationInput) (*entities.Client, error)) *ClientAuthenticatorMock_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClientAuthenticatorMock creates a new instance of ClientAuthenticatorMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClientAuthenticatorMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClientAuthenticatorMock {
+	mock := &ClientAuthenticatorMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,54 @@ func (_m *ClientRepositoryMock) EXPECT() *ClientRepositoryMock_Expecter {
 	return &ClientRepositoryMock_Expecter{mock: &_m.Mock}
 }
 
+// AddScope provides a mock function with given fields: ctx, clientID, scope
+func (_m *ClientRepositoryMock) AddScope(ctx context.Context, clientID string, scope string) error {
+	ret := _m.Called(ctx, clientID, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddScope")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, clientID, scope)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientRepositoryMock_AddScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddScope'
+type ClientRepositoryMock_AddScope_Call struct {
+	*mock.Call
+}
+
+// AddScope is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - scope string
+func (_e *ClientRepositoryMock_Expecter) AddScope(ctx interface{}, clientID interface{}, scope interface{}) *ClientRepositoryMock_AddScope_Call {
+	return &ClientRepositoryMock_AddScope_Call{Call: _e.mock.On("AddScope", ctx, clientID, scope)}
+}
+
+func (_c *ClientRepositoryMock_AddScope_Call) Run(run func(ctx context.Context, clientID string, scope string)) *ClientRepositoryMock_AddScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ClientRepositoryMock_AddScope_Call) Return(_a0 error) *ClientRepositoryMock_AddScope_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientRepositoryMock_AddScope_Call) RunAndReturn(run func(context.Context, string, string) error) *ClientRepositoryMock_AddScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, client
 func (_m *ClientRepositoryMock) Create(ctx context.Context, client *entities.Client) error {
 	ret := _m.Called(ctx, client)
@@ -128,6 +176,150 @@ func (_c *ClientRepositoryMock_GetByClientID_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// RemoveScope provides a mock function with given fields: ctx, clientID, scope
+func (_m *ClientRepositoryMock) RemoveScope(ctx context.Context, clientID string, scope string) error {
+	ret := _m.Called(ctx, clientID, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveScope")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, clientID, scope)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientRepositoryMock_RemoveScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveScope'
+type ClientRepositoryMock_RemoveScope_Call struct {
+	*mock.Call
+}
+
+// RemoveScope is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - scope string
+func (_e *ClientRepositoryMock_Expecter) RemoveScope(ctx interface{}, clientID interface{}, scope interface{}) *ClientRepositoryMock_RemoveScope_Call {
+	return &ClientRepositoryMock_RemoveScope_Call{Call: _e.mock.On("RemoveScope", ctx, clientID, scope)}
+}
+
+func (_c *ClientRepositoryMock_RemoveScope_Call) Run(run func(ctx context.Context, clientID string, scope string)) *ClientRepositoryMock_RemoveScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ClientRepositoryMock_RemoveScope_Call) Return(_a0 error) *ClientRepositoryMock_RemoveScope_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientRepositoryMock_RemoveScope_Call) RunAndReturn(run func(context.Context, string, string) error) *ClientRepositoryMock_RemoveScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFirstParty provides a mock function with given fields: ctx, clientID, firstParty
+func (_m *ClientRepositoryMock) UpdateFirstParty(ctx context.Context, clientID string, firstParty bool) error {
+	ret := _m.Called(ctx, clientID, firstParty)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFirstParty")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, clientID, firstParty)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientRepositoryMock_UpdateFirstParty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFirstParty'
+type ClientRepositoryMock_UpdateFirstParty_Call struct {
+	*mock.Call
+}
+
+// UpdateFirstParty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - firstParty bool
+func (_e *ClientRepositoryMock_Expecter) UpdateFirstParty(ctx interface{}, clientID interface{}, firstParty interface{}) *ClientRepositoryMock_UpdateFirstParty_Call {
+	return &ClientRepositoryMock_UpdateFirstParty_Call{Call: _e.mock.On("UpdateFirstParty", ctx, clientID, firstParty)}
+}
+
+func (_c *ClientRepositoryMock_UpdateFirstParty_Call) Run(run func(ctx context.Context, clientID string, firstParty bool)) *ClientRepositoryMock_UpdateFirstParty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ClientRepositoryMock_UpdateFirstParty_Call) Return(_a0 error) *ClientRepositoryMock_UpdateFirstParty_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientRepositoryMock_UpdateFirstParty_Call) RunAndReturn(run func(context.Context, string, bool) error) *ClientRepositoryMock_UpdateFirstParty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTokenExchangePolicy provides a mock function with given fields: ctx, clientID, policy
+func (_m *ClientRepositoryMock) UpdateTokenExchangePolicy(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy) error {
+	ret := _m.Called(ctx, clientID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTokenExchangePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entities.TokenExchangePolicy) error); ok {
+		r0 = rf(ctx, clientID, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientRepositoryMock_UpdateTokenExchangePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTokenExchangePolicy'
+type ClientRepositoryMock_UpdateTokenExchangePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateTokenExchangePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - policy *entities.TokenExchangePolicy
+func (_e *ClientRepositoryMock_Expecter) UpdateTokenExchangePolicy(ctx interface{}, clientID interface{}, policy interface{}) *ClientRepositoryMock_UpdateTokenExchangePolicy_Call {
+	return &ClientRepositoryMock_UpdateTokenExchangePolicy_Call{Call: _e.mock.On("UpdateTokenExchangePolicy", ctx, clientID, policy)}
+}
+
+func (_c *ClientRepositoryMock_UpdateTokenExchangePolicy_Call) Run(run func(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy)) *ClientRepositoryMock_UpdateTokenExchangePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entities.TokenExchangePolicy))
+	})
+	return _c
+}
+
+func (_c *ClientRepositoryMock_UpdateTokenExchangePolicy_Call) Return(_a0 error) *ClientRepositoryMock_UpdateTokenExchangePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientRepositoryMock_UpdateTokenExchangePolicy_Call) RunAndReturn(run func(context.Context, string, *entities.TokenExchangePolicy) error) *ClientRepositoryMock_UpdateTokenExchangePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClientRepositoryMock creates a new instance of ClientRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientRepositoryMock(t interface {
@@ -24,9 +24,9 @@ func (_m *ClientServiceMock) EXPECT() *ClientServiceMock_Expecter {
 	return &ClientServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// CreateClient provides a mock function with given fields: ctx, name, description, redirectURIs, grantTypes
-func (_m *ClientServiceMock) CreateClient(ctx context.Context, name string, description string, redirectURIs []string, grantTypes []string) (*models.ClientResponse, error) {
-	ret := _m.Called(ctx, name, description, redirectURIs, grantTypes)
+// CreateClient provides a mock function with given fields: ctx, input
+func (_m *ClientServiceMock) CreateClient(ctx context.Context, input models.CreateClientInput) (*models.ClientResponse, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateClient")
@@ -34,19 +34,19 @@ func (_m *ClientServiceMock) CreateClient(ctx context.Context, name string, desc
 
 	var r0 *models.ClientResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, []string) (*models.ClientResponse, error)); ok {
-		return rf(ctx, name, description, redirectURIs, grantTypes)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateClientInput) (*models.ClientResponse, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, []string) *models.ClientResponse); ok {
-		r0 = rf(ctx, name, description, redirectURIs, grantTypes)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateClientInput) *models.ClientResponse); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ClientResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string, []string) error); ok {
-		r1 = rf(ctx, name, description, redirectURIs, grantTypes)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateClientInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,17 +61,14 @@ type ClientServiceMock_CreateClient_Call struct {
 
 // CreateClient is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-//   - description string
-//   - redirectURIs []string
-//   - grantTypes []string
-func (_e *ClientServiceMock_Expecter) CreateClient(ctx interface{}, name interface{}, description interface{}, redirectURIs interface{}, grantTypes interface{}) *ClientServiceMock_CreateClient_Call {
-	return &ClientServiceMock_CreateClient_Call{Call: _e.mock.On("CreateClient", ctx, name, description, redirectURIs, grantTypes)}
+//   - input models.CreateClientInput
+func (_e *ClientServiceMock_Expecter) CreateClient(ctx interface{}, input interface{}) *ClientServiceMock_CreateClient_Call {
+	return &ClientServiceMock_CreateClient_Call{Call: _e.mock.On("CreateClient", ctx, input)}
 }
 
-func (_c *ClientServiceMock_CreateClient_Call) Run(run func(ctx context.Context, name string, description string, redirectURIs []string, grantTypes []string)) *ClientServiceMock_CreateClient_Call {
+func (_c *ClientServiceMock_CreateClient_Call) Run(run func(ctx context.Context, input models.CreateClientInput)) *ClientServiceMock_CreateClient_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string), args[4].([]string))
+		run(args[0].(context.Context), args[1].(models.CreateClientInput))
 	})
 	return _c
 }
@@ -81,7 +78,7 @@ func (_c *ClientServiceMock_CreateClient_Call) Return(_a0 *models.ClientResponse
 	return _c
 }
 
-func (_c *ClientServiceMock_CreateClient_Call) RunAndReturn(run func(context.Context, string, string, []string, []string) (*models.ClientResponse, error)) *ClientServiceMock_CreateClient_Call {
+func (_c *ClientServiceMock_CreateClient_Call) RunAndReturn(run func(context.Context, models.CreateClientInput) (*models.ClientResponse, error)) *ClientServiceMock_CreateClient_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -145,6 +142,198 @@ func (_c *ClientServiceMock_GetClientByClientID_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GrantScope provides a mock function with given fields: ctx, clientID, scope
+func (_m *ClientServiceMock) GrantScope(ctx context.Context, clientID string, scope string) error {
+	ret := _m.Called(ctx, clientID, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantScope")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, clientID, scope)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientServiceMock_GrantScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantScope'
+type ClientServiceMock_GrantScope_Call struct {
+	*mock.Call
+}
+
+// GrantScope is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - scope string
+func (_e *ClientServiceMock_Expecter) GrantScope(ctx interface{}, clientID interface{}, scope interface{}) *ClientServiceMock_GrantScope_Call {
+	return &ClientServiceMock_GrantScope_Call{Call: _e.mock.On("GrantScope", ctx, clientID, scope)}
+}
+
+func (_c *ClientServiceMock_GrantScope_Call) Run(run func(ctx context.Context, clientID string, scope string)) *ClientServiceMock_GrantScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ClientServiceMock_GrantScope_Call) Return(_a0 error) *ClientServiceMock_GrantScope_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientServiceMock_GrantScope_Call) RunAndReturn(run func(context.Context, string, string) error) *ClientServiceMock_GrantScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeScope provides a mock function with given fields: ctx, clientID, scope
+func (_m *ClientServiceMock) RevokeScope(ctx context.Context, clientID string, scope string) error {
+	ret := _m.Called(ctx, clientID, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeScope")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, clientID, scope)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientServiceMock_RevokeScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeScope'
+type ClientServiceMock_RevokeScope_Call struct {
+	*mock.Call
+}
+
+// RevokeScope is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - scope string
+func (_e *ClientServiceMock_Expecter) RevokeScope(ctx interface{}, clientID interface{}, scope interface{}) *ClientServiceMock_RevokeScope_Call {
+	return &ClientServiceMock_RevokeScope_Call{Call: _e.mock.On("RevokeScope", ctx, clientID, scope)}
+}
+
+func (_c *ClientServiceMock_RevokeScope_Call) Run(run func(ctx context.Context, clientID string, scope string)) *ClientServiceMock_RevokeScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ClientServiceMock_RevokeScope_Call) Return(_a0 error) *ClientServiceMock_RevokeScope_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientServiceMock_RevokeScope_Call) RunAndReturn(run func(context.Context, string, string) error) *ClientServiceMock_RevokeScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFirstParty provides a mock function with given fields: ctx, clientID, firstParty
+func (_m *ClientServiceMock) SetFirstParty(ctx context.Context, clientID string, firstParty bool) error {
+	ret := _m.Called(ctx, clientID, firstParty)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFirstParty")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, clientID, firstParty)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientServiceMock_SetFirstParty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFirstParty'
+type ClientServiceMock_SetFirstParty_Call struct {
+	*mock.Call
+}
+
+// SetFirstParty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - firstParty bool
+func (_e *ClientServiceMock_Expecter) SetFirstParty(ctx interface{}, clientID interface{}, firstParty interface{}) *ClientServiceMock_SetFirstParty_Call {
+	return &ClientServiceMock_SetFirstParty_Call{Call: _e.mock.On("SetFirstParty", ctx, clientID, firstParty)}
+}
+
+func (_c *ClientServiceMock_SetFirstParty_Call) Run(run func(ctx context.Context, clientID string, firstParty bool)) *ClientServiceMock_SetFirstParty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ClientServiceMock_SetFirstParty_Call) Return(_a0 error) *ClientServiceMock_SetFirstParty_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientServiceMock_SetFirstParty_Call) RunAndReturn(run func(context.Context, string, bool) error) *ClientServiceMock_SetFirstParty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTokenExchangePolicy provides a mock function with given fields: ctx, clientID, policy
+func (_m *ClientServiceMock) SetTokenExchangePolicy(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy) error {
+	ret := _m.Called(ctx, clientID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTokenExchangePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entities.TokenExchangePolicy) error); ok {
+		r0 = rf(ctx, clientID, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientServiceMock_SetTokenExchangePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTokenExchangePolicy'
+type ClientServiceMock_SetTokenExchangePolicy_Call struct {
+	*mock.Call
+}
+
+// SetTokenExchangePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - policy *entities.TokenExchangePolicy
+func (_e *ClientServiceMock_Expecter) SetTokenExchangePolicy(ctx interface{}, clientID interface{}, policy interface{}) *ClientServiceMock_SetTokenExchangePolicy_Call {
+	return &ClientServiceMock_SetTokenExchangePolicy_Call{Call: _e.mock.On("SetTokenExchangePolicy", ctx, clientID, policy)}
+}
+
+func (_c *ClientServiceMock_SetTokenExchangePolicy_Call) Run(run func(ctx context.Context, clientID string, policy *entities.TokenExchangePolicy)) *ClientServiceMock_SetTokenExchangePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entities.TokenExchangePolicy))
+	})
+	return _c
+}
+
+func (_c *ClientServiceMock_SetTokenExchangePolicy_Call) Return(_a0 error) *ClientServiceMock_SetTokenExchangePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientServiceMock_SetTokenExchangePolicy_Call) RunAndReturn(run func(context.Context, string, *entities.TokenExchangePolicy) error) *ClientServiceMock_SetTokenExchangePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClientServiceMock creates a new instance of ClientServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientServiceMock(t interface {
@@ -0,0 +1,349 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConsentRepositoryMock is an autogenerated mock type for the ConsentRepository type
+type ConsentRepositoryMock struct {
+	mock.Mock
+}
+
+type ConsentRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConsentRepositoryMock) EXPECT() *ConsentRepositoryMock_Expecter {
+	return &ConsentRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, userID, clientID
+func (_m *ConsentRepositoryMock) Delete(ctx context.Context, userID string, clientID string) error {
+	ret := _m.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentRepositoryMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ConsentRepositoryMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+func (_e *ConsentRepositoryMock_Expecter) Delete(ctx interface{}, userID interface{}, clientID interface{}) *ConsentRepositoryMock_Delete_Call {
+	return &ConsentRepositoryMock_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, clientID)}
+}
+
+func (_c *ConsentRepositoryMock_Delete_Call) Run(run func(ctx context.Context, userID string, clientID string)) *ConsentRepositoryMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_Delete_Call) Return(_a0 error) *ConsentRepositoryMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_Delete_Call) RunAndReturn(run func(context.Context, string, string) error) *ConsentRepositoryMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUser provides a mock function with given fields: ctx, userID
+func (_m *ConsentRepositoryMock) FindByUser(ctx context.Context, userID string) ([]*entities.Consent, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUser")
+	}
+
+	var r0 []*entities.Consent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.Consent, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.Consent); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Consent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentRepositoryMock_FindByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUser'
+type ConsentRepositoryMock_FindByUser_Call struct {
+	*mock.Call
+}
+
+// FindByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *ConsentRepositoryMock_Expecter) FindByUser(ctx interface{}, userID interface{}) *ConsentRepositoryMock_FindByUser_Call {
+	return &ConsentRepositoryMock_FindByUser_Call{Call: _e.mock.On("FindByUser", ctx, userID)}
+}
+
+func (_c *ConsentRepositoryMock_FindByUser_Call) Run(run func(ctx context.Context, userID string)) *ConsentRepositoryMock_FindByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_FindByUser_Call) Return(_a0 []*entities.Consent, _a1 error) *ConsentRepositoryMock_FindByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_FindByUser_Call) RunAndReturn(run func(context.Context, string) ([]*entities.Consent, error)) *ConsentRepositoryMock_FindByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserAndClient provides a mock function with given fields: ctx, userID, clientID
+func (_m *ConsentRepositoryMock) FindByUserAndClient(ctx context.Context, userID string, clientID string) (*entities.Consent, error) {
+	ret := _m.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserAndClient")
+	}
+
+	var r0 *entities.Consent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Consent, error)); ok {
+		return rf(ctx, userID, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.Consent); ok {
+		r0 = rf(ctx, userID, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Consent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentRepositoryMock_FindByUserAndClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserAndClient'
+type ConsentRepositoryMock_FindByUserAndClient_Call struct {
+	*mock.Call
+}
+
+// FindByUserAndClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+func (_e *ConsentRepositoryMock_Expecter) FindByUserAndClient(ctx interface{}, userID interface{}, clientID interface{}) *ConsentRepositoryMock_FindByUserAndClient_Call {
+	return &ConsentRepositoryMock_FindByUserAndClient_Call{Call: _e.mock.On("FindByUserAndClient", ctx, userID, clientID)}
+}
+
+func (_c *ConsentRepositoryMock_FindByUserAndClient_Call) Run(run func(ctx context.Context, userID string, clientID string)) *ConsentRepositoryMock_FindByUserAndClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_FindByUserAndClient_Call) Return(_a0 *entities.Consent, _a1 error) *ConsentRepositoryMock_FindByUserAndClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_FindByUserAndClient_Call) RunAndReturn(run func(context.Context, string, string) (*entities.Consent, error)) *ConsentRepositoryMock_FindByUserAndClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Grant provides a mock function with given fields: ctx, userID, clientID, scopes
+func (_m *ConsentRepositoryMock) Grant(ctx context.Context, userID string, clientID string, scopes []string) error {
+	ret := _m.Called(ctx, userID, clientID, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Grant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, userID, clientID, scopes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentRepositoryMock_Grant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Grant'
+type ConsentRepositoryMock_Grant_Call struct {
+	*mock.Call
+}
+
+// Grant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+//   - scopes []string
+func (_e *ConsentRepositoryMock_Expecter) Grant(ctx interface{}, userID interface{}, clientID interface{}, scopes interface{}) *ConsentRepositoryMock_Grant_Call {
+	return &ConsentRepositoryMock_Grant_Call{Call: _e.mock.On("Grant", ctx, userID, clientID, scopes)}
+}
+
+func (_c *ConsentRepositoryMock_Grant_Call) Run(run func(ctx context.Context, userID string, clientID string, scopes []string)) *ConsentRepositoryMock_Grant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_Grant_Call) Return(_a0 error) *ConsentRepositoryMock_Grant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_Grant_Call) RunAndReturn(run func(context.Context, string, string, []string) error) *ConsentRepositoryMock_Grant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordUse provides a mock function with given fields: ctx, userID, clientID, scopes
+func (_m *ConsentRepositoryMock) RecordUse(ctx context.Context, userID string, clientID string, scopes []string) error {
+	ret := _m.Called(ctx, userID, clientID, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordUse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, userID, clientID, scopes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentRepositoryMock_RecordUse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordUse'
+type ConsentRepositoryMock_RecordUse_Call struct {
+	*mock.Call
+}
+
+// RecordUse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+//   - scopes []string
+func (_e *ConsentRepositoryMock_Expecter) RecordUse(ctx interface{}, userID interface{}, clientID interface{}, scopes interface{}) *ConsentRepositoryMock_RecordUse_Call {
+	return &ConsentRepositoryMock_RecordUse_Call{Call: _e.mock.On("RecordUse", ctx, userID, clientID, scopes)}
+}
+
+func (_c *ConsentRepositoryMock_RecordUse_Call) Run(run func(ctx context.Context, userID string, clientID string, scopes []string)) *ConsentRepositoryMock_RecordUse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_RecordUse_Call) Return(_a0 error) *ConsentRepositoryMock_RecordUse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_RecordUse_Call) RunAndReturn(run func(context.Context, string, string, []string) error) *ConsentRepositoryMock_RecordUse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, consent
+func (_m *ConsentRepositoryMock) Save(ctx context.Context, consent *entities.Consent) error {
+	ret := _m.Called(ctx, consent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Consent) error); ok {
+		r0 = rf(ctx, consent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type ConsentRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - consent *entities.Consent
+func (_e *ConsentRepositoryMock_Expecter) Save(ctx interface{}, consent interface{}) *ConsentRepositoryMock_Save_Call {
+	return &ConsentRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, consent)}
+}
+
+func (_c *ConsentRepositoryMock_Save_Call) Run(run func(ctx context.Context, consent *entities.Consent)) *ConsentRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Consent))
+	})
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_Save_Call) Return(_a0 error) *ConsentRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entities.Consent) error) *ConsentRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConsentRepositoryMock creates a new instance of ConsentRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConsentRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConsentRepositoryMock {
+	mock := &ConsentRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,254 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConsentRequestRepositoryMock is an autogenerated mock type for the ConsentRequestRepository type
+type ConsentRequestRepositoryMock struct {
+	mock.Mock
+}
+
+type ConsentRequestRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConsentRequestRepositoryMock) EXPECT() *ConsentRequestRepositoryMock_Expecter {
+	return &ConsentRequestRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeApproved provides a mock function with given fields: ctx, challengeHash, userID, clientID
+func (_m *ConsentRequestRepositoryMock) ConsumeApproved(ctx context.Context, challengeHash string, userID string, clientID string) (*entities.ConsentRequest, error) {
+	ret := _m.Called(ctx, challengeHash, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeApproved")
+	}
+
+	var r0 *entities.ConsentRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*entities.ConsentRequest, error)); ok {
+		return rf(ctx, challengeHash, userID, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *entities.ConsentRequest); ok {
+		r0 = rf(ctx, challengeHash, userID, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ConsentRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, challengeHash, userID, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentRequestRepositoryMock_ConsumeApproved_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeApproved'
+type ConsentRequestRepositoryMock_ConsumeApproved_Call struct {
+	*mock.Call
+}
+
+// ConsumeApproved is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challengeHash string
+//   - userID string
+//   - clientID string
+func (_e *ConsentRequestRepositoryMock_Expecter) ConsumeApproved(ctx interface{}, challengeHash interface{}, userID interface{}, clientID interface{}) *ConsentRequestRepositoryMock_ConsumeApproved_Call {
+	return &ConsentRequestRepositoryMock_ConsumeApproved_Call{Call: _e.mock.On("ConsumeApproved", ctx, challengeHash, userID, clientID)}
+}
+
+func (_c *ConsentRequestRepositoryMock_ConsumeApproved_Call) Run(run func(ctx context.Context, challengeHash string, userID string, clientID string)) *ConsentRequestRepositoryMock_ConsumeApproved_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_ConsumeApproved_Call) Return(_a0 *entities.ConsentRequest, _a1 error) *ConsentRequestRepositoryMock_ConsumeApproved_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_ConsumeApproved_Call) RunAndReturn(run func(context.Context, string, string, string) (*entities.ConsentRequest, error)) *ConsentRequestRepositoryMock_ConsumeApproved_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, request
+func (_m *ConsentRequestRepositoryMock) Create(ctx context.Context, request *entities.ConsentRequest) error {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ConsentRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentRequestRepositoryMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ConsentRequestRepositoryMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *entities.ConsentRequest
+func (_e *ConsentRequestRepositoryMock_Expecter) Create(ctx interface{}, request interface{}) *ConsentRequestRepositoryMock_Create_Call {
+	return &ConsentRequestRepositoryMock_Create_Call{Call: _e.mock.On("Create", ctx, request)}
+}
+
+func (_c *ConsentRequestRepositoryMock_Create_Call) Run(run func(ctx context.Context, request *entities.ConsentRequest)) *ConsentRequestRepositoryMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ConsentRequest))
+	})
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_Create_Call) Return(_a0 error) *ConsentRequestRepositoryMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_Create_Call) RunAndReturn(run func(context.Context, *entities.ConsentRequest) error) *ConsentRequestRepositoryMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Decide provides a mock function with given fields: ctx, challengeHash, userID, status
+func (_m *ConsentRequestRepositoryMock) Decide(ctx context.Context, challengeHash string, userID string, status string) error {
+	ret := _m.Called(ctx, challengeHash, userID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decide")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, challengeHash, userID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentRequestRepositoryMock_Decide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decide'
+type ConsentRequestRepositoryMock_Decide_Call struct {
+	*mock.Call
+}
+
+// Decide is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challengeHash string
+//   - userID string
+//   - status string
+func (_e *ConsentRequestRepositoryMock_Expecter) Decide(ctx interface{}, challengeHash interface{}, userID interface{}, status interface{}) *ConsentRequestRepositoryMock_Decide_Call {
+	return &ConsentRequestRepositoryMock_Decide_Call{Call: _e.mock.On("Decide", ctx, challengeHash, userID, status)}
+}
+
+func (_c *ConsentRequestRepositoryMock_Decide_Call) Run(run func(ctx context.Context, challengeHash string, userID string, status string)) *ConsentRequestRepositoryMock_Decide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_Decide_Call) Return(_a0 error) *ConsentRequestRepositoryMock_Decide_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_Decide_Call) RunAndReturn(run func(context.Context, string, string, string) error) *ConsentRequestRepositoryMock_Decide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPending provides a mock function with given fields: ctx, challengeHash, userID
+func (_m *ConsentRequestRepositoryMock) FindPending(ctx context.Context, challengeHash string, userID string) (*entities.ConsentRequest, error) {
+	ret := _m.Called(ctx, challengeHash, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPending")
+	}
+
+	var r0 *entities.ConsentRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.ConsentRequest, error)); ok {
+		return rf(ctx, challengeHash, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.ConsentRequest); ok {
+		r0 = rf(ctx, challengeHash, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ConsentRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, challengeHash, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentRequestRepositoryMock_FindPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPending'
+type ConsentRequestRepositoryMock_FindPending_Call struct {
+	*mock.Call
+}
+
+// FindPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - challengeHash string
+//   - userID string
+func (_e *ConsentRequestRepositoryMock_Expecter) FindPending(ctx interface{}, challengeHash interface{}, userID interface{}) *ConsentRequestRepositoryMock_FindPending_Call {
+	return &ConsentRequestRepositoryMock_FindPending_Call{Call: _e.mock.On("FindPending", ctx, challengeHash, userID)}
+}
+
+func (_c *ConsentRequestRepositoryMock_FindPending_Call) Run(run func(ctx context.Context, challengeHash string, userID string)) *ConsentRequestRepositoryMock_FindPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_FindPending_Call) Return(_a0 *entities.ConsentRequest, _a1 error) *ConsentRequestRepositoryMock_FindPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentRequestRepositoryMock_FindPending_Call) RunAndReturn(run func(context.Context, string, string) (*entities.ConsentRequest, error)) *ConsentRequestRepositoryMock_FindPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConsentRequestRepositoryMock creates a new instance of ConsentRequestRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConsentRequestRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConsentRequestRepositoryMock {
+	mock := &ConsentRequestRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,479 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+)
+
+// ConsentServiceMock is an autogenerated mock type for the ConsentService type
+type ConsentServiceMock struct {
+	mock.Mock
+}
+
+type ConsentServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConsentServiceMock) EXPECT() *ConsentServiceMock_Expecter {
+	return &ConsentServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// ConsentRequired provides a mock function with given fields: ctx, client, input
+func (_m *ConsentServiceMock) ConsentRequired(ctx context.Context, client *entities.Client, input models.AuthorizeInput) (bool, error) {
+	ret := _m.Called(ctx, client, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsentRequired")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Client, models.AuthorizeInput) (bool, error)); ok {
+		return rf(ctx, client, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Client, models.AuthorizeInput) bool); ok {
+		r0 = rf(ctx, client, input)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.Client, models.AuthorizeInput) error); ok {
+		r1 = rf(ctx, client, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentServiceMock_ConsentRequired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsentRequired'
+type ConsentServiceMock_ConsentRequired_Call struct {
+	*mock.Call
+}
+
+// ConsentRequired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - client *entities.Client
+//   - input models.AuthorizeInput
+func (_e *ConsentServiceMock_Expecter) ConsentRequired(ctx interface{}, client interface{}, input interface{}) *ConsentServiceMock_ConsentRequired_Call {
+	return &ConsentServiceMock_ConsentRequired_Call{Call: _e.mock.On("ConsentRequired", ctx, client, input)}
+}
+
+func (_c *ConsentServiceMock_ConsentRequired_Call) Run(run func(ctx context.Context, client *entities.Client, input models.AuthorizeInput)) *ConsentServiceMock_ConsentRequired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Client), args[2].(models.AuthorizeInput))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_ConsentRequired_Call) Return(_a0 bool, _a1 error) *ConsentServiceMock_ConsentRequired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentServiceMock_ConsentRequired_Call) RunAndReturn(run func(context.Context, *entities.Client, models.AuthorizeInput) (bool, error)) *ConsentServiceMock_ConsentRequired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateConsentRequest provides a mock function with given fields: ctx, input, continueURL
+func (_m *ConsentServiceMock) CreateConsentRequest(ctx context.Context, input models.AuthorizeInput, continueURL string) (string, error) {
+	ret := _m.Called(ctx, input, continueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConsentRequest")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuthorizeInput, string) (string, error)); ok {
+		return rf(ctx, input, continueURL)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuthorizeInput, string) string); ok {
+		r0 = rf(ctx, input, continueURL)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuthorizeInput, string) error); ok {
+		r1 = rf(ctx, input, continueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentServiceMock_CreateConsentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConsentRequest'
+type ConsentServiceMock_CreateConsentRequest_Call struct {
+	*mock.Call
+}
+
+// CreateConsentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.AuthorizeInput
+//   - continueURL string
+func (_e *ConsentServiceMock_Expecter) CreateConsentRequest(ctx interface{}, input interface{}, continueURL interface{}) *ConsentServiceMock_CreateConsentRequest_Call {
+	return &ConsentServiceMock_CreateConsentRequest_Call{Call: _e.mock.On("CreateConsentRequest", ctx, input, continueURL)}
+}
+
+func (_c *ConsentServiceMock_CreateConsentRequest_Call) Run(run func(ctx context.Context, input models.AuthorizeInput, continueURL string)) *ConsentServiceMock_CreateConsentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.AuthorizeInput), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_CreateConsentRequest_Call) Return(_a0 string, _a1 error) *ConsentServiceMock_CreateConsentRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentServiceMock_CreateConsentRequest_Call) RunAndReturn(run func(context.Context, models.AuthorizeInput, string) (string, error)) *ConsentServiceMock_CreateConsentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecideConsentRequest provides a mock function with given fields: ctx, input
+func (_m *ConsentServiceMock) DecideConsentRequest(ctx context.Context, input models.ConsentDecisionInput) (*models.ConsentDecisionResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideConsentRequest")
+	}
+
+	var r0 *models.ConsentDecisionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ConsentDecisionInput) (*models.ConsentDecisionResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ConsentDecisionInput) *models.ConsentDecisionResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConsentDecisionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ConsentDecisionInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentServiceMock_DecideConsentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecideConsentRequest'
+type ConsentServiceMock_DecideConsentRequest_Call struct {
+	*mock.Call
+}
+
+// DecideConsentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.ConsentDecisionInput
+func (_e *ConsentServiceMock_Expecter) DecideConsentRequest(ctx interface{}, input interface{}) *ConsentServiceMock_DecideConsentRequest_Call {
+	return &ConsentServiceMock_DecideConsentRequest_Call{Call: _e.mock.On("DecideConsentRequest", ctx, input)}
+}
+
+func (_c *ConsentServiceMock_DecideConsentRequest_Call) Run(run func(ctx context.Context, input models.ConsentDecisionInput)) *ConsentServiceMock_DecideConsentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ConsentDecisionInput))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_DecideConsentRequest_Call) Return(_a0 *models.ConsentDecisionResponse, _a1 error) *ConsentServiceMock_DecideConsentRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentServiceMock_DecideConsentRequest_Call) RunAndReturn(run func(context.Context, models.ConsentDecisionInput) (*models.ConsentDecisionResponse, error)) *ConsentServiceMock_DecideConsentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConsentRequest provides a mock function with given fields: ctx, userID, consentChallenge
+func (_m *ConsentServiceMock) GetConsentRequest(ctx context.Context, userID string, consentChallenge string) (*models.ConsentRequestResponse, error) {
+	ret := _m.Called(ctx, userID, consentChallenge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsentRequest")
+	}
+
+	var r0 *models.ConsentRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.ConsentRequestResponse, error)); ok {
+		return rf(ctx, userID, consentChallenge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.ConsentRequestResponse); ok {
+		r0 = rf(ctx, userID, consentChallenge)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ConsentRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, consentChallenge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentServiceMock_GetConsentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsentRequest'
+type ConsentServiceMock_GetConsentRequest_Call struct {
+	*mock.Call
+}
+
+// GetConsentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - consentChallenge string
+func (_e *ConsentServiceMock_Expecter) GetConsentRequest(ctx interface{}, userID interface{}, consentChallenge interface{}) *ConsentServiceMock_GetConsentRequest_Call {
+	return &ConsentServiceMock_GetConsentRequest_Call{Call: _e.mock.On("GetConsentRequest", ctx, userID, consentChallenge)}
+}
+
+func (_c *ConsentServiceMock_GetConsentRequest_Call) Run(run func(ctx context.Context, userID string, consentChallenge string)) *ConsentServiceMock_GetConsentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_GetConsentRequest_Call) Return(_a0 *models.ConsentRequestResponse, _a1 error) *ConsentServiceMock_GetConsentRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentServiceMock_GetConsentRequest_Call) RunAndReturn(run func(context.Context, string, string) (*models.ConsentRequestResponse, error)) *ConsentServiceMock_GetConsentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GrantScopes provides a mock function with given fields: ctx, userID, clientID, scopes
+func (_m *ConsentServiceMock) GrantScopes(ctx context.Context, userID string, clientID string, scopes []string) error {
+	ret := _m.Called(ctx, userID, clientID, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantScopes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, userID, clientID, scopes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentServiceMock_GrantScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantScopes'
+type ConsentServiceMock_GrantScopes_Call struct {
+	*mock.Call
+}
+
+// GrantScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+//   - scopes []string
+func (_e *ConsentServiceMock_Expecter) GrantScopes(ctx interface{}, userID interface{}, clientID interface{}, scopes interface{}) *ConsentServiceMock_GrantScopes_Call {
+	return &ConsentServiceMock_GrantScopes_Call{Call: _e.mock.On("GrantScopes", ctx, userID, clientID, scopes)}
+}
+
+func (_c *ConsentServiceMock_GrantScopes_Call) Run(run func(ctx context.Context, userID string, clientID string, scopes []string)) *ConsentServiceMock_GrantScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_GrantScopes_Call) Return(_a0 error) *ConsentServiceMock_GrantScopes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentServiceMock_GrantScopes_Call) RunAndReturn(run func(context.Context, string, string, []string) error) *ConsentServiceMock_GrantScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGrants provides a mock function with given fields: ctx, userID
+func (_m *ConsentServiceMock) ListGrants(ctx context.Context, userID string) ([]models.GrantResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGrants")
+	}
+
+	var r0 []models.GrantResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.GrantResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.GrantResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.GrantResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsentServiceMock_ListGrants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGrants'
+type ConsentServiceMock_ListGrants_Call struct {
+	*mock.Call
+}
+
+// ListGrants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *ConsentServiceMock_Expecter) ListGrants(ctx interface{}, userID interface{}) *ConsentServiceMock_ListGrants_Call {
+	return &ConsentServiceMock_ListGrants_Call{Call: _e.mock.On("ListGrants", ctx, userID)}
+}
+
+func (_c *ConsentServiceMock_ListGrants_Call) Run(run func(ctx context.Context, userID string)) *ConsentServiceMock_ListGrants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_ListGrants_Call) Return(_a0 []models.GrantResponse, _a1 error) *ConsentServiceMock_ListGrants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsentServiceMock_ListGrants_Call) RunAndReturn(run func(context.Context, string) ([]models.GrantResponse, error)) *ConsentServiceMock_ListGrants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordGrantUse provides a mock function with given fields: ctx, userID, clientID, scopes
+func (_m *ConsentServiceMock) RecordGrantUse(ctx context.Context, userID string, clientID string, scopes []string) error {
+	ret := _m.Called(ctx, userID, clientID, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordGrantUse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, userID, clientID, scopes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentServiceMock_RecordGrantUse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordGrantUse'
+type ConsentServiceMock_RecordGrantUse_Call struct {
+	*mock.Call
+}
+
+// RecordGrantUse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+//   - scopes []string
+func (_e *ConsentServiceMock_Expecter) RecordGrantUse(ctx interface{}, userID interface{}, clientID interface{}, scopes interface{}) *ConsentServiceMock_RecordGrantUse_Call {
+	return &ConsentServiceMock_RecordGrantUse_Call{Call: _e.mock.On("RecordGrantUse", ctx, userID, clientID, scopes)}
+}
+
+func (_c *ConsentServiceMock_RecordGrantUse_Call) Run(run func(ctx context.Context, userID string, clientID string, scopes []string)) *ConsentServiceMock_RecordGrantUse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_RecordGrantUse_Call) Return(_a0 error) *ConsentServiceMock_RecordGrantUse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentServiceMock_RecordGrantUse_Call) RunAndReturn(run func(context.Context, string, string, []string) error) *ConsentServiceMock_RecordGrantUse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeGrant provides a mock function with given fields: ctx, userID, clientID
+func (_m *ConsentServiceMock) RevokeGrant(ctx context.Context, userID string, clientID string) error {
+	ret := _m.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeGrant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsentServiceMock_RevokeGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeGrant'
+type ConsentServiceMock_RevokeGrant_Call struct {
+	*mock.Call
+}
+
+// RevokeGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+func (_e *ConsentServiceMock_Expecter) RevokeGrant(ctx interface{}, userID interface{}, clientID interface{}) *ConsentServiceMock_RevokeGrant_Call {
+	return &ConsentServiceMock_RevokeGrant_Call{Call: _e.mock.On("RevokeGrant", ctx, userID, clientID)}
+}
+
+func (_c *ConsentServiceMock_RevokeGrant_Call) Run(run func(ctx context.Context, userID string, clientID string)) *ConsentServiceMock_RevokeGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ConsentServiceMock_RevokeGrant_Call) Return(_a0 error) *ConsentServiceMock_RevokeGrant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsentServiceMock_RevokeGrant_Call) RunAndReturn(run func(context.Context, string, string) error) *ConsentServiceMock_RevokeGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConsentServiceMock creates a new instance of ConsentServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConsentServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConsentServiceMock {
+	mock := &ConsentServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DPoPServiceMock is an autogenerated mock type for the DPoPService type
+type DPoPServiceMock struct {
+	mock.Mock
+}
+
+type DPoPServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DPoPServiceMock) EXPECT() *DPoPServiceMock_Expecter {
+	return &DPoPServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// ValidateProof provides a mock function with given fields: ctx, input
+func (_m *DPoPServiceMock) ValidateProof(ctx context.Context, input models.DPoPProofInput) (string, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateProof")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DPoPProofInput) (string, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DPoPProofInput) string); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DPoPProofInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DPoPServiceMock_ValidateProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateProof'
+type DPoPServiceMock_ValidateProof_Call struct {
+	*mock.Call
+}
+
+// ValidateProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.DPoPProofInput
+func (_e *DPoPServiceMock_Expecter) ValidateProof(ctx interface{}, input interface{}) *DPoPServiceMock_ValidateProof_Call {
+	return &DPoPServiceMock_ValidateProof_Call{Call: _e.mock.On("ValidateProof", ctx, input)}
+}
+
+func (_c *DPoPServiceMock_ValidateProof_Call) Run(run func(ctx context.Context, input models.DPoPProofInput)) *DPoPServiceMock_ValidateProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DPoPProofInput))
+	})
+	return _c
+}
+
+func (_c *DPoPServiceMock_ValidateProof_Call) Return(_a0 string, _a1 error) *DPoPServiceMock_ValidateProof_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DPoPServiceMock_ValidateProof_Call) RunAndReturn(run func(context.Context, models.DPoPProofInput) (string, error)) *DPoPServiceMock_ValidateProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDPoPServiceMock creates a new instance of DPoPServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDPoPServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DPoPServiceMock {
+	mock := &DPoPServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
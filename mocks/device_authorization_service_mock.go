@@ -0,0 +1,320 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+)
+
+// DeviceAuthorizationServiceMock is an autogenerated mock type for the DeviceAuthorizationService type
+type DeviceAuthorizationServiceMock struct {
+	mock.Mock
+}
+
+type DeviceAuthorizationServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeviceAuthorizationServiceMock) EXPECT() *DeviceAuthorizationServiceMock_Expecter {
+	return &DeviceAuthorizationServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeDeviceCode provides a mock function with given fields: ctx, deviceCode, clientID
+func (_m *DeviceAuthorizationServiceMock) ConsumeDeviceCode(ctx context.Context, deviceCode string, clientID string) (*entities.DeviceCode, error) {
+	ret := _m.Called(ctx, deviceCode, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeDeviceCode")
+	}
+
+	var r0 *entities.DeviceCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.DeviceCode, error)); ok {
+		return rf(ctx, deviceCode, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.DeviceCode); ok {
+		r0 = rf(ctx, deviceCode, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.DeviceCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, deviceCode, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeDeviceCode'
+type DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call struct {
+	*mock.Call
+}
+
+// ConsumeDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCode string
+//   - clientID string
+func (_e *DeviceAuthorizationServiceMock_Expecter) ConsumeDeviceCode(ctx interface{}, deviceCode interface{}, clientID interface{}) *DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call {
+	return &DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call{Call: _e.mock.On("ConsumeDeviceCode", ctx, deviceCode, clientID)}
+}
+
+func (_c *DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call) Run(run func(ctx context.Context, deviceCode string, clientID string)) *DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call) Return(_a0 *entities.DeviceCode, _a1 error) *DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call) RunAndReturn(run func(context.Context, string, string) (*entities.DeviceCode, error)) *DeviceAuthorizationServiceMock_ConsumeDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDeviceAuthorization provides a mock function with given fields: ctx, input
+func (_m *DeviceAuthorizationServiceMock) CreateDeviceAuthorization(ctx context.Context, input models.DeviceAuthorizationInput) (*models.DeviceAuthorizationResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDeviceAuthorization")
+	}
+
+	var r0 *models.DeviceAuthorizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeviceAuthorizationInput) (*models.DeviceAuthorizationResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeviceAuthorizationInput) *models.DeviceAuthorizationResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DeviceAuthorizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DeviceAuthorizationInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeviceAuthorization'
+type DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call struct {
+	*mock.Call
+}
+
+// CreateDeviceAuthorization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.DeviceAuthorizationInput
+func (_e *DeviceAuthorizationServiceMock_Expecter) CreateDeviceAuthorization(ctx interface{}, input interface{}) *DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call {
+	return &DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call{Call: _e.mock.On("CreateDeviceAuthorization", ctx, input)}
+}
+
+func (_c *DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call) Run(run func(ctx context.Context, input models.DeviceAuthorizationInput)) *DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DeviceAuthorizationInput))
+	})
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call) Return(_a0 *models.DeviceAuthorizationResponse, _a1 error) *DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call) RunAndReturn(run func(context.Context, models.DeviceAuthorizationInput) (*models.DeviceAuthorizationResponse, error)) *DeviceAuthorizationServiceMock_CreateDeviceAuthorization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecideDeviceAuthorization provides a mock function with given fields: ctx, input
+func (_m *DeviceAuthorizationServiceMock) DecideDeviceAuthorization(ctx context.Context, input models.DeviceDecisionInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideDeviceAuthorization")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeviceDecisionInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecideDeviceAuthorization'
+type DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call struct {
+	*mock.Call
+}
+
+// DecideDeviceAuthorization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.DeviceDecisionInput
+func (_e *DeviceAuthorizationServiceMock_Expecter) DecideDeviceAuthorization(ctx interface{}, input interface{}) *DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call {
+	return &DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call{Call: _e.mock.On("DecideDeviceAuthorization", ctx, input)}
+}
+
+func (_c *DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call) Run(run func(ctx context.Context, input models.DeviceDecisionInput)) *DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DeviceDecisionInput))
+	})
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call) Return(_a0 error) *DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call) RunAndReturn(run func(context.Context, models.DeviceDecisionInput) error) *DeviceAuthorizationServiceMock_DecideDeviceAuthorization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeviceVerification provides a mock function with given fields: ctx, userCode
+func (_m *DeviceAuthorizationServiceMock) GetDeviceVerification(ctx context.Context, userCode string) (*models.DeviceVerificationResponse, error) {
+	ret := _m.Called(ctx, userCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeviceVerification")
+	}
+
+	var r0 *models.DeviceVerificationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.DeviceVerificationResponse, error)); ok {
+		return rf(ctx, userCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.DeviceVerificationResponse); ok {
+		r0 = rf(ctx, userCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DeviceVerificationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceAuthorizationServiceMock_GetDeviceVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceVerification'
+type DeviceAuthorizationServiceMock_GetDeviceVerification_Call struct {
+	*mock.Call
+}
+
+// GetDeviceVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCode string
+func (_e *DeviceAuthorizationServiceMock_Expecter) GetDeviceVerification(ctx interface{}, userCode interface{}) *DeviceAuthorizationServiceMock_GetDeviceVerification_Call {
+	return &DeviceAuthorizationServiceMock_GetDeviceVerification_Call{Call: _e.mock.On("GetDeviceVerification", ctx, userCode)}
+}
+
+func (_c *DeviceAuthorizationServiceMock_GetDeviceVerification_Call) Run(run func(ctx context.Context, userCode string)) *DeviceAuthorizationServiceMock_GetDeviceVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_GetDeviceVerification_Call) Return(_a0 *models.DeviceVerificationResponse, _a1 error) *DeviceAuthorizationServiceMock_GetDeviceVerification_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_GetDeviceVerification_Call) RunAndReturn(run func(context.Context, string) (*models.DeviceVerificationResponse, error)) *DeviceAuthorizationServiceMock_GetDeviceVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginRedirectURL provides a mock function with given fields: continueURL
+func (_m *DeviceAuthorizationServiceMock) LoginRedirectURL(continueURL string) (string, error) {
+	ret := _m.Called(continueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginRedirectURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(continueURL)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(continueURL)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(continueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceAuthorizationServiceMock_LoginRedirectURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginRedirectURL'
+type DeviceAuthorizationServiceMock_LoginRedirectURL_Call struct {
+	*mock.Call
+}
+
+// LoginRedirectURL is a helper method to define mock.On call
+//   - continueURL string
+func (_e *DeviceAuthorizationServiceMock_Expecter) LoginRedirectURL(continueURL interface{}) *DeviceAuthorizationServiceMock_LoginRedirectURL_Call {
+	return &DeviceAuthorizationServiceMock_LoginRedirectURL_Call{Call: _e.mock.On("LoginRedirectURL", continueURL)}
+}
+
+func (_c *DeviceAuthorizationServiceMock_LoginRedirectURL_Call) Run(run func(continueURL string)) *DeviceAuthorizationServiceMock_LoginRedirectURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_LoginRedirectURL_Call) Return(_a0 string, _a1 error) *DeviceAuthorizationServiceMock_LoginRedirectURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceAuthorizationServiceMock_LoginRedirectURL_Call) RunAndReturn(run func(string) (string, error)) *DeviceAuthorizationServiceMock_LoginRedirectURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeviceAuthorizationServiceMock creates a new instance of DeviceAuthorizationServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeviceAuthorizationServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeviceAuthorizationServiceMock {
+	mock := &DeviceAuthorizationServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
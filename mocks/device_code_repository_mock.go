@@ -0,0 +1,409 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// DeviceCodeRepositoryMock is an autogenerated mock type for the DeviceCodeRepository type
+type DeviceCodeRepositoryMock struct {
+	mock.Mock
+}
+
+type DeviceCodeRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeviceCodeRepositoryMock) EXPECT() *DeviceCodeRepositoryMock_Expecter {
+	return &DeviceCodeRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Consume provides a mock function with given fields: ctx, id
+func (_m *DeviceCodeRepositoryMock) Consume(ctx context.Context, id string) (*entities.DeviceCode, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 *entities.DeviceCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.DeviceCode, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.DeviceCode); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.DeviceCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceCodeRepositoryMock_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type DeviceCodeRepositoryMock_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DeviceCodeRepositoryMock_Expecter) Consume(ctx interface{}, id interface{}) *DeviceCodeRepositoryMock_Consume_Call {
+	return &DeviceCodeRepositoryMock_Consume_Call{Call: _e.mock.On("Consume", ctx, id)}
+}
+
+func (_c *DeviceCodeRepositoryMock_Consume_Call) Run(run func(ctx context.Context, id string)) *DeviceCodeRepositoryMock_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_Consume_Call) Return(_a0 *entities.DeviceCode, _a1 error) *DeviceCodeRepositoryMock_Consume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_Consume_Call) RunAndReturn(run func(context.Context, string) (*entities.DeviceCode, error)) *DeviceCodeRepositoryMock_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, deviceCode
+func (_m *DeviceCodeRepositoryMock) Create(ctx context.Context, deviceCode *entities.DeviceCode) error {
+	ret := _m.Called(ctx, deviceCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.DeviceCode) error); ok {
+		r0 = rf(ctx, deviceCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceCodeRepositoryMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type DeviceCodeRepositoryMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCode *entities.DeviceCode
+func (_e *DeviceCodeRepositoryMock_Expecter) Create(ctx interface{}, deviceCode interface{}) *DeviceCodeRepositoryMock_Create_Call {
+	return &DeviceCodeRepositoryMock_Create_Call{Call: _e.mock.On("Create", ctx, deviceCode)}
+}
+
+func (_c *DeviceCodeRepositoryMock_Create_Call) Run(run func(ctx context.Context, deviceCode *entities.DeviceCode)) *DeviceCodeRepositoryMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.DeviceCode))
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_Create_Call) Return(_a0 error) *DeviceCodeRepositoryMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_Create_Call) RunAndReturn(run func(context.Context, *entities.DeviceCode) error) *DeviceCodeRepositoryMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Decide provides a mock function with given fields: ctx, userCode, userID, status
+func (_m *DeviceCodeRepositoryMock) Decide(ctx context.Context, userCode string, userID string, status string) error {
+	ret := _m.Called(ctx, userCode, userID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decide")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userCode, userID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceCodeRepositoryMock_Decide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decide'
+type DeviceCodeRepositoryMock_Decide_Call struct {
+	*mock.Call
+}
+
+// Decide is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCode string
+//   - userID string
+//   - status string
+func (_e *DeviceCodeRepositoryMock_Expecter) Decide(ctx interface{}, userCode interface{}, userID interface{}, status interface{}) *DeviceCodeRepositoryMock_Decide_Call {
+	return &DeviceCodeRepositoryMock_Decide_Call{Call: _e.mock.On("Decide", ctx, userCode, userID, status)}
+}
+
+func (_c *DeviceCodeRepositoryMock_Decide_Call) Run(run func(ctx context.Context, userCode string, userID string, status string)) *DeviceCodeRepositoryMock_Decide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_Decide_Call) Return(_a0 error) *DeviceCodeRepositoryMock_Decide_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_Decide_Call) RunAndReturn(run func(context.Context, string, string, string) error) *DeviceCodeRepositoryMock_Decide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DenyApprovedByUserAndClient provides a mock function with given fields: ctx, userID, clientID
+func (_m *DeviceCodeRepositoryMock) DenyApprovedByUserAndClient(ctx context.Context, userID string, clientID string) error {
+	ret := _m.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenyApprovedByUserAndClient")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenyApprovedByUserAndClient'
+type DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call struct {
+	*mock.Call
+}
+
+// DenyApprovedByUserAndClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+func (_e *DeviceCodeRepositoryMock_Expecter) DenyApprovedByUserAndClient(ctx interface{}, userID interface{}, clientID interface{}) *DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call {
+	return &DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call{Call: _e.mock.On("DenyApprovedByUserAndClient", ctx, userID, clientID)}
+}
+
+func (_c *DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call) Run(run func(ctx context.Context, userID string, clientID string)) *DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call) Return(_a0 error) *DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call) RunAndReturn(run func(context.Context, string, string) error) *DeviceCodeRepositoryMock_DenyApprovedByUserAndClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByDeviceCodeHash provides a mock function with given fields: ctx, deviceCodeHash
+func (_m *DeviceCodeRepositoryMock) FindByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (*entities.DeviceCode, error) {
+	ret := _m.Called(ctx, deviceCodeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByDeviceCodeHash")
+	}
+
+	var r0 *entities.DeviceCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.DeviceCode, error)); ok {
+		return rf(ctx, deviceCodeHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.DeviceCode); ok {
+		r0 = rf(ctx, deviceCodeHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.DeviceCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deviceCodeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByDeviceCodeHash'
+type DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call struct {
+	*mock.Call
+}
+
+// FindByDeviceCodeHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceCodeHash string
+func (_e *DeviceCodeRepositoryMock_Expecter) FindByDeviceCodeHash(ctx interface{}, deviceCodeHash interface{}) *DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call {
+	return &DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call{Call: _e.mock.On("FindByDeviceCodeHash", ctx, deviceCodeHash)}
+}
+
+func (_c *DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call) Run(run func(ctx context.Context, deviceCodeHash string)) *DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call) Return(_a0 *entities.DeviceCode, _a1 error) *DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call) RunAndReturn(run func(context.Context, string) (*entities.DeviceCode, error)) *DeviceCodeRepositoryMock_FindByDeviceCodeHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPendingByUserCode provides a mock function with given fields: ctx, userCode
+func (_m *DeviceCodeRepositoryMock) FindPendingByUserCode(ctx context.Context, userCode string) (*entities.DeviceCode, error) {
+	ret := _m.Called(ctx, userCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPendingByUserCode")
+	}
+
+	var r0 *entities.DeviceCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.DeviceCode, error)); ok {
+		return rf(ctx, userCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.DeviceCode); ok {
+		r0 = rf(ctx, userCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.DeviceCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceCodeRepositoryMock_FindPendingByUserCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPendingByUserCode'
+type DeviceCodeRepositoryMock_FindPendingByUserCode_Call struct {
+	*mock.Call
+}
+
+// FindPendingByUserCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userCode string
+func (_e *DeviceCodeRepositoryMock_Expecter) FindPendingByUserCode(ctx interface{}, userCode interface{}) *DeviceCodeRepositoryMock_FindPendingByUserCode_Call {
+	return &DeviceCodeRepositoryMock_FindPendingByUserCode_Call{Call: _e.mock.On("FindPendingByUserCode", ctx, userCode)}
+}
+
+func (_c *DeviceCodeRepositoryMock_FindPendingByUserCode_Call) Run(run func(ctx context.Context, userCode string)) *DeviceCodeRepositoryMock_FindPendingByUserCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_FindPendingByUserCode_Call) Return(_a0 *entities.DeviceCode, _a1 error) *DeviceCodeRepositoryMock_FindPendingByUserCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_FindPendingByUserCode_Call) RunAndReturn(run func(context.Context, string) (*entities.DeviceCode, error)) *DeviceCodeRepositoryMock_FindPendingByUserCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePolling provides a mock function with given fields: ctx, id, polledAt, interval
+func (_m *DeviceCodeRepositoryMock) UpdatePolling(ctx context.Context, id string, polledAt time.Time, interval time.Duration) error {
+	ret := _m.Called(ctx, id, polledAt, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePolling")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Duration) error); ok {
+		r0 = rf(ctx, id, polledAt, interval)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeviceCodeRepositoryMock_UpdatePolling_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePolling'
+type DeviceCodeRepositoryMock_UpdatePolling_Call struct {
+	*mock.Call
+}
+
+// UpdatePolling is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - polledAt time.Time
+//   - interval time.Duration
+func (_e *DeviceCodeRepositoryMock_Expecter) UpdatePolling(ctx interface{}, id interface{}, polledAt interface{}, interval interface{}) *DeviceCodeRepositoryMock_UpdatePolling_Call {
+	return &DeviceCodeRepositoryMock_UpdatePolling_Call{Call: _e.mock.On("UpdatePolling", ctx, id, polledAt, interval)}
+}
+
+func (_c *DeviceCodeRepositoryMock_UpdatePolling_Call) Run(run func(ctx context.Context, id string, polledAt time.Time, interval time.Duration)) *DeviceCodeRepositoryMock_UpdatePolling_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_UpdatePolling_Call) Return(_a0 error) *DeviceCodeRepositoryMock_UpdatePolling_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeviceCodeRepositoryMock_UpdatePolling_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Duration) error) *DeviceCodeRepositoryMock_UpdatePolling_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeviceCodeRepositoryMock creates a new instance of DeviceCodeRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeviceCodeRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeviceCodeRepositoryMock {
+	mock := &DeviceCodeRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
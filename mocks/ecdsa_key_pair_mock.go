@@ -21,19 +21,15 @@ func (_m *EcdsaKeyPairMock) EXPECT() *EcdsaKeyPairMock_Expecter {
 	return &EcdsaKeyPairMock_Expecter{mock: &_m.Mock}
 }
 
-// ParseECDSAPrivateKey provides a mock function with no fields
-func (_m *EcdsaKeyPairMock) ParseECDSAPrivateKey() (*ecdsa.PrivateKey, error) {
+// PrivateKey provides a mock function with no fields
+func (_m *EcdsaKeyPairMock) PrivateKey() *ecdsa.PrivateKey {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ParseECDSAPrivateKey")
+		panic("no return value specified for PrivateKey")
 	}
 
 	var r0 *ecdsa.PrivateKey
-	var r1 error
-	if rf, ok := ret.Get(0).(func() (*ecdsa.PrivateKey, error)); ok {
-		return rf()
-	}
 	if rf, ok := ret.Get(0).(func() *ecdsa.PrivateKey); ok {
 		r0 = rf()
 	} else {
@@ -42,55 +38,45 @@ func (_m *EcdsaKeyPairMock) ParseECDSAPrivateKey() (*ecdsa.PrivateKey, error) {
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// EcdsaKeyPairMock_ParseECDSAPrivateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseECDSAPrivateKey'
-type EcdsaKeyPairMock_ParseECDSAPrivateKey_Call struct {
+// EcdsaKeyPairMock_PrivateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrivateKey'
+type EcdsaKeyPairMock_PrivateKey_Call struct {
 	*mock.Call
 }
 
-// ParseECDSAPrivateKey is a helper method to define mock.On call
-func (_e *EcdsaKeyPairMock_Expecter) ParseECDSAPrivateKey() *EcdsaKeyPairMock_ParseECDSAPrivateKey_Call {
-	return &EcdsaKeyPairMock_ParseECDSAPrivateKey_Call{Call: _e.mock.On("ParseECDSAPrivateKey")}
+// PrivateKey is a helper method to define mock.On call
+func (_e *EcdsaKeyPairMock_Expecter) PrivateKey() *EcdsaKeyPairMock_PrivateKey_Call {
+	return &EcdsaKeyPairMock_PrivateKey_Call{Call: _e.mock.On("PrivateKey")}
 }
 
-func (_c *EcdsaKeyPairMock_ParseECDSAPrivateKey_Call) Run(run func()) *EcdsaKeyPairMock_ParseECDSAPrivateKey_Call {
+func (_c *EcdsaKeyPairMock_PrivateKey_Call) Run(run func()) *EcdsaKeyPairMock_PrivateKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *EcdsaKeyPairMock_ParseECDSAPrivateKey_Call) Return(_a0 *ecdsa.PrivateKey, _a1 error) *EcdsaKeyPairMock_ParseECDSAPrivateKey_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *EcdsaKeyPairMock_PrivateKey_Call) Return(_a0 *ecdsa.PrivateKey) *EcdsaKeyPairMock_PrivateKey_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *EcdsaKeyPairMock_ParseECDSAPrivateKey_Call) RunAndReturn(run func() (*ecdsa.PrivateKey, error)) *EcdsaKeyPairMock_ParseECDSAPrivateKey_Call {
+func (_c *EcdsaKeyPairMock_PrivateKey_Call) RunAndReturn(run func() *ecdsa.PrivateKey) *EcdsaKeyPairMock_PrivateKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ParseECDSAPublicKey provides a mock function with no fields
-func (_m *EcdsaKeyPairMock) ParseECDSAPublicKey() (*ecdsa.PublicKey, error) {
+// PublicKey provides a mock function with no fields
+func (_m *EcdsaKeyPairMock) PublicKey() *ecdsa.PublicKey {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ParseECDSAPublicKey")
+		panic("no return value specified for PublicKey")
 	}
 
 	var r0 *ecdsa.PublicKey
-	var r1 error
-	if rf, ok := ret.Get(0).(func() (*ecdsa.PublicKey, error)); ok {
-		return rf()
-	}
 	if rf, ok := ret.Get(0).(func() *ecdsa.PublicKey); ok {
 		r0 = rf()
 	} else {
@@ -99,38 +85,32 @@ func (_m *EcdsaKeyPairMock) ParseECDSAPublicKey() (*ecdsa.PublicKey, error) {
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// EcdsaKeyPairMock_ParseECDSAPublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseECDSAPublicKey'
-type EcdsaKeyPairMock_ParseECDSAPublicKey_Call struct {
+// EcdsaKeyPairMock_PublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublicKey'
+type EcdsaKeyPairMock_PublicKey_Call struct {
 	*mock.Call
 }
 
-// ParseECDSAPublicKey is a helper method to define mock.On call
-func (_e *EcdsaKeyPairMock_Expecter) ParseECDSAPublicKey() *EcdsaKeyPairMock_ParseECDSAPublicKey_Call {
-	return &EcdsaKeyPairMock_ParseECDSAPublicKey_Call{Call: _e.mock.On("ParseECDSAPublicKey")}
+// PublicKey is a helper method to define mock.On call
+func (_e *EcdsaKeyPairMock_Expecter) PublicKey() *EcdsaKeyPairMock_PublicKey_Call {
+	return &EcdsaKeyPairMock_PublicKey_Call{Call: _e.mock.On("PublicKey")}
 }
 
-func (_c *EcdsaKeyPairMock_ParseECDSAPublicKey_Call) Run(run func()) *EcdsaKeyPairMock_ParseECDSAPublicKey_Call {
+func (_c *EcdsaKeyPairMock_PublicKey_Call) Run(run func()) *EcdsaKeyPairMock_PublicKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *EcdsaKeyPairMock_ParseECDSAPublicKey_Call) Return(_a0 *ecdsa.PublicKey, _a1 error) *EcdsaKeyPairMock_ParseECDSAPublicKey_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *EcdsaKeyPairMock_PublicKey_Call) Return(_a0 *ecdsa.PublicKey) *EcdsaKeyPairMock_PublicKey_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *EcdsaKeyPairMock_ParseECDSAPublicKey_Call) RunAndReturn(run func() (*ecdsa.PublicKey, error)) *EcdsaKeyPairMock_ParseECDSAPublicKey_Call {
+func (_c *EcdsaKeyPairMock_PublicKey_Call) RunAndReturn(run func() *ecdsa.PublicKey) *EcdsaKeyPairMock_PublicKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -24,9 +24,9 @@ func (_m *JWTServiceMock) EXPECT() *JWTServiceMock_Expecter {
 	return &JWTServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// GenerateAccessTokenJWT provides a mock function with given fields: ctx, userID, expiresAt
-func (_m *JWTServiceMock) GenerateAccessTokenJWT(ctx context.Context, userID string, expiresAt time.Time) (string, error) {
-	ret := _m.Called(ctx, userID, expiresAt)
+// GenerateAccessTokenJWT provides a mock function with given fields: ctx, input
+func (_m *JWTServiceMock) GenerateAccessTokenJWT(ctx context.Context, input models.AccessTokenInput) (string, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateAccessTokenJWT")
@@ -34,17 +34,17 @@ func (_m *JWTServiceMock) GenerateAccessTokenJWT(ctx context.Context, userID str
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (string, error)); ok {
-		return rf(ctx, userID, expiresAt)
+	if rf, ok := ret.Get(0).(func(context.Context, models.AccessTokenInput) (string, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) string); ok {
-		r0 = rf(ctx, userID, expiresAt)
+	if rf, ok := ret.Get(0).(func(context.Context, models.AccessTokenInput) string); ok {
+		r0 = rf(ctx, input)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
-		r1 = rf(ctx, userID, expiresAt)
+	if rf, ok := ret.Get(1).(func(context.Context, models.AccessTokenInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,15 +59,14 @@ type JWTServiceMock_GenerateAccessTokenJWT_Call struct {
 
 // GenerateAccessTokenJWT is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - expiresAt time.Time
-func (_e *JWTServiceMock_Expecter) GenerateAccessTokenJWT(ctx interface{}, userID interface{}, expiresAt interface{}) *JWTServiceMock_GenerateAccessTokenJWT_Call {
-	return &JWTServiceMock_GenerateAccessTokenJWT_Call{Call: _e.mock.On("GenerateAccessTokenJWT", ctx, userID, expiresAt)}
+//   - input models.AccessTokenInput
+func (_e *JWTServiceMock_Expecter) GenerateAccessTokenJWT(ctx interface{}, input interface{}) *JWTServiceMock_GenerateAccessTokenJWT_Call {
+	return &JWTServiceMock_GenerateAccessTokenJWT_Call{Call: _e.mock.On("GenerateAccessTokenJWT", ctx, input)}
 }
 
-func (_c *JWTServiceMock_GenerateAccessTokenJWT_Call) Run(run func(ctx context.Context, userID string, expiresAt time.Time)) *JWTServiceMock_GenerateAccessTokenJWT_Call {
+func (_c *JWTServiceMock_GenerateAccessTokenJWT_Call) Run(run func(ctx context.Context, input models.AccessTokenInput)) *JWTServiceMock_GenerateAccessTokenJWT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+		run(args[0].(context.Context), args[1].(models.AccessTokenInput))
 	})
 	return _c
 }
@@ -77,14 +76,14 @@ func (_c *JWTServiceMock_GenerateAccessTokenJWT_Call) Return(_a0 string, _a1 err
 	return _c
 }
 
-func (_c *JWTServiceMock_GenerateAccessTokenJWT_Call) RunAndReturn(run func(context.Context, string, time.Time) (string, error)) *JWTServiceMock_GenerateAccessTokenJWT_Call {
+func (_c *JWTServiceMock_GenerateAccessTokenJWT_Call) RunAndReturn(run func(context.Context, models.AccessTokenInput) (string, error)) *JWTServiceMock_GenerateAccessTokenJWT_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenerateIDTokenJWT provides a mock function with given fields: ctx, userID, name, email, expiresAt
-func (_m *JWTServiceMock) GenerateIDTokenJWT(ctx context.Context, userID string, name string, email string, expiresAt time.Duration) (string, error) {
-	ret := _m.Called(ctx, userID, name, email, expiresAt)
+// GenerateIDTokenJWT provides a mock function with given fields: ctx, input
+func (_m *JWTServiceMock) GenerateIDTokenJWT(ctx context.Context, input models.IDTokenInput) (string, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateIDTokenJWT")
@@ -92,17 +91,17 @@ func (_m *JWTServiceMock) GenerateIDTokenJWT(ctx context.Context, userID string,
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) (string, error)); ok {
-		return rf(ctx, userID, name, email, expiresAt)
+	if rf, ok := ret.Get(0).(func(context.Context, models.IDTokenInput) (string, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) string); ok {
-		r0 = rf(ctx, userID, name, email, expiresAt)
+	if rf, ok := ret.Get(0).(func(context.Context, models.IDTokenInput) string); ok {
+		r0 = rf(ctx, input)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, time.Duration) error); ok {
-		r1 = rf(ctx, userID, name, email, expiresAt)
+	if rf, ok := ret.Get(1).(func(context.Context, models.IDTokenInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -117,17 +116,14 @@ type JWTServiceMock_GenerateIDTokenJWT_Call struct {
 
 // GenerateIDTokenJWT is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - name string
-//   - email string
-//   - expiresAt time.Duration
-func (_e *JWTServiceMock_Expecter) GenerateIDTokenJWT(ctx interface{}, userID interface{}, name interface{}, email interface{}, expiresAt interface{}) *JWTServiceMock_GenerateIDTokenJWT_Call {
-	return &JWTServiceMock_GenerateIDTokenJWT_Call{Call: _e.mock.On("GenerateIDTokenJWT", ctx, userID, name, email, expiresAt)}
+//   - input models.IDTokenInput
+func (_e *JWTServiceMock_Expecter) GenerateIDTokenJWT(ctx interface{}, input interface{}) *JWTServiceMock_GenerateIDTokenJWT_Call {
+	return &JWTServiceMock_GenerateIDTokenJWT_Call{Call: _e.mock.On("GenerateIDTokenJWT", ctx, input)}
 }
 
-func (_c *JWTServiceMock_GenerateIDTokenJWT_Call) Run(run func(ctx context.Context, userID string, name string, email string, expiresAt time.Duration)) *JWTServiceMock_GenerateIDTokenJWT_Call {
+func (_c *JWTServiceMock_GenerateIDTokenJWT_Call) Run(run func(ctx context.Context, input models.IDTokenInput)) *JWTServiceMock_GenerateIDTokenJWT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Duration))
+		run(args[0].(context.Context), args[1].(models.IDTokenInput))
 	})
 	return _c
 }
@@ -137,7 +133,7 @@ func (_c *JWTServiceMock_GenerateIDTokenJWT_Call) Return(_a0 string, _a1 error)
 	return _c
 }
 
-func (_c *JWTServiceMock_GenerateIDTokenJWT_Call) RunAndReturn(run func(context.Context, string, string, string, time.Duration) (string, error)) *JWTServiceMock_GenerateIDTokenJWT_Call {
+func (_c *JWTServiceMock_GenerateIDTokenJWT_Call) RunAndReturn(run func(context.Context, models.IDTokenInput) (string, error)) *JWTServiceMock_GenerateIDTokenJWT_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -200,6 +196,180 @@ func (_c *JWTServiceMock_GenerateOTPTokenJWT_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GenerateSessionTokenJWT provides a mock function with given fields: ctx, userID, expiresAt
+func (_m *JWTServiceMock) GenerateSessionTokenJWT(ctx context.Context, userID string, expiresAt time.Time) (string, error) {
+	ret := _m.Called(ctx, userID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateSessionTokenJWT")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (string, error)); ok {
+		return rf(ctx, userID, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) string); ok {
+		r0 = rf(ctx, userID, expiresAt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTServiceMock_GenerateSessionTokenJWT_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSessionTokenJWT'
+type JWTServiceMock_GenerateSessionTokenJWT_Call struct {
+	*mock.Call
+}
+
+// GenerateSessionTokenJWT is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - expiresAt time.Time
+func (_e *JWTServiceMock_Expecter) GenerateSessionTokenJWT(ctx interface{}, userID interface{}, expiresAt interface{}) *JWTServiceMock_GenerateSessionTokenJWT_Call {
+	return &JWTServiceMock_GenerateSessionTokenJWT_Call{Call: _e.mock.On("GenerateSessionTokenJWT", ctx, userID, expiresAt)}
+}
+
+func (_c *JWTServiceMock_GenerateSessionTokenJWT_Call) Run(run func(ctx context.Context, userID string, expiresAt time.Time)) *JWTServiceMock_GenerateSessionTokenJWT_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_GenerateSessionTokenJWT_Call) Return(_a0 string, _a1 error) *JWTServiceMock_GenerateSessionTokenJWT_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTServiceMock_GenerateSessionTokenJWT_Call) RunAndReturn(run func(context.Context, string, time.Time) (string, error)) *JWTServiceMock_GenerateSessionTokenJWT_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateUserInfoJWT provides a mock function with given fields: ctx, algorithm, claims
+func (_m *JWTServiceMock) GenerateUserInfoJWT(ctx context.Context, algorithm string, claims models.UserInfoClaims) (string, error) {
+	ret := _m.Called(ctx, algorithm, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateUserInfoJWT")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UserInfoClaims) (string, error)); ok {
+		return rf(ctx, algorithm, claims)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.UserInfoClaims) string); ok {
+		r0 = rf(ctx, algorithm, claims)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.UserInfoClaims) error); ok {
+		r1 = rf(ctx, algorithm, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTServiceMock_GenerateUserInfoJWT_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateUserInfoJWT'
+type JWTServiceMock_GenerateUserInfoJWT_Call struct {
+	*mock.Call
+}
+
+// GenerateUserInfoJWT is a helper method to define mock.On call
+//   - ctx context.Context
+//   - algorithm string
+//   - claims models.UserInfoClaims
+func (_e *JWTServiceMock_Expecter) GenerateUserInfoJWT(ctx interface{}, algorithm interface{}, claims interface{}) *JWTServiceMock_GenerateUserInfoJWT_Call {
+	return &JWTServiceMock_GenerateUserInfoJWT_Call{Call: _e.mock.On("GenerateUserInfoJWT", ctx, algorithm, claims)}
+}
+
+func (_c *JWTServiceMock_GenerateUserInfoJWT_Call) Run(run func(ctx context.Context, algorithm string, claims models.UserInfoClaims)) *JWTServiceMock_GenerateUserInfoJWT_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.UserInfoClaims))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_GenerateUserInfoJWT_Call) Return(_a0 string, _a1 error) *JWTServiceMock_GenerateUserInfoJWT_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTServiceMock_GenerateUserInfoJWT_Call) RunAndReturn(run func(context.Context, string, models.UserInfoClaims) (string, error)) *JWTServiceMock_GenerateUserInfoJWT_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJWKS provides a mock function with given fields: ctx
+func (_m *JWTServiceMock) GetJWKS(ctx context.Context) (*models.JWKSResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJWKS")
+	}
+
+	var r0 *models.JWKSResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.JWKSResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.JWKSResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.JWKSResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTServiceMock_GetJWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJWKS'
+type JWTServiceMock_GetJWKS_Call struct {
+	*mock.Call
+}
+
+// GetJWKS is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JWTServiceMock_Expecter) GetJWKS(ctx interface{}) *JWTServiceMock_GetJWKS_Call {
+	return &JWTServiceMock_GetJWKS_Call{Call: _e.mock.On("GetJWKS", ctx)}
+}
+
+func (_c *JWTServiceMock_GetJWKS_Call) Run(run func(ctx context.Context)) *JWTServiceMock_GetJWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_GetJWKS_Call) Return(_a0 *models.JWKSResponse, _a1 error) *JWTServiceMock_GetJWKS_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTServiceMock_GetJWKS_Call) RunAndReturn(run func(context.Context) (*models.JWKSResponse, error)) *JWTServiceMock_GetJWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateAccessTokenJWT provides a mock function with given fields: ctx, token
 func (_m *JWTServiceMock) ValidateAccessTokenJWT(ctx context.Context, token string) (models.AccessTokenClaims, error) {
 	ret := _m.Called(ctx, token)
@@ -257,6 +427,63 @@ func (_c *JWTServiceMock_ValidateAccessTokenJWT_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ValidateIDTokenHint provides a mock function with given fields: ctx, token
+func (_m *JWTServiceMock) ValidateIDTokenHint(ctx context.Context, token string) (models.IDTokenClaims, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateIDTokenHint")
+	}
+
+	var r0 models.IDTokenClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.IDTokenClaims, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.IDTokenClaims); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(models.IDTokenClaims)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTServiceMock_ValidateIDTokenHint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateIDTokenHint'
+type JWTServiceMock_ValidateIDTokenHint_Call struct {
+	*mock.Call
+}
+
+// ValidateIDTokenHint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *JWTServiceMock_Expecter) ValidateIDTokenHint(ctx interface{}, token interface{}) *JWTServiceMock_ValidateIDTokenHint_Call {
+	return &JWTServiceMock_ValidateIDTokenHint_Call{Call: _e.mock.On("ValidateIDTokenHint", ctx, token)}
+}
+
+func (_c *JWTServiceMock_ValidateIDTokenHint_Call) Run(run func(ctx context.Context, token string)) *JWTServiceMock_ValidateIDTokenHint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_ValidateIDTokenHint_Call) Return(_a0 models.IDTokenClaims, _a1 error) *JWTServiceMock_ValidateIDTokenHint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTServiceMock_ValidateIDTokenHint_Call) RunAndReturn(run func(context.Context, string) (models.IDTokenClaims, error)) *JWTServiceMock_ValidateIDTokenHint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateOTPTokenJWT provides a mock function with given fields: ctx, token
 func (_m *JWTServiceMock) ValidateOTPTokenJWT(ctx context.Context, token string) (models.OTPTokenClaims, error) {
 	ret := _m.Called(ctx, token)
@@ -314,6 +541,63 @@ func (_c *JWTServiceMock_ValidateOTPTokenJWT_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ValidateSessionTokenJWT provides a mock function with given fields: ctx, token
+func (_m *JWTServiceMock) ValidateSessionTokenJWT(ctx context.Context, token string) (models.AccessTokenClaims, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateSessionTokenJWT")
+	}
+
+	var r0 models.AccessTokenClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.AccessTokenClaims, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.AccessTokenClaims); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(models.AccessTokenClaims)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTServiceMock_ValidateSessionTokenJWT_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateSessionTokenJWT'
+type JWTServiceMock_ValidateSessionTokenJWT_Call struct {
+	*mock.Call
+}
+
+// ValidateSessionTokenJWT is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *JWTServiceMock_Expecter) ValidateSessionTokenJWT(ctx interface{}, token interface{}) *JWTServiceMock_ValidateSessionTokenJWT_Call {
+	return &JWTServiceMock_ValidateSessionTokenJWT_Call{Call: _e.mock.On("ValidateSessionTokenJWT", ctx, token)}
+}
+
+func (_c *JWTServiceMock_ValidateSessionTokenJWT_Call) Run(run func(ctx context.Context, token string)) *JWTServiceMock_ValidateSessionTokenJWT_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_ValidateSessionTokenJWT_Call) Return(_a0 models.AccessTokenClaims, _a1 error) *JWTServiceMock_ValidateSessionTokenJWT_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTServiceMock_ValidateSessionTokenJWT_Call) RunAndReturn(run func(context.Context, string) (models.AccessTokenClaims, error)) *JWTServiceMock_ValidateSessionTokenJWT_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewJWTServiceMock creates a new instance of JWTServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewJWTServiceMock(t interface {
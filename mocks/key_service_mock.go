@@ -0,0 +1,447 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	signer "github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+)
+
+// KeyServiceMock is an autogenerated mock type for the KeyService type
+type KeyServiceMock struct {
+	mock.Mock
+}
+
+type KeyServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KeyServiceMock) EXPECT() *KeyServiceMock_Expecter {
+	return &KeyServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// EnsureSigningKeys provides a mock function with given fields: ctx
+func (_m *KeyServiceMock) EnsureSigningKeys(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureSigningKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KeyServiceMock_EnsureSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureSigningKeys'
+type KeyServiceMock_EnsureSigningKeys_Call struct {
+	*mock.Call
+}
+
+// EnsureSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KeyServiceMock_Expecter) EnsureSigningKeys(ctx interface{}) *KeyServiceMock_EnsureSigningKeys_Call {
+	return &KeyServiceMock_EnsureSigningKeys_Call{Call: _e.mock.On("EnsureSigningKeys", ctx)}
+}
+
+func (_c *KeyServiceMock_EnsureSigningKeys_Call) Run(run func(ctx context.Context)) *KeyServiceMock_EnsureSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_EnsureSigningKeys_Call) Return(_a0 error) *KeyServiceMock_EnsureSigningKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KeyServiceMock_EnsureSigningKeys_Call) RunAndReturn(run func(context.Context) error) *KeyServiceMock_EnsureSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveSigningKey provides a mock function with given fields: ctx, algorithm
+func (_m *KeyServiceMock) GetActiveSigningKey(ctx context.Context, algorithm string) (*signer.Key, error) {
+	ret := _m.Called(ctx, algorithm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSigningKey")
+	}
+
+	var r0 *signer.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*signer.Key, error)); ok {
+		return rf(ctx, algorithm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *signer.Key); ok {
+		r0 = rf(ctx, algorithm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*signer.Key)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, algorithm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeyServiceMock_GetActiveSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSigningKey'
+type KeyServiceMock_GetActiveSigningKey_Call struct {
+	*mock.Call
+}
+
+// GetActiveSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - algorithm string
+func (_e *KeyServiceMock_Expecter) GetActiveSigningKey(ctx interface{}, algorithm interface{}) *KeyServiceMock_GetActiveSigningKey_Call {
+	return &KeyServiceMock_GetActiveSigningKey_Call{Call: _e.mock.On("GetActiveSigningKey", ctx, algorithm)}
+}
+
+func (_c *KeyServiceMock_GetActiveSigningKey_Call) Run(run func(ctx context.Context, algorithm string)) *KeyServiceMock_GetActiveSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_GetActiveSigningKey_Call) Return(_a0 *signer.Key, _a1 error) *KeyServiceMock_GetActiveSigningKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeyServiceMock_GetActiveSigningKey_Call) RunAndReturn(run func(context.Context, string) (*signer.Key, error)) *KeyServiceMock_GetActiveSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerificationKey provides a mock function with given fields: ctx, kid
+func (_m *KeyServiceMock) GetVerificationKey(ctx context.Context, kid string) (*signer.Key, error) {
+	ret := _m.Called(ctx, kid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerificationKey")
+	}
+
+	var r0 *signer.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*signer.Key, error)); ok {
+		return rf(ctx, kid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *signer.Key); ok {
+		r0 = rf(ctx, kid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*signer.Key)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeyServiceMock_GetVerificationKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerificationKey'
+type KeyServiceMock_GetVerificationKey_Call struct {
+	*mock.Call
+}
+
+// GetVerificationKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kid string
+func (_e *KeyServiceMock_Expecter) GetVerificationKey(ctx interface{}, kid interface{}) *KeyServiceMock_GetVerificationKey_Call {
+	return &KeyServiceMock_GetVerificationKey_Call{Call: _e.mock.On("GetVerificationKey", ctx, kid)}
+}
+
+func (_c *KeyServiceMock_GetVerificationKey_Call) Run(run func(ctx context.Context, kid string)) *KeyServiceMock_GetVerificationKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_GetVerificationKey_Call) Return(_a0 *signer.Key, _a1 error) *KeyServiceMock_GetVerificationKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeyServiceMock_GetVerificationKey_Call) RunAndReturn(run func(context.Context, string) (*signer.Key, error)) *KeyServiceMock_GetVerificationKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerificationKeys provides a mock function with given fields: ctx
+func (_m *KeyServiceMock) GetVerificationKeys(ctx context.Context) []*signer.Key {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerificationKeys")
+	}
+
+	var r0 []*signer.Key
+	if rf, ok := ret.Get(0).(func(context.Context) []*signer.Key); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*signer.Key)
+		}
+	}
+
+	return r0
+}
+
+// KeyServiceMock_GetVerificationKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerificationKeys'
+type KeyServiceMock_GetVerificationKeys_Call struct {
+	*mock.Call
+}
+
+// GetVerificationKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KeyServiceMock_Expecter) GetVerificationKeys(ctx interface{}) *KeyServiceMock_GetVerificationKeys_Call {
+	return &KeyServiceMock_GetVerificationKeys_Call{Call: _e.mock.On("GetVerificationKeys", ctx)}
+}
+
+func (_c *KeyServiceMock_GetVerificationKeys_Call) Run(run func(ctx context.Context)) *KeyServiceMock_GetVerificationKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_GetVerificationKeys_Call) Return(_a0 []*signer.Key) *KeyServiceMock_GetVerificationKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KeyServiceMock_GetVerificationKeys_Call) RunAndReturn(run func(context.Context) []*signer.Key) *KeyServiceMock_GetVerificationKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListVerificationKeys provides a mock function with given fields: ctx
+func (_m *KeyServiceMock) ListVerificationKeys(ctx context.Context) ([]*entities.SigningKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVerificationKeys")
+	}
+
+	var r0 []*entities.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entities.SigningKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entities.SigningKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeyServiceMock_ListVerificationKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVerificationKeys'
+type KeyServiceMock_ListVerificationKeys_Call struct {
+	*mock.Call
+}
+
+// ListVerificationKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KeyServiceMock_Expecter) ListVerificationKeys(ctx interface{}) *KeyServiceMock_ListVerificationKeys_Call {
+	return &KeyServiceMock_ListVerificationKeys_Call{Call: _e.mock.On("ListVerificationKeys", ctx)}
+}
+
+func (_c *KeyServiceMock_ListVerificationKeys_Call) Run(run func(ctx context.Context)) *KeyServiceMock_ListVerificationKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_ListVerificationKeys_Call) Return(_a0 []*entities.SigningKey, _a1 error) *KeyServiceMock_ListVerificationKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeyServiceMock_ListVerificationKeys_Call) RunAndReturn(run func(context.Context) ([]*entities.SigningKey, error)) *KeyServiceMock_ListVerificationKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReloadSigningKeys provides a mock function with given fields: ctx
+func (_m *KeyServiceMock) ReloadSigningKeys(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadSigningKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KeyServiceMock_ReloadSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadSigningKeys'
+type KeyServiceMock_ReloadSigningKeys_Call struct {
+	*mock.Call
+}
+
+// ReloadSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KeyServiceMock_Expecter) ReloadSigningKeys(ctx interface{}) *KeyServiceMock_ReloadSigningKeys_Call {
+	return &KeyServiceMock_ReloadSigningKeys_Call{Call: _e.mock.On("ReloadSigningKeys", ctx)}
+}
+
+func (_c *KeyServiceMock_ReloadSigningKeys_Call) Run(run func(ctx context.Context)) *KeyServiceMock_ReloadSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_ReloadSigningKeys_Call) Return(_a0 error) *KeyServiceMock_ReloadSigningKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KeyServiceMock_ReloadSigningKeys_Call) RunAndReturn(run func(context.Context) error) *KeyServiceMock_ReloadSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSigningKeys provides a mock function with given fields: ctx, algorithm
+func (_m *KeyServiceMock) RotateSigningKeys(ctx context.Context, algorithm string) (*entities.SigningKey, error) {
+	ret := _m.Called(ctx, algorithm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSigningKeys")
+	}
+
+	var r0 *entities.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.SigningKey, error)); ok {
+		return rf(ctx, algorithm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.SigningKey); ok {
+		r0 = rf(ctx, algorithm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, algorithm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeyServiceMock_RotateSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSigningKeys'
+type KeyServiceMock_RotateSigningKeys_Call struct {
+	*mock.Call
+}
+
+// RotateSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - algorithm string
+func (_e *KeyServiceMock_Expecter) RotateSigningKeys(ctx interface{}, algorithm interface{}) *KeyServiceMock_RotateSigningKeys_Call {
+	return &KeyServiceMock_RotateSigningKeys_Call{Call: _e.mock.On("RotateSigningKeys", ctx, algorithm)}
+}
+
+func (_c *KeyServiceMock_RotateSigningKeys_Call) Run(run func(ctx context.Context, algorithm string)) *KeyServiceMock_RotateSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_RotateSigningKeys_Call) Return(_a0 *entities.SigningKey, _a1 error) *KeyServiceMock_RotateSigningKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeyServiceMock_RotateSigningKeys_Call) RunAndReturn(run func(context.Context, string) (*entities.SigningKey, error)) *KeyServiceMock_RotateSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchSigningKeys provides a mock function with given fields: ctx
+func (_m *KeyServiceMock) WatchSigningKeys(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// KeyServiceMock_WatchSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchSigningKeys'
+type KeyServiceMock_WatchSigningKeys_Call struct {
+	*mock.Call
+}
+
+// WatchSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KeyServiceMock_Expecter) WatchSigningKeys(ctx interface{}) *KeyServiceMock_WatchSigningKeys_Call {
+	return &KeyServiceMock_WatchSigningKeys_Call{Call: _e.mock.On("WatchSigningKeys", ctx)}
+}
+
+func (_c *KeyServiceMock_WatchSigningKeys_Call) Run(run func(ctx context.Context)) *KeyServiceMock_WatchSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KeyServiceMock_WatchSigningKeys_Call) Return() *KeyServiceMock_WatchSigningKeys_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *KeyServiceMock_WatchSigningKeys_Call) RunAndReturn(run func(context.Context)) *KeyServiceMock_WatchSigningKeys_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewKeyServiceMock creates a new instance of KeyServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKeyServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KeyServiceMock {
+	mock := &KeyServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
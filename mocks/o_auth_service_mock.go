@@ -81,6 +81,65 @@ func (_c *OAuthServiceMock_Authorize_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ClientCredentials provides a mock function with given fields: ctx, input
+func (_m *OAuthServiceMock) ClientCredentials(ctx context.Context, input models.ClientCredentialsInput) (*models.TokenResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientCredentials")
+	}
+
+	var r0 *models.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ClientCredentialsInput) (*models.TokenResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ClientCredentialsInput) *models.TokenResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ClientCredentialsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthServiceMock_ClientCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientCredentials'
+type OAuthServiceMock_ClientCredentials_Call struct {
+	*mock.Call
+}
+
+// ClientCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.ClientCredentialsInput
+func (_e *OAuthServiceMock_Expecter) ClientCredentials(ctx interface{}, input interface{}) *OAuthServiceMock_ClientCredentials_Call {
+	return &OAuthServiceMock_ClientCredentials_Call{Call: _e.mock.On("ClientCredentials", ctx, input)}
+}
+
+func (_c *OAuthServiceMock_ClientCredentials_Call) Run(run func(ctx context.Context, input models.ClientCredentialsInput)) *OAuthServiceMock_ClientCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ClientCredentialsInput))
+	})
+	return _c
+}
+
+func (_c *OAuthServiceMock_ClientCredentials_Call) Return(_a0 *models.TokenResponse, _a1 error) *OAuthServiceMock_ClientCredentials_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthServiceMock_ClientCredentials_Call) RunAndReturn(run func(context.Context, models.ClientCredentialsInput) (*models.TokenResponse, error)) *OAuthServiceMock_ClientCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExchangeCodeForToken provides a mock function with given fields: ctx, input
 func (_m *OAuthServiceMock) ExchangeCodeForToken(ctx context.Context, input models.ExchangeAuthorizationCodeInput) (*models.TokenResponse, error) {
 	ret := _m.Called(ctx, input)
@@ -140,6 +199,183 @@ func (_c *OAuthServiceMock_ExchangeCodeForToken_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ExchangeDeviceCode provides a mock function with given fields: ctx, input
+func (_m *OAuthServiceMock) ExchangeDeviceCode(ctx context.Context, input models.DeviceCodeInput) (*models.TokenResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeDeviceCode")
+	}
+
+	var r0 *models.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeviceCodeInput) (*models.TokenResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeviceCodeInput) *models.TokenResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DeviceCodeInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthServiceMock_ExchangeDeviceCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExchangeDeviceCode'
+type OAuthServiceMock_ExchangeDeviceCode_Call struct {
+	*mock.Call
+}
+
+// ExchangeDeviceCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.DeviceCodeInput
+func (_e *OAuthServiceMock_Expecter) ExchangeDeviceCode(ctx interface{}, input interface{}) *OAuthServiceMock_ExchangeDeviceCode_Call {
+	return &OAuthServiceMock_ExchangeDeviceCode_Call{Call: _e.mock.On("ExchangeDeviceCode", ctx, input)}
+}
+
+func (_c *OAuthServiceMock_ExchangeDeviceCode_Call) Run(run func(ctx context.Context, input models.DeviceCodeInput)) *OAuthServiceMock_ExchangeDeviceCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DeviceCodeInput))
+	})
+	return _c
+}
+
+func (_c *OAuthServiceMock_ExchangeDeviceCode_Call) Return(_a0 *models.TokenResponse, _a1 error) *OAuthServiceMock_ExchangeDeviceCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthServiceMock_ExchangeDeviceCode_Call) RunAndReturn(run func(context.Context, models.DeviceCodeInput) (*models.TokenResponse, error)) *OAuthServiceMock_ExchangeDeviceCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PushAuthorizationRequest provides a mock function with given fields: ctx, input
+func (_m *OAuthServiceMock) PushAuthorizationRequest(ctx context.Context, input models.AuthorizeInput) (*models.PushedAuthorizationResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PushAuthorizationRequest")
+	}
+
+	var r0 *models.PushedAuthorizationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuthorizeInput) (*models.PushedAuthorizationResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuthorizeInput) *models.PushedAuthorizationResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PushedAuthorizationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuthorizeInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthServiceMock_PushAuthorizationRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushAuthorizationRequest'
+type OAuthServiceMock_PushAuthorizationRequest_Call struct {
+	*mock.Call
+}
+
+// PushAuthorizationRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.AuthorizeInput
+func (_e *OAuthServiceMock_Expecter) PushAuthorizationRequest(ctx interface{}, input interface{}) *OAuthServiceMock_PushAuthorizationRequest_Call {
+	return &OAuthServiceMock_PushAuthorizationRequest_Call{Call: _e.mock.On("PushAuthorizationRequest", ctx, input)}
+}
+
+func (_c *OAuthServiceMock_PushAuthorizationRequest_Call) Run(run func(ctx context.Context, input models.AuthorizeInput)) *OAuthServiceMock_PushAuthorizationRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.AuthorizeInput))
+	})
+	return _c
+}
+
+func (_c *OAuthServiceMock_PushAuthorizationRequest_Call) Return(_a0 *models.PushedAuthorizationResponse, _a1 error) *OAuthServiceMock_PushAuthorizationRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthServiceMock_PushAuthorizationRequest_Call) RunAndReturn(run func(context.Context, models.AuthorizeInput) (*models.PushedAuthorizationResponse, error)) *OAuthServiceMock_PushAuthorizationRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshAccessToken provides a mock function with given fields: ctx, input
+func (_m *OAuthServiceMock) RefreshAccessToken(ctx context.Context, input models.RefreshTokenInput) (*models.TokenResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshAccessToken")
+	}
+
+	var r0 *models.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.RefreshTokenInput) (*models.TokenResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.RefreshTokenInput) *models.TokenResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.RefreshTokenInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OAuthServiceMock_RefreshAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshAccessToken'
+type OAuthServiceMock_RefreshAccessToken_Call struct {
+	*mock.Call
+}
+
+// RefreshAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.RefreshTokenInput
+func (_e *OAuthServiceMock_Expecter) RefreshAccessToken(ctx interface{}, input interface{}) *OAuthServiceMock_RefreshAccessToken_Call {
+	return &OAuthServiceMock_RefreshAccessToken_Call{Call: _e.mock.On("RefreshAccessToken", ctx, input)}
+}
+
+func (_c *OAuthServiceMock_RefreshAccessToken_Call) Run(run func(ctx context.Context, input models.RefreshTokenInput)) *OAuthServiceMock_RefreshAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.RefreshTokenInput))
+	})
+	return _c
+}
+
+func (_c *OAuthServiceMock_RefreshAccessToken_Call) Return(_a0 *models.TokenResponse, _a1 error) *OAuthServiceMock_RefreshAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OAuthServiceMock_RefreshAccessToken_Call) RunAndReturn(run func(context.Context, models.RefreshTokenInput) (*models.TokenResponse, error)) *OAuthServiceMock_RefreshAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOAuthServiceMock creates a new instance of OAuthServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOAuthServiceMock(t interface {
@@ -0,0 +1,205 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PushedAuthorizationRequestRepositoryMock is an autogenerated mock type for the PushedAuthorizationRequestRepository type
+type PushedAuthorizationRequestRepositoryMock struct {
+	mock.Mock
+}
+
+type PushedAuthorizationRequestRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PushedAuthorizationRequestRepositoryMock) EXPECT() *PushedAuthorizationRequestRepositoryMock_Expecter {
+	return &PushedAuthorizationRequestRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Consume provides a mock function with given fields: ctx, requestURIHash
+func (_m *PushedAuthorizationRequestRepositoryMock) Consume(ctx context.Context, requestURIHash string) (*entities.PushedAuthorizationRequest, error) {
+	ret := _m.Called(ctx, requestURIHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 *entities.PushedAuthorizationRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.PushedAuthorizationRequest, error)); ok {
+		return rf(ctx, requestURIHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.PushedAuthorizationRequest); ok {
+		r0 = rf(ctx, requestURIHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PushedAuthorizationRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestURIHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PushedAuthorizationRequestRepositoryMock_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type PushedAuthorizationRequestRepositoryMock_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestURIHash string
+func (_e *PushedAuthorizationRequestRepositoryMock_Expecter) Consume(ctx interface{}, requestURIHash interface{}) *PushedAuthorizationRequestRepositoryMock_Consume_Call {
+	return &PushedAuthorizationRequestRepositoryMock_Consume_Call{Call: _e.mock.On("Consume", ctx, requestURIHash)}
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Consume_Call) Run(run func(ctx context.Context, requestURIHash string)) *PushedAuthorizationRequestRepositoryMock_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Consume_Call) Return(_a0 *entities.PushedAuthorizationRequest, _a1 error) *PushedAuthorizationRequestRepositoryMock_Consume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Consume_Call) RunAndReturn(run func(context.Context, string) (*entities.PushedAuthorizationRequest, error)) *PushedAuthorizationRequestRepositoryMock_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, request
+func (_m *PushedAuthorizationRequestRepositoryMock) Create(ctx context.Context, request *entities.PushedAuthorizationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PushedAuthorizationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PushedAuthorizationRequestRepositoryMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PushedAuthorizationRequestRepositoryMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *entities.PushedAuthorizationRequest
+func (_e *PushedAuthorizationRequestRepositoryMock_Expecter) Create(ctx interface{}, request interface{}) *PushedAuthorizationRequestRepositoryMock_Create_Call {
+	return &PushedAuthorizationRequestRepositoryMock_Create_Call{Call: _e.mock.On("Create", ctx, request)}
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Create_Call) Run(run func(ctx context.Context, request *entities.PushedAuthorizationRequest)) *PushedAuthorizationRequestRepositoryMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PushedAuthorizationRequest))
+	})
+	return _c
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Create_Call) Return(_a0 error) *PushedAuthorizationRequestRepositoryMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Create_Call) RunAndReturn(run func(context.Context, *entities.PushedAuthorizationRequest) error) *PushedAuthorizationRequestRepositoryMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeem provides a mock function with given fields: ctx, requestURIHash, expiresAt
+func (_m *PushedAuthorizationRequestRepositoryMock) Redeem(ctx context.Context, requestURIHash string, expiresAt time.Time) (*entities.PushedAuthorizationRequest, error) {
+	ret := _m.Called(ctx, requestURIHash, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeem")
+	}
+
+	var r0 *entities.PushedAuthorizationRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (*entities.PushedAuthorizationRequest, error)); ok {
+		return rf(ctx, requestURIHash, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) *entities.PushedAuthorizationRequest); ok {
+		r0 = rf(ctx, requestURIHash, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PushedAuthorizationRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, requestURIHash, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PushedAuthorizationRequestRepositoryMock_Redeem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeem'
+type PushedAuthorizationRequestRepositoryMock_Redeem_Call struct {
+	*mock.Call
+}
+
+// Redeem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestURIHash string
+//   - expiresAt time.Time
+func (_e *PushedAuthorizationRequestRepositoryMock_Expecter) Redeem(ctx interface{}, requestURIHash interface{}, expiresAt interface{}) *PushedAuthorizationRequestRepositoryMock_Redeem_Call {
+	return &PushedAuthorizationRequestRepositoryMock_Redeem_Call{Call: _e.mock.On("Redeem", ctx, requestURIHash, expiresAt)}
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Redeem_Call) Run(run func(ctx context.Context, requestURIHash string, expiresAt time.Time)) *PushedAuthorizationRequestRepositoryMock_Redeem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Redeem_Call) Return(_a0 *entities.PushedAuthorizationRequest, _a1 error) *PushedAuthorizationRequestRepositoryMock_Redeem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PushedAuthorizationRequestRepositoryMock_Redeem_Call) RunAndReturn(run func(context.Context, string, time.Time) (*entities.PushedAuthorizationRequest, error)) *PushedAuthorizationRequestRepositoryMock_Redeem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPushedAuthorizationRequestRepositoryMock creates a new instance of PushedAuthorizationRequestRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPushedAuthorizationRequestRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PushedAuthorizationRequestRepositoryMock {
+	mock := &PushedAuthorizationRequestRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -128,6 +128,148 @@ func (_c *RefreshTokenRepositoryMock_FindByTokenHash_Call) RunAndReturn(run func
 	return _c
 }
 
+// Revoke provides a mock function with given fields: ctx, id
+func (_m *RefreshTokenRepositoryMock) Revoke(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepositoryMock_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type RefreshTokenRepositoryMock_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RefreshTokenRepositoryMock_Expecter) Revoke(ctx interface{}, id interface{}) *RefreshTokenRepositoryMock_Revoke_Call {
+	return &RefreshTokenRepositoryMock_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *RefreshTokenRepositoryMock_Revoke_Call) Run(run func(ctx context.Context, id string)) *RefreshTokenRepositoryMock_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepositoryMock_Revoke_Call) Return(_a0 error) *RefreshTokenRepositoryMock_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepositoryMock_Revoke_Call) RunAndReturn(run func(context.Context, string) error) *RefreshTokenRepositoryMock_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByUserAndClient provides a mock function with given fields: ctx, userID, clientID
+func (_m *RefreshTokenRepositoryMock) RevokeByUserAndClient(ctx context.Context, userID string, clientID string) error {
+	ret := _m.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByUserAndClient")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepositoryMock_RevokeByUserAndClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByUserAndClient'
+type RefreshTokenRepositoryMock_RevokeByUserAndClient_Call struct {
+	*mock.Call
+}
+
+// RevokeByUserAndClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - clientID string
+func (_e *RefreshTokenRepositoryMock_Expecter) RevokeByUserAndClient(ctx interface{}, userID interface{}, clientID interface{}) *RefreshTokenRepositoryMock_RevokeByUserAndClient_Call {
+	return &RefreshTokenRepositoryMock_RevokeByUserAndClient_Call{Call: _e.mock.On("RevokeByUserAndClient", ctx, userID, clientID)}
+}
+
+func (_c *RefreshTokenRepositoryMock_RevokeByUserAndClient_Call) Run(run func(ctx context.Context, userID string, clientID string)) *RefreshTokenRepositoryMock_RevokeByUserAndClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepositoryMock_RevokeByUserAndClient_Call) Return(_a0 error) *RefreshTokenRepositoryMock_RevokeByUserAndClient_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepositoryMock_RevokeByUserAndClient_Call) RunAndReturn(run func(context.Context, string, string) error) *RefreshTokenRepositoryMock_RevokeByUserAndClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function with given fields: ctx, familyID
+func (_m *RefreshTokenRepositoryMock) RevokeFamily(ctx context.Context, familyID string) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenRepositoryMock_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type RefreshTokenRepositoryMock_RevokeFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID string
+func (_e *RefreshTokenRepositoryMock_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *RefreshTokenRepositoryMock_RevokeFamily_Call {
+	return &RefreshTokenRepositoryMock_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
+}
+
+func (_c *RefreshTokenRepositoryMock_RevokeFamily_Call) Run(run func(ctx context.Context, familyID string)) *RefreshTokenRepositoryMock_RevokeFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRepositoryMock_RevokeFamily_Call) Return(_a0 error) *RefreshTokenRepositoryMock_RevokeFamily_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenRepositoryMock_RevokeFamily_Call) RunAndReturn(run func(context.Context, string) error) *RefreshTokenRepositoryMock_RevokeFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRefreshTokenRepositoryMock creates a new instance of RefreshTokenRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRefreshTokenRepositoryMock(t interface {
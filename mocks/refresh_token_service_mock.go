@@ -7,6 +7,8 @@ import (
 
 	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
 )
 
 // RefreshTokenServiceMock is an autogenerated mock type for the RefreshTokenService type
@@ -22,9 +24,9 @@ func (_m *RefreshTokenServiceMock) EXPECT() *RefreshTokenServiceMock_Expecter {
 	return &RefreshTokenServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// CreateRefreshToken provides a mock function with given fields: ctx, userID, clientID, scopes
-func (_m *RefreshTokenServiceMock) CreateRefreshToken(ctx context.Context, userID string, clientID string, scopes []string) (*entities.RefreshToken, error) {
-	ret := _m.Called(ctx, userID, clientID, scopes)
+// CreateRefreshToken provides a mock function with given fields: ctx, input
+func (_m *RefreshTokenServiceMock) CreateRefreshToken(ctx context.Context, input models.CreateRefreshTokenInput) (*entities.RefreshToken, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateRefreshToken")
@@ -32,19 +34,19 @@ func (_m *RefreshTokenServiceMock) CreateRefreshToken(ctx context.Context, userI
 
 	var r0 *entities.RefreshToken
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) (*entities.RefreshToken, error)); ok {
-		return rf(ctx, userID, clientID, scopes)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateRefreshTokenInput) (*entities.RefreshToken, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) *entities.RefreshToken); ok {
-		r0 = rf(ctx, userID, clientID, scopes)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateRefreshTokenInput) *entities.RefreshToken); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.RefreshToken)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
-		r1 = rf(ctx, userID, clientID, scopes)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateRefreshTokenInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,26 +61,238 @@ type RefreshTokenServiceMock_CreateRefreshToken_Call struct {
 
 // CreateRefreshToken is a helper method to define mock.On call
 //   - ctx context.Context
+//   - input models.CreateRefreshTokenInput
+func (_e *RefreshTokenServiceMock_Expecter) CreateRefreshToken(ctx interface{}, input interface{}) *RefreshTokenServiceMock_CreateRefreshToken_Call {
+	return &RefreshTokenServiceMock_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, input)}
+}
+
+func (_c *RefreshTokenServiceMock_CreateRefreshToken_Call) Run(run func(ctx context.Context, input models.CreateRefreshTokenInput)) *RefreshTokenServiceMock_CreateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateRefreshTokenInput))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_CreateRefreshToken_Call) Return(_a0 *entities.RefreshToken, _a1 error) *RefreshTokenServiceMock_CreateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_CreateRefreshToken_Call) RunAndReturn(run func(context.Context, models.CreateRefreshTokenInput) (*entities.RefreshToken, error)) *RefreshTokenServiceMock_CreateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByUserAndClient provides a mock function with given fields: ctx, userID, clientID
+func (_m *RefreshTokenServiceMock) RevokeByUserAndClient(ctx context.Context, userID string, clientID string) error {
+	ret := _m.Called(ctx, userID, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByUserAndClient")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenServiceMock_RevokeByUserAndClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByUserAndClient'
+type RefreshTokenServiceMock_RevokeByUserAndClient_Call struct {
+	*mock.Call
+}
+
+// RevokeByUserAndClient is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID string
 //   - clientID string
-//   - scopes []string
-func (_e *RefreshTokenServiceMock_Expecter) CreateRefreshToken(ctx interface{}, userID interface{}, clientID interface{}, scopes interface{}) *RefreshTokenServiceMock_CreateRefreshToken_Call {
-	return &RefreshTokenServiceMock_CreateRefreshToken_Call{Call: _e.mock.On("CreateRefreshToken", ctx, userID, clientID, scopes)}
+func (_e *RefreshTokenServiceMock_Expecter) RevokeByUserAndClient(ctx interface{}, userID interface{}, clientID interface{}) *RefreshTokenServiceMock_RevokeByUserAndClient_Call {
+	return &RefreshTokenServiceMock_RevokeByUserAndClient_Call{Call: _e.mock.On("RevokeByUserAndClient", ctx, userID, clientID)}
 }
 
-func (_c *RefreshTokenServiceMock_CreateRefreshToken_Call) Run(run func(ctx context.Context, userID string, clientID string, scopes []string)) *RefreshTokenServiceMock_CreateRefreshToken_Call {
+func (_c *RefreshTokenServiceMock_RevokeByUserAndClient_Call) Run(run func(ctx context.Context, userID string, clientID string)) *RefreshTokenServiceMock_RevokeByUserAndClient_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *RefreshTokenServiceMock_CreateRefreshToken_Call) Return(_a0 *entities.RefreshToken, _a1 error) *RefreshTokenServiceMock_CreateRefreshToken_Call {
+func (_c *RefreshTokenServiceMock_RevokeByUserAndClient_Call) Return(_a0 error) *RefreshTokenServiceMock_RevokeByUserAndClient_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_RevokeByUserAndClient_Call) RunAndReturn(run func(context.Context, string, string) error) *RefreshTokenServiceMock_RevokeByUserAndClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function with given fields: ctx, familyID
+func (_m *RefreshTokenServiceMock) RevokeFamily(ctx context.Context, familyID string) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshTokenServiceMock_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type RefreshTokenServiceMock_RevokeFamily_Call struct {
+	*mock.Call
+}
+
+// RevokeFamily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - familyID string
+func (_e *RefreshTokenServiceMock_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *RefreshTokenServiceMock_RevokeFamily_Call {
+	return &RefreshTokenServiceMock_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
+}
+
+func (_c *RefreshTokenServiceMock_RevokeFamily_Call) Run(run func(ctx context.Context, familyID string)) *RefreshTokenServiceMock_RevokeFamily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_RevokeFamily_Call) Return(_a0 error) *RefreshTokenServiceMock_RevokeFamily_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_RevokeFamily_Call) RunAndReturn(run func(context.Context, string) error) *RefreshTokenServiceMock_RevokeFamily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateRefreshToken provides a mock function with given fields: ctx, refreshToken
+func (_m *RefreshTokenServiceMock) RotateRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateRefreshToken")
+	}
+
+	var r0 *entities.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RefreshToken) (*entities.RefreshToken, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RefreshToken) *entities.RefreshToken); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.RefreshToken) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTokenServiceMock_RotateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateRefreshToken'
+type RefreshTokenServiceMock_RotateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RotateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken *entities.RefreshToken
+func (_e *RefreshTokenServiceMock_Expecter) RotateRefreshToken(ctx interface{}, refreshToken interface{}) *RefreshTokenServiceMock_RotateRefreshToken_Call {
+	return &RefreshTokenServiceMock_RotateRefreshToken_Call{Call: _e.mock.On("RotateRefreshToken", ctx, refreshToken)}
+}
+
+func (_c *RefreshTokenServiceMock_RotateRefreshToken_Call) Run(run func(ctx context.Context, refreshToken *entities.RefreshToken)) *RefreshTokenServiceMock_RotateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_RotateRefreshToken_Call) Return(_a0 *entities.RefreshToken, _a1 error) *RefreshTokenServiceMock_RotateRefreshToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_RotateRefreshToken_Call) RunAndReturn(run func(context.Context, *entities.RefreshToken) (*entities.RefreshToken, error)) *RefreshTokenServiceMock_RotateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateRefreshToken provides a mock function with given fields: ctx, token, clientID
+func (_m *RefreshTokenServiceMock) ValidateRefreshToken(ctx context.Context, token string, clientID string) (*entities.RefreshToken, error) {
+	ret := _m.Called(ctx, token, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateRefreshToken")
+	}
+
+	var r0 *entities.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.RefreshToken, error)); ok {
+		return rf(ctx, token, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.RefreshToken); ok {
+		r0 = rf(ctx, token, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTokenServiceMock_ValidateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateRefreshToken'
+type RefreshTokenServiceMock_ValidateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// ValidateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - clientID string
+func (_e *RefreshTokenServiceMock_Expecter) ValidateRefreshToken(ctx interface{}, token interface{}, clientID interface{}) *RefreshTokenServiceMock_ValidateRefreshToken_Call {
+	return &RefreshTokenServiceMock_ValidateRefreshToken_Call{Call: _e.mock.On("ValidateRefreshToken", ctx, token, clientID)}
+}
+
+func (_c *RefreshTokenServiceMock_ValidateRefreshToken_Call) Run(run func(ctx context.Context, token string, clientID string)) *RefreshTokenServiceMock_ValidateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RefreshTokenServiceMock_ValidateRefreshToken_Call) Return(_a0 *entities.RefreshToken, _a1 error) *RefreshTokenServiceMock_ValidateRefreshToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RefreshTokenServiceMock_CreateRefreshToken_Call) RunAndReturn(run func(context.Context, string, string, []string) (*entities.RefreshToken, error)) *RefreshTokenServiceMock_CreateRefreshToken_Call {
+func (_c *RefreshTokenServiceMock_ValidateRefreshToken_Call) RunAndReturn(run func(context.Context, string, string) (*entities.RefreshToken, error)) *RefreshTokenServiceMock_ValidateRefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
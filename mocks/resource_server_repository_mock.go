@@ -0,0 +1,143 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResourceServerRepositoryMock is an autogenerated mock type for the ResourceServerRepository type
+type ResourceServerRepositoryMock struct {
+	mock.Mock
+}
+
+type ResourceServerRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResourceServerRepositoryMock) EXPECT() *ResourceServerRepositoryMock_Expecter {
+	return &ResourceServerRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, resourceServer
+func (_m *ResourceServerRepositoryMock) Create(ctx context.Context, resourceServer *entities.ResourceServer) error {
+	ret := _m.Called(ctx, resourceServer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ResourceServer) error); ok {
+		r0 = rf(ctx, resourceServer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResourceServerRepositoryMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ResourceServerRepositoryMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceServer *entities.ResourceServer
+func (_e *ResourceServerRepositoryMock_Expecter) Create(ctx interface{}, resourceServer interface{}) *ResourceServerRepositoryMock_Create_Call {
+	return &ResourceServerRepositoryMock_Create_Call{Call: _e.mock.On("Create", ctx, resourceServer)}
+}
+
+func (_c *ResourceServerRepositoryMock_Create_Call) Run(run func(ctx context.Context, resourceServer *entities.ResourceServer)) *ResourceServerRepositoryMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ResourceServer))
+	})
+	return _c
+}
+
+func (_c *ResourceServerRepositoryMock_Create_Call) Return(_a0 error) *ResourceServerRepositoryMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResourceServerRepositoryMock_Create_Call) RunAndReturn(run func(context.Context, *entities.ResourceServer) error) *ResourceServerRepositoryMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIdentifier provides a mock function with given fields: ctx, identifier
+func (_m *ResourceServerRepositoryMock) GetByIdentifier(ctx context.Context, identifier string) (*entities.ResourceServer, error) {
+	ret := _m.Called(ctx, identifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdentifier")
+	}
+
+	var r0 *entities.ResourceServer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.ResourceServer, error)); ok {
+		return rf(ctx, identifier)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.ResourceServer); ok {
+		r0 = rf(ctx, identifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ResourceServer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, identifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceServerRepositoryMock_GetByIdentifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIdentifier'
+type ResourceServerRepositoryMock_GetByIdentifier_Call struct {
+	*mock.Call
+}
+
+// GetByIdentifier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identifier string
+func (_e *ResourceServerRepositoryMock_Expecter) GetByIdentifier(ctx interface{}, identifier interface{}) *ResourceServerRepositoryMock_GetByIdentifier_Call {
+	return &ResourceServerRepositoryMock_GetByIdentifier_Call{Call: _e.mock.On("GetByIdentifier", ctx, identifier)}
+}
+
+func (_c *ResourceServerRepositoryMock_GetByIdentifier_Call) Run(run func(ctx context.Context, identifier string)) *ResourceServerRepositoryMock_GetByIdentifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceServerRepositoryMock_GetByIdentifier_Call) Return(_a0 *entities.ResourceServer, _a1 error) *ResourceServerRepositoryMock_GetByIdentifier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceServerRepositoryMock_GetByIdentifier_Call) RunAndReturn(run func(context.Context, string) (*entities.ResourceServer, error)) *ResourceServerRepositoryMock_GetByIdentifier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResourceServerRepositoryMock creates a new instance of ResourceServerRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResourceServerRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResourceServerRepositoryMock {
+	mock := &ResourceServerRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+)
+
+// ResourceServerServiceMock is an autogenerated mock type for the ResourceServerService type
+type ResourceServerServiceMock struct {
+	mock.Mock
+}
+
+type ResourceServerServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResourceServerServiceMock) EXPECT() *ResourceServerServiceMock_Expecter {
+	return &ResourceServerServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateResourceServer provides a mock function with given fields: ctx, input
+func (_m *ResourceServerServiceMock) CreateResourceServer(ctx context.Context, input models.CreateResourceServerInput) (*models.ResourceServerResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateResourceServer")
+	}
+
+	var r0 *models.ResourceServerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateResourceServerInput) (*models.ResourceServerResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateResourceServerInput) *models.ResourceServerResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceServerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateResourceServerInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceServerServiceMock_CreateResourceServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceServer'
+type ResourceServerServiceMock_CreateResourceServer_Call struct {
+	*mock.Call
+}
+
+// CreateResourceServer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.CreateResourceServerInput
+func (_e *ResourceServerServiceMock_Expecter) CreateResourceServer(ctx interface{}, input interface{}) *ResourceServerServiceMock_CreateResourceServer_Call {
+	return &ResourceServerServiceMock_CreateResourceServer_Call{Call: _e.mock.On("CreateResourceServer", ctx, input)}
+}
+
+func (_c *ResourceServerServiceMock_CreateResourceServer_Call) Run(run func(ctx context.Context, input models.CreateResourceServerInput)) *ResourceServerServiceMock_CreateResourceServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateResourceServerInput))
+	})
+	return _c
+}
+
+func (_c *ResourceServerServiceMock_CreateResourceServer_Call) Return(_a0 *models.ResourceServerResponse, _a1 error) *ResourceServerServiceMock_CreateResourceServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceServerServiceMock_CreateResourceServer_Call) RunAndReturn(run func(context.Context, models.CreateResourceServerInput) (*models.ResourceServerResponse, error)) *ResourceServerServiceMock_CreateResourceServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourceServers provides a mock function with given fields: ctx, identifiers
+func (_m *ResourceServerServiceMock) GetResourceServers(ctx context.Context, identifiers []string) ([]*entities.ResourceServer, error) {
+	ret := _m.Called(ctx, identifiers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourceServers")
+	}
+
+	var r0 []*entities.ResourceServer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*entities.ResourceServer, error)); ok {
+		return rf(ctx, identifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*entities.ResourceServer); ok {
+		r0 = rf(ctx, identifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ResourceServer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, identifiers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceServerServiceMock_GetResourceServers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceServers'
+type ResourceServerServiceMock_GetResourceServers_Call struct {
+	*mock.Call
+}
+
+// GetResourceServers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identifiers []string
+func (_e *ResourceServerServiceMock_Expecter) GetResourceServers(ctx interface{}, identifiers interface{}) *ResourceServerServiceMock_GetResourceServers_Call {
+	return &ResourceServerServiceMock_GetResourceServers_Call{Call: _e.mock.On("GetResourceServers", ctx, identifiers)}
+}
+
+func (_c *ResourceServerServiceMock_GetResourceServers_Call) Run(run func(ctx context.Context, identifiers []string)) *ResourceServerServiceMock_GetResourceServers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ResourceServerServiceMock_GetResourceServers_Call) Return(_a0 []*entities.ResourceServer, _a1 error) *ResourceServerServiceMock_GetResourceServers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceServerServiceMock_GetResourceServers_Call) RunAndReturn(run func(context.Context, []string) ([]*entities.ResourceServer, error)) *ResourceServerServiceMock_GetResourceServers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResourceServerServiceMock creates a new instance of ResourceServerServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResourceServerServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResourceServerServiceMock {
+	mock := &ResourceServerServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,141 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RevokedTokenRepositoryMock is an autogenerated mock type for the RevokedTokenRepository type
+type RevokedTokenRepositoryMock struct {
+	mock.Mock
+}
+
+type RevokedTokenRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RevokedTokenRepositoryMock) EXPECT() *RevokedTokenRepositoryMock_Expecter {
+	return &RevokedTokenRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, revokedToken
+func (_m *RevokedTokenRepositoryMock) Create(ctx context.Context, revokedToken *entities.RevokedToken) error {
+	ret := _m.Called(ctx, revokedToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RevokedToken) error); ok {
+		r0 = rf(ctx, revokedToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokedTokenRepositoryMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type RevokedTokenRepositoryMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revokedToken *entities.RevokedToken
+func (_e *RevokedTokenRepositoryMock_Expecter) Create(ctx interface{}, revokedToken interface{}) *RevokedTokenRepositoryMock_Create_Call {
+	return &RevokedTokenRepositoryMock_Create_Call{Call: _e.mock.On("Create", ctx, revokedToken)}
+}
+
+func (_c *RevokedTokenRepositoryMock_Create_Call) Run(run func(ctx context.Context, revokedToken *entities.RevokedToken)) *RevokedTokenRepositoryMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RevokedToken))
+	})
+	return _c
+}
+
+func (_c *RevokedTokenRepositoryMock_Create_Call) Return(_a0 error) *RevokedTokenRepositoryMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RevokedTokenRepositoryMock_Create_Call) RunAndReturn(run func(context.Context, *entities.RevokedToken) error) *RevokedTokenRepositoryMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsByJTI provides a mock function with given fields: ctx, jti
+func (_m *RevokedTokenRepositoryMock) ExistsByJTI(ctx context.Context, jti string) (bool, error) {
+	ret := _m.Called(ctx, jti)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsByJTI")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, jti)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, jti)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, jti)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokedTokenRepositoryMock_ExistsByJTI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsByJTI'
+type RevokedTokenRepositoryMock_ExistsByJTI_Call struct {
+	*mock.Call
+}
+
+// ExistsByJTI is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+func (_e *RevokedTokenRepositoryMock_Expecter) ExistsByJTI(ctx interface{}, jti interface{}) *RevokedTokenRepositoryMock_ExistsByJTI_Call {
+	return &RevokedTokenRepositoryMock_ExistsByJTI_Call{Call: _e.mock.On("ExistsByJTI", ctx, jti)}
+}
+
+func (_c *RevokedTokenRepositoryMock_ExistsByJTI_Call) Run(run func(ctx context.Context, jti string)) *RevokedTokenRepositoryMock_ExistsByJTI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RevokedTokenRepositoryMock_ExistsByJTI_Call) Return(_a0 bool, _a1 error) *RevokedTokenRepositoryMock_ExistsByJTI_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RevokedTokenRepositoryMock_ExistsByJTI_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *RevokedTokenRepositoryMock_ExistsByJTI_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRevokedTokenRepositoryMock creates a new instance of RevokedTokenRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevokedTokenRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevokedTokenRepositoryMock {
+	mock := &RevokedTokenRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,358 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/aetheris-lab/aetheris-id/api/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SigningKeyRepositoryMock is an autogenerated mock type for the SigningKeyRepository type
+type SigningKeyRepositoryMock struct {
+	mock.Mock
+}
+
+type SigningKeyRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SigningKeyRepositoryMock) EXPECT() *SigningKeyRepositoryMock_Expecter {
+	return &SigningKeyRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Activate provides a mock function with given fields: ctx, kid
+func (_m *SigningKeyRepositoryMock) Activate(ctx context.Context, kid string) error {
+	ret := _m.Called(ctx, kid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Activate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, kid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SigningKeyRepositoryMock_Activate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Activate'
+type SigningKeyRepositoryMock_Activate_Call struct {
+	*mock.Call
+}
+
+// Activate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kid string
+func (_e *SigningKeyRepositoryMock_Expecter) Activate(ctx interface{}, kid interface{}) *SigningKeyRepositoryMock_Activate_Call {
+	return &SigningKeyRepositoryMock_Activate_Call{Call: _e.mock.On("Activate", ctx, kid)}
+}
+
+func (_c *SigningKeyRepositoryMock_Activate_Call) Run(run func(ctx context.Context, kid string)) *SigningKeyRepositoryMock_Activate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_Activate_Call) Return(_a0 error) *SigningKeyRepositoryMock_Activate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_Activate_Call) RunAndReturn(run func(context.Context, string) error) *SigningKeyRepositoryMock_Activate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, signingKey
+func (_m *SigningKeyRepositoryMock) Create(ctx context.Context, signingKey *entities.SigningKey) error {
+	ret := _m.Called(ctx, signingKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.SigningKey) error); ok {
+		r0 = rf(ctx, signingKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SigningKeyRepositoryMock_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SigningKeyRepositoryMock_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - signingKey *entities.SigningKey
+func (_e *SigningKeyRepositoryMock_Expecter) Create(ctx interface{}, signingKey interface{}) *SigningKeyRepositoryMock_Create_Call {
+	return &SigningKeyRepositoryMock_Create_Call{Call: _e.mock.On("Create", ctx, signingKey)}
+}
+
+func (_c *SigningKeyRepositoryMock_Create_Call) Run(run func(ctx context.Context, signingKey *entities.SigningKey)) *SigningKeyRepositoryMock_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.SigningKey))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_Create_Call) Return(_a0 error) *SigningKeyRepositoryMock_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_Create_Call) RunAndReturn(run func(context.Context, *entities.SigningKey) error) *SigningKeyRepositoryMock_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKID provides a mock function with given fields: ctx, kid
+func (_m *SigningKeyRepositoryMock) FindByKID(ctx context.Context, kid string) (*entities.SigningKey, error) {
+	ret := _m.Called(ctx, kid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKID")
+	}
+
+	var r0 *entities.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.SigningKey, error)); ok {
+		return rf(ctx, kid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.SigningKey); ok {
+		r0 = rf(ctx, kid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_FindByKID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKID'
+type SigningKeyRepositoryMock_FindByKID_Call struct {
+	*mock.Call
+}
+
+// FindByKID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kid string
+func (_e *SigningKeyRepositoryMock_Expecter) FindByKID(ctx interface{}, kid interface{}) *SigningKeyRepositoryMock_FindByKID_Call {
+	return &SigningKeyRepositoryMock_FindByKID_Call{Call: _e.mock.On("FindByKID", ctx, kid)}
+}
+
+func (_c *SigningKeyRepositoryMock_FindByKID_Call) Run(run func(ctx context.Context, kid string)) *SigningKeyRepositoryMock_FindByKID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindByKID_Call) Return(_a0 *entities.SigningKey, _a1 error) *SigningKeyRepositoryMock_FindByKID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindByKID_Call) RunAndReturn(run func(context.Context, string) (*entities.SigningKey, error)) *SigningKeyRepositoryMock_FindByKID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByStatus provides a mock function with given fields: ctx, algorithm, status
+func (_m *SigningKeyRepositoryMock) FindByStatus(ctx context.Context, algorithm string, status entities.SigningKeyStatus) (*entities.SigningKey, error) {
+	ret := _m.Called(ctx, algorithm, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByStatus")
+	}
+
+	var r0 *entities.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.SigningKeyStatus) (*entities.SigningKey, error)); ok {
+		return rf(ctx, algorithm, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entities.SigningKeyStatus) *entities.SigningKey); ok {
+		r0 = rf(ctx, algorithm, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entities.SigningKeyStatus) error); ok {
+		r1 = rf(ctx, algorithm, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_FindByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByStatus'
+type SigningKeyRepositoryMock_FindByStatus_Call struct {
+	*mock.Call
+}
+
+// FindByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - algorithm string
+//   - status entities.SigningKeyStatus
+func (_e *SigningKeyRepositoryMock_Expecter) FindByStatus(ctx interface{}, algorithm interface{}, status interface{}) *SigningKeyRepositoryMock_FindByStatus_Call {
+	return &SigningKeyRepositoryMock_FindByStatus_Call{Call: _e.mock.On("FindByStatus", ctx, algorithm, status)}
+}
+
+func (_c *SigningKeyRepositoryMock_FindByStatus_Call) Run(run func(ctx context.Context, algorithm string, status entities.SigningKeyStatus)) *SigningKeyRepositoryMock_FindByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entities.SigningKeyStatus))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindByStatus_Call) Return(_a0 *entities.SigningKey, _a1 error) *SigningKeyRepositoryMock_FindByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindByStatus_Call) RunAndReturn(run func(context.Context, string, entities.SigningKeyStatus) (*entities.SigningKey, error)) *SigningKeyRepositoryMock_FindByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindVerifiable provides a mock function with given fields: ctx
+func (_m *SigningKeyRepositoryMock) FindVerifiable(ctx context.Context) ([]*entities.SigningKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindVerifiable")
+	}
+
+	var r0 []*entities.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entities.SigningKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entities.SigningKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_FindVerifiable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindVerifiable'
+type SigningKeyRepositoryMock_FindVerifiable_Call struct {
+	*mock.Call
+}
+
+// FindVerifiable is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyRepositoryMock_Expecter) FindVerifiable(ctx interface{}) *SigningKeyRepositoryMock_FindVerifiable_Call {
+	return &SigningKeyRepositoryMock_FindVerifiable_Call{Call: _e.mock.On("FindVerifiable", ctx)}
+}
+
+func (_c *SigningKeyRepositoryMock_FindVerifiable_Call) Run(run func(ctx context.Context)) *SigningKeyRepositoryMock_FindVerifiable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindVerifiable_Call) Return(_a0 []*entities.SigningKey, _a1 error) *SigningKeyRepositoryMock_FindVerifiable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindVerifiable_Call) RunAndReturn(run func(context.Context) ([]*entities.SigningKey, error)) *SigningKeyRepositoryMock_FindVerifiable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retire provides a mock function with given fields: ctx, kid, verifyUntil
+func (_m *SigningKeyRepositoryMock) Retire(ctx context.Context, kid string, verifyUntil time.Time) error {
+	ret := _m.Called(ctx, kid, verifyUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retire")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, kid, verifyUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SigningKeyRepositoryMock_Retire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retire'
+type SigningKeyRepositoryMock_Retire_Call struct {
+	*mock.Call
+}
+
+// Retire is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kid string
+//   - verifyUntil time.Time
+func (_e *SigningKeyRepositoryMock_Expecter) Retire(ctx interface{}, kid interface{}, verifyUntil interface{}) *SigningKeyRepositoryMock_Retire_Call {
+	return &SigningKeyRepositoryMock_Retire_Call{Call: _e.mock.On("Retire", ctx, kid, verifyUntil)}
+}
+
+func (_c *SigningKeyRepositoryMock_Retire_Call) Run(run func(ctx context.Context, kid string, verifyUntil time.Time)) *SigningKeyRepositoryMock_Retire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_Retire_Call) Return(_a0 error) *SigningKeyRepositoryMock_Retire_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_Retire_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *SigningKeyRepositoryMock_Retire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSigningKeyRepositoryMock creates a new instance of SigningKeyRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSigningKeyRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SigningKeyRepositoryMock {
+	mock := &SigningKeyRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
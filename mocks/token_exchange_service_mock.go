@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TokenExchangeServiceMock is an autogenerated mock type for the TokenExchangeService type
+type TokenExchangeServiceMock struct {
+	mock.Mock
+}
+
+type TokenExchangeServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenExchangeServiceMock) EXPECT() *TokenExchangeServiceMock_Expecter {
+	return &TokenExchangeServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// Exchange provides a mock function with given fields: ctx, input
+func (_m *TokenExchangeServiceMock) Exchange(ctx context.Context, input models.TokenExchangeInput) (*models.TokenResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exchange")
+	}
+
+	var r0 *models.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TokenExchangeInput) (*models.TokenResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.TokenExchangeInput) *models.TokenResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.TokenExchangeInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenExchangeServiceMock_Exchange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exchange'
+type TokenExchangeServiceMock_Exchange_Call struct {
+	*mock.Call
+}
+
+// Exchange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.TokenExchangeInput
+func (_e *TokenExchangeServiceMock_Expecter) Exchange(ctx interface{}, input interface{}) *TokenExchangeServiceMock_Exchange_Call {
+	return &TokenExchangeServiceMock_Exchange_Call{Call: _e.mock.On("Exchange", ctx, input)}
+}
+
+func (_c *TokenExchangeServiceMock_Exchange_Call) Run(run func(ctx context.Context, input models.TokenExchangeInput)) *TokenExchangeServiceMock_Exchange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.TokenExchangeInput))
+	})
+	return _c
+}
+
+func (_c *TokenExchangeServiceMock_Exchange_Call) Return(_a0 *models.TokenResponse, _a1 error) *TokenExchangeServiceMock_Exchange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenExchangeServiceMock_Exchange_Call) RunAndReturn(run func(context.Context, models.TokenExchangeInput) (*models.TokenResponse, error)) *TokenExchangeServiceMock_Exchange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenExchangeServiceMock creates a new instance of TokenExchangeServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenExchangeServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenExchangeServiceMock {
+	mock := &TokenExchangeServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TokenIntrospectionServiceMock is an autogenerated mock type for the TokenIntrospectionService type
+type TokenIntrospectionServiceMock struct {
+	mock.Mock
+}
+
+type TokenIntrospectionServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenIntrospectionServiceMock) EXPECT() *TokenIntrospectionServiceMock_Expecter {
+	return &TokenIntrospectionServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// Introspect provides a mock function with given fields: ctx, input
+func (_m *TokenIntrospectionServiceMock) Introspect(ctx context.Context, input models.IntrospectTokenInput) (*models.IntrospectionResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Introspect")
+	}
+
+	var r0 *models.IntrospectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.IntrospectTokenInput) (*models.IntrospectionResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.IntrospectTokenInput) *models.IntrospectionResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.IntrospectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.IntrospectTokenInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenIntrospectionServiceMock_Introspect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Introspect'
+type TokenIntrospectionServiceMock_Introspect_Call struct {
+	*mock.Call
+}
+
+// Introspect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.IntrospectTokenInput
+func (_e *TokenIntrospectionServiceMock_Expecter) Introspect(ctx interface{}, input interface{}) *TokenIntrospectionServiceMock_Introspect_Call {
+	return &TokenIntrospectionServiceMock_Introspect_Call{Call: _e.mock.On("Introspect", ctx, input)}
+}
+
+func (_c *TokenIntrospectionServiceMock_Introspect_Call) Run(run func(ctx context.Context, input models.IntrospectTokenInput)) *TokenIntrospectionServiceMock_Introspect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.IntrospectTokenInput))
+	})
+	return _c
+}
+
+func (_c *TokenIntrospectionServiceMock_Introspect_Call) Return(_a0 *models.IntrospectionResponse, _a1 error) *TokenIntrospectionServiceMock_Introspect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenIntrospectionServiceMock_Introspect_Call) RunAndReturn(run func(context.Context, models.IntrospectTokenInput) (*models.IntrospectionResponse, error)) *TokenIntrospectionServiceMock_Introspect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenIntrospectionServiceMock creates a new instance of TokenIntrospectionServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenIntrospectionServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenIntrospectionServiceMock {
+	mock := &TokenIntrospectionServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TokenRevocationServiceMock is an autogenerated mock type for the TokenRevocationService type
+type TokenRevocationServiceMock struct {
+	mock.Mock
+}
+
+type TokenRevocationServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenRevocationServiceMock) EXPECT() *TokenRevocationServiceMock_Expecter {
+	return &TokenRevocationServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// IsAccessTokenRevoked provides a mock function with given fields: ctx, jti
+func (_m *TokenRevocationServiceMock) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	ret := _m.Called(ctx, jti)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAccessTokenRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, jti)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, jti)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, jti)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenRevocationServiceMock_IsAccessTokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAccessTokenRevoked'
+type TokenRevocationServiceMock_IsAccessTokenRevoked_Call struct {
+	*mock.Call
+}
+
+// IsAccessTokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+func (_e *TokenRevocationServiceMock_Expecter) IsAccessTokenRevoked(ctx interface{}, jti interface{}) *TokenRevocationServiceMock_IsAccessTokenRevoked_Call {
+	return &TokenRevocationServiceMock_IsAccessTokenRevoked_Call{Call: _e.mock.On("IsAccessTokenRevoked", ctx, jti)}
+}
+
+func (_c *TokenRevocationServiceMock_IsAccessTokenRevoked_Call) Run(run func(ctx context.Context, jti string)) *TokenRevocationServiceMock_IsAccessTokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TokenRevocationServiceMock_IsAccessTokenRevoked_Call) Return(_a0 bool, _a1 error) *TokenRevocationServiceMock_IsAccessTokenRevoked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenRevocationServiceMock_IsAccessTokenRevoked_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *TokenRevocationServiceMock_IsAccessTokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAccessToken provides a mock function with given fields: ctx, jti, expiresAt
+func (_m *TokenRevocationServiceMock) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	ret := _m.Called(ctx, jti, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccessToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, jti, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenRevocationServiceMock_RevokeAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccessToken'
+type TokenRevocationServiceMock_RevokeAccessToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - expiresAt time.Time
+func (_e *TokenRevocationServiceMock_Expecter) RevokeAccessToken(ctx interface{}, jti interface{}, expiresAt interface{}) *TokenRevocationServiceMock_RevokeAccessToken_Call {
+	return &TokenRevocationServiceMock_RevokeAccessToken_Call{Call: _e.mock.On("RevokeAccessToken", ctx, jti, expiresAt)}
+}
+
+func (_c *TokenRevocationServiceMock_RevokeAccessToken_Call) Run(run func(ctx context.Context, jti string, expiresAt time.Time)) *TokenRevocationServiceMock_RevokeAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TokenRevocationServiceMock_RevokeAccessToken_Call) Return(_a0 error) *TokenRevocationServiceMock_RevokeAccessToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenRevocationServiceMock_RevokeAccessToken_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *TokenRevocationServiceMock_RevokeAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: ctx, input
+func (_m *TokenRevocationServiceMock) RevokeToken(ctx context.Context, input models.RevokeTokenInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.RevokeTokenInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenRevocationServiceMock_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type TokenRevocationServiceMock_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.RevokeTokenInput
+func (_e *TokenRevocationServiceMock_Expecter) RevokeToken(ctx interface{}, input interface{}) *TokenRevocationServiceMock_RevokeToken_Call {
+	return &TokenRevocationServiceMock_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, input)}
+}
+
+func (_c *TokenRevocationServiceMock_RevokeToken_Call) Run(run func(ctx context.Context, input models.RevokeTokenInput)) *TokenRevocationServiceMock_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.RevokeTokenInput))
+	})
+	return _c
+}
+
+func (_c *TokenRevocationServiceMock_RevokeToken_Call) Return(_a0 error) *TokenRevocationServiceMock_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenRevocationServiceMock_RevokeToken_Call) RunAndReturn(run func(context.Context, models.RevokeTokenInput) error) *TokenRevocationServiceMock_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenRevocationServiceMock creates a new instance of TokenRevocationServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenRevocationServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenRevocationServiceMock {
+	mock := &TokenRevocationServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
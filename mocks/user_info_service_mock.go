@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	models "github.com/aetheris-lab/aetheris-id/api/internal/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserInfoServiceMock is an autogenerated mock type for the UserInfoService type
+type UserInfoServiceMock struct {
+	mock.Mock
+}
+
+type UserInfoServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserInfoServiceMock) EXPECT() *UserInfoServiceMock_Expecter {
+	return &UserInfoServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetUserInfo provides a mock function with given fields: ctx, input
+func (_m *UserInfoServiceMock) GetUserInfo(ctx context.Context, input models.UserInfoInput) (*models.UserInfoResponse, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserInfo")
+	}
+
+	var r0 *models.UserInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserInfoInput) (*models.UserInfoResponse, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserInfoInput) *models.UserInfoResponse); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.UserInfoInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserInfoServiceMock_GetUserInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserInfo'
+type UserInfoServiceMock_GetUserInfo_Call struct {
+	*mock.Call
+}
+
+// GetUserInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input models.UserInfoInput
+func (_e *UserInfoServiceMock_Expecter) GetUserInfo(ctx interface{}, input interface{}) *UserInfoServiceMock_GetUserInfo_Call {
+	return &UserInfoServiceMock_GetUserInfo_Call{Call: _e.mock.On("GetUserInfo", ctx, input)}
+}
+
+func (_c *UserInfoServiceMock_GetUserInfo_Call) Run(run func(ctx context.Context, input models.UserInfoInput)) *UserInfoServiceMock_GetUserInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.UserInfoInput))
+	})
+	return _c
+}
+
+func (_c *UserInfoServiceMock_GetUserInfo_Call) Return(_a0 *models.UserInfoResponse, _a1 error) *UserInfoServiceMock_GetUserInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserInfoServiceMock_GetUserInfo_Call) RunAndReturn(run func(context.Context, models.UserInfoInput) (*models.UserInfoResponse, error)) *UserInfoServiceMock_GetUserInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserInfoServiceMock creates a new instance of UserInfoServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserInfoServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserInfoServiceMock {
+	mock := &UserInfoServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,57 +2,56 @@ package ecdsa
 
 import (
 	"crypto/ecdsa"
-	"crypto/x509"
-	"encoding/pem"
 	"errors"
+	"fmt"
 
 	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
 )
 
 type EcdsaKeyPair interface {
-	ParseECDSAPrivateKey() (*ecdsa.PrivateKey, error)
-	ParseECDSAPublicKey() (*ecdsa.PublicKey, error)
+	PrivateKey() *ecdsa.PrivateKey
+	PublicKey() *ecdsa.PublicKey
 }
 
 type ecdsaKeyPair struct {
-	config *configs.Environment
+	privateKey *ecdsa.PrivateKey
+	publicKey  *ecdsa.PublicKey
 }
 
-func NewEcdsaKeyPair(config *configs.Environment) EcdsaKeyPair {
-	return &ecdsaKeyPair{
-		config: config,
-	}
-}
-
-func (e *ecdsaKeyPair) ParseECDSAPrivateKey() (*ecdsa.PrivateKey, error) {
-	block, _ := pem.Decode([]byte(e.config.Key.PrivateKey))
-	if block == nil || block.Type != "EC PRIVATE KEY" {
-		return nil, errors.New("failed to parse EC private key")
-	}
-
-	privateKey, err := x509.ParseECPrivateKey(block.Bytes)
+// NewEcdsaKeyPair parseia as chaves de configs.Key uma única vez, falhando na
+// inicialização se alguma estiver malformada.
+func NewEcdsaKeyPair(config *configs.Environment) (EcdsaKeyPair, error) {
+	privateKey, err := signer.ParsePrivateKeyPEM(config.Key.PrivateKey)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("parse private key: %w", err)
 	}
 
-	return privateKey, nil
-}
-
-func (e *ecdsaKeyPair) ParseECDSAPublicKey() (*ecdsa.PublicKey, error) {
-	block, _ := pem.Decode([]byte(e.config.Key.PublicKey))
-	if block == nil || block.Type != "PUBLIC KEY" {
-		return nil, errors.New("failed to parse EC public key")
+	ecdsaPrivateKey, ok := privateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("parse private key: key is not a valid ECDSA private key")
 	}
 
-	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	publicKey, err := signer.ParsePublicKeyPEM(config.Key.PublicKey)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("parse public key: %w", err)
 	}
 
-	ecdsaPubKey, ok := publicKey.(*ecdsa.PublicKey)
+	ecdsaPublicKey, ok := publicKey.(*ecdsa.PublicKey)
 	if !ok {
-		return nil, errors.New("key is not a valid ECDSA public key")
+		return nil, errors.New("parse public key: key is not a valid ECDSA public key")
 	}
 
-	return ecdsaPubKey, nil
+	return &ecdsaKeyPair{
+		privateKey: ecdsaPrivateKey,
+		publicKey:  ecdsaPublicKey,
+	}, nil
+}
+
+func (e *ecdsaKeyPair) PrivateKey() *ecdsa.PrivateKey {
+	return e.privateKey
+}
+
+func (e *ecdsaKeyPair) PublicKey() *ecdsa.PublicKey {
+	return e.publicKey
 }
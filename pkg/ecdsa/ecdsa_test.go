@@ -0,0 +1,57 @@
+package ecdsa
+
+import (
+	"testing"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/aetheris-lab/aetheris-id/api/pkg/signer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEcdsaKeyPair(t *testing.T) {
+	t.Run("should parse configured keys once", func(t *testing.T) {
+		// Arrange
+		privateKeyPEM, publicKeyPEM, err := signer.GenerateKeyPEM(signer.ES256)
+		require.NoError(t, err)
+		config := &configs.Environment{Key: configs.Key{PrivateKey: privateKeyPEM, PublicKey: publicKeyPEM}}
+
+		// Act
+		keyPair, err := NewEcdsaKeyPair(config)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotNil(t, keyPair.PrivateKey())
+		assert.True(t, keyPair.PublicKey().Equal(keyPair.PrivateKey().Public()))
+	})
+
+	t.Run("should fail fast when private key is malformed", func(t *testing.T) {
+		// Arrange
+		_, publicKeyPEM, err := signer.GenerateKeyPEM(signer.ES256)
+		require.NoError(t, err)
+		config := &configs.Environment{Key: configs.Key{PrivateKey: "invalid", PublicKey: publicKeyPEM}}
+
+		// Act
+		keyPair, err := NewEcdsaKeyPair(config)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, keyPair)
+		assert.Contains(t, err.Error(), "parse private key")
+	})
+
+	t.Run("should fail fast when configured key is not ECDSA", func(t *testing.T) {
+		// Arrange
+		privateKeyPEM, publicKeyPEM, err := signer.GenerateKeyPEM(signer.EdDSA)
+		require.NoError(t, err)
+		config := &configs.Environment{Key: configs.Key{PrivateKey: privateKeyPEM, PublicKey: publicKeyPEM}}
+
+		// Act
+		keyPair, err := NewEcdsaKeyPair(config)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, keyPair)
+		assert.Contains(t, err.Error(), "not a valid ECDSA private key")
+	})
+}
@@ -0,0 +1,91 @@
+package mtls
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+)
+
+// Thumbprint retorna o x5t#S256 do certificado: o SHA-256 do DER em base64url
+// (RFC 8705, seção 3.1).
+func Thumbprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ClientCAs guarda as autoridades que emitem os certificados dos clientes com
+// tls_client_auth. Sem TLS_CLIENT_CA_FILE nenhuma cadeia é aceita.
+type ClientCAs struct {
+	pool *x509.CertPool
+}
+
+func NewClientCAs(config *configs.Environment) (*ClientCAs, error) {
+	if config.TLS.ClientCAFile == "" {
+		return &ClientCAs{}, nil
+	}
+
+	data, err := os.ReadFile(config.TLS.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read client CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("read client CA file: no certificates found")
+	}
+
+	return &ClientCAs{pool: pool}, nil
+}
+
+// Verify confere a cadeia apresentada no handshake (folha primeiro) contra as CAs
+// configuradas, exigindo uso para autenticação de cliente.
+func (c *ClientCAs) Verify(chain []*x509.Certificate) error {
+	if c == nil || c.pool == nil {
+		return errors.New("no client CA configured")
+	}
+
+	if len(chain) == 0 {
+		return errors.New("no client certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         c.pool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	return err
+}
+
+// NewServerConfig monta o tls.Config do listener HTTPS. O certificado do cliente é
+// pedido mas não verificado no handshake, porque os certificados auto-assinados de
+// self_signed_tls_client_auth não têm cadeia; a verificação acontece na autenticação
+// do cliente, de acordo com o método registrado.
+func NewServerConfig(certFile, keyFile string, clientCAs *ClientCAs) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequestClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAs != nil {
+		tlsConfig.ClientCAs = clientCAs.pool
+	}
+
+	return tlsConfig, nil
+}
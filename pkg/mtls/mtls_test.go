@@ -0,0 +1,152 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aetheris-lab/aetheris-id/api/configs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCertificate emite um certificado para o nome informado; sem parent ele é auto-assinado.
+func newCertificate(t *testing.T, name string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name, Organization: []string{"Acme"}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
+
+func writePEM(t *testing.T, blockType string, der []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "file.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+
+	return path
+}
+
+func TestThumbprint(t *testing.T) {
+	t.Run("should return the base64url SHA-256 of the certificate DER", func(t *testing.T) {
+		// Arrange
+		cert, _ := newCertificate(t, "orders", false, nil, nil)
+		sum := sha256.Sum256(cert.Raw)
+
+		// Act
+		thumbprint := Thumbprint(cert)
+
+		// Assert
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:]), thumbprint)
+	})
+}
+
+func TestClientCAsVerify(t *testing.T) {
+	ca, caKey := newCertificate(t, "Acme CA", true, nil, nil)
+	config := &configs.Environment{TLS: configs.TLS{ClientCAFile: writePEM(t, "CERTIFICATE", ca.Raw)}}
+
+	clientCAs, err := NewClientCAs(config)
+	require.NoError(t, err)
+
+	t.Run("should accept a certificate issued by the configured CA", func(t *testing.T) {
+		// Arrange
+		cert, _ := newCertificate(t, "orders", false, ca, caKey)
+
+		// Act
+		err := clientCAs.Verify([]*x509.Certificate{cert})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a self-signed certificate", func(t *testing.T) {
+		// Arrange
+		cert, _ := newCertificate(t, "orders", false, nil, nil)
+
+		// Act
+		err := clientCAs.Verify([]*x509.Certificate{cert})
+
+		// Assert
+		require.Error(t, err)
+	})
+
+	t.Run("should reject any chain when no CA is configured", func(t *testing.T) {
+		// Arrange
+		cert, _ := newCertificate(t, "orders", false, ca, caKey)
+		emptyCAs, err := NewClientCAs(&configs.Environment{})
+		require.NoError(t, err)
+
+		// Act
+		err = emptyCAs.Verify([]*x509.Certificate{cert})
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no client CA configured")
+	})
+
+	t.Run("should fail when the CA file has no certificates", func(t *testing.T) {
+		// Arrange
+		config := &configs.Environment{TLS: configs.TLS{ClientCAFile: writePEM(t, "PRIVATE KEY", []byte("invalid"))}}
+
+		// Act
+		result, err := NewClientCAs(config)
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestNewServerConfig(t *testing.T) {
+	t.Run("should request client certificates without verifying them in the handshake", func(t *testing.T) {
+		// Arrange
+		cert, key := newCertificate(t, "localhost", false, nil, nil)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+
+		certFile := writePEM(t, "CERTIFICATE", cert.Raw)
+		keyFile := writePEM(t, "EC PRIVATE KEY", keyDER)
+
+		// Act
+		tlsConfig, err := NewServerConfig(certFile, keyFile, nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, tls.RequestClientCert, tlsConfig.ClientAuth)
+		assert.Len(t, tlsConfig.Certificates, 1)
+	})
+}
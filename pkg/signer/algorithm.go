@@ -0,0 +1,58 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"fmt"
+	"slices"
+)
+
+// Algoritmos JWS suportados (RFC 7518 e RFC 8037).
+const (
+	ES256 = "ES256"
+	RS256 = "RS256"
+	PS256 = "PS256"
+	EdDSA = "EdDSA"
+)
+
+// SupportedAlgorithms lista os algoritmos na ordem em que as chaves são criadas.
+var SupportedAlgorithms = []string{ES256, RS256, PS256, EdDSA}
+
+// minRSAKeyBits é o tamanho mínimo exigido pela RFC 7518 para RS256 e PS256.
+const minRSAKeyBits = 2048
+
+func IsSupportedAlgorithm(alg string) bool {
+	return slices.Contains(SupportedAlgorithms, alg)
+}
+
+// ValidateKey verifica se o tipo da chave pública é compatível com o algoritmo,
+// impedindo, por exemplo, que uma chave RSA seja carregada como ES256.
+func ValidateKey(alg string, publicKey crypto.PublicKey) error {
+	switch alg {
+	case ES256:
+		key, ok := publicKey.(*ecdsa.PublicKey)
+		if !ok || key.Curve != elliptic.P256() {
+			return fmt.Errorf("%s requires a P-256 key, got %T", alg, publicKey)
+		}
+	case RS256, PS256:
+		key, ok := publicKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%s requires an RSA key, got %T", alg, publicKey)
+		}
+
+		if key.N.BitLen() < minRSAKeyBits {
+			return fmt.Errorf("%s requires an RSA key of at least %d bits", alg, minRSAKeyBits)
+		}
+	case EdDSA:
+		if _, ok := publicKey.(ed25519.PublicKey); !ok {
+			return fmt.Errorf("%s requires an Ed25519 key, got %T", alg, publicKey)
+		}
+	default:
+		return fmt.Errorf("unsupported algorithm %s", alg)
+	}
+
+	return nil
+}
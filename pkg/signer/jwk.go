@@ -0,0 +1,182 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// JWK representa uma chave pública EC, RSA ou OKP no formato da RFC 7517.
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// NewJWK converte a chave pública em JWK, usando o thumbprint da RFC 7638 como kid.
+func NewJWK(key crypto.PublicKey, alg string) (JWK, error) {
+	jwk := JWK{
+		Use: "sig",
+		Alg: alg,
+	}
+
+	switch publicKey := key.(type) {
+	case *ecdsa.PublicKey:
+		ecdhKey, err := publicKey.ECDH()
+		if err != nil {
+			return JWK{}, fmt.Errorf("convert public key: %w", err)
+		}
+
+		// Formato não comprimido: 0x04 || X || Y
+		raw := ecdhKey.Bytes()
+		size := (len(raw) - 1) / 2
+
+		jwk.Kty = "EC"
+		jwk.Crv = publicKey.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(raw[1 : 1+size])
+		jwk.Y = base64.RawURLEncoding.EncodeToString(raw[1+size:])
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		// RFC 8037: chaves Ed25519 usam o tipo OKP.
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", key)
+	}
+
+	kid, err := Thumbprint(jwk)
+	if err != nil {
+		return JWK{}, err
+	}
+	jwk.Kid = kid
+
+	return jwk, nil
+}
+
+// PublicKey converte a JWK de volta na chave pública, validando que os pontos EC
+// pertencem à curva declarada.
+func (j JWK) PublicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "EC":
+		if j.Crv != elliptic.P256().Params().Name {
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+
+		x, err := decodeMember("x", j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeMember("y", j.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		// Formato não comprimido: 0x04 || X || Y, com coordenadas de 32 bytes.
+		if len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid P-256 coordinates length")
+		}
+		raw := append(append([]byte{0x04}, x...), y...)
+
+		ecdhKey, err := ecdh.P256().NewPublicKey(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid P-256 point: %w", err)
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(ecdhKey.Bytes()[1:33]),
+			Y:     new(big.Int).SetBytes(ecdhKey.Bytes()[33:]),
+		}, nil
+	case "RSA":
+		n, err := decodeMember("n", j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeMember("e", j.E)
+		if err != nil {
+			return nil, err
+		}
+
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > math.MaxInt32 || exponent.Int64() < 3 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+
+		x, err := decodeMember("x", j.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key length")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+}
+
+func decodeMember(name, value string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("missing jwk member %q", name)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("decode jwk member %q: %w", name, err)
+	}
+
+	return decoded, nil
+}
+
+// Thumbprint calcula o thumbprint SHA-256 da RFC 7638, que só considera os
+// membros obrigatórios de cada tipo de chave em ordem lexicográfica.
+func Thumbprint(jwk JWK) (string, error) {
+	var members map[string]string
+	switch jwk.Kty {
+	case "EC":
+		members = map[string]string{"crv": jwk.Crv, "kty": jwk.Kty, "x": jwk.X, "y": jwk.Y}
+	case "RSA":
+		members = map[string]string{"e": jwk.E, "kty": jwk.Kty, "n": jwk.N}
+	case "OKP":
+		members = map[string]string{"crv": jwk.Crv, "kty": jwk.Kty, "x": jwk.X}
+	default:
+		return "", fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+
+	// encoding/json serializa as chaves de um map em ordem lexicográfica.
+	encoded, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("marshal jwk members: %w", err)
+	}
+
+	hash := sha256.Sum256(encoded)
+	return base64.RawURLEncoding.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,149 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThumbprint(t *testing.T) {
+	t.Run("should ignore optional members", func(t *testing.T) {
+		// Arrange
+		jwk := JWK{
+			Kty: "EC",
+			Crv: "P-256",
+			X:   "f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU",
+			Y:   "x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0",
+			Kid: "ignored",
+			Use: "ignored",
+		}
+
+		// Act
+		first, err := Thumbprint(jwk)
+		require.NoError(t, err)
+		jwk.Kid, jwk.Use = "", ""
+		second, err := Thumbprint(jwk)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		assert.Len(t, first, 43)
+	})
+
+	t.Run("should match the RFC 7638 example", func(t *testing.T) {
+		// Arrange
+		jwk := JWK{
+			Kty: "RSA",
+			E:   "AQAB",
+			N: "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbI" +
+				"SD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		}
+
+		// Act
+		thumbprint, err := Thumbprint(jwk)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint)
+	})
+}
+
+func TestNewJWK(t *testing.T) {
+	t.Run("should encode coordinates with fixed length", func(t *testing.T) {
+		// Arrange
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		// Act
+		jwk, err := NewJWK(&privateKey.PublicKey, "ES256")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "EC", jwk.Kty)
+		assert.Equal(t, "P-256", jwk.Crv)
+		assert.Equal(t, "ES256", jwk.Alg)
+		assert.Len(t, jwk.X, 43)
+		assert.Len(t, jwk.Y, 43)
+		assert.NotEmpty(t, jwk.Kid)
+	})
+
+	t.Run("should encode RSA modulus and exponent", func(t *testing.T) {
+		// Arrange
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		// Act
+		jwk, err := NewJWK(&privateKey.PublicKey, RS256)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "RSA", jwk.Kty)
+		assert.Equal(t, "AQAB", jwk.E)
+		assert.Len(t, jwk.N, 342)
+		assert.Empty(t, jwk.Crv)
+	})
+
+	t.Run("should encode Ed25519 keys as OKP", func(t *testing.T) {
+		// Arrange
+		publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		// Act
+		jwk, err := NewJWK(publicKey, EdDSA)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "OKP", jwk.Kty)
+		assert.Equal(t, "Ed25519", jwk.Crv)
+		assert.Len(t, jwk.X, 43)
+		assert.Empty(t, jwk.Y)
+	})
+}
+
+func TestJWKPublicKey(t *testing.T) {
+	t.Run("should round trip EC, RSA and OKP keys", func(t *testing.T) {
+		// Arrange
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		edPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		for alg, publicKey := range map[string]any{ES256: &ecKey.PublicKey, RS256: &rsaKey.PublicKey, EdDSA: edPublicKey} {
+			jwk, err := NewJWK(publicKey, alg)
+			require.NoError(t, err)
+
+			// Act
+			parsed, err := jwk.PublicKey()
+
+			// Assert
+			require.NoError(t, err, alg)
+			assert.True(t, publicKey.(interface{ Equal(crypto.PublicKey) bool }).Equal(parsed), alg)
+		}
+	})
+
+	t.Run("should reject EC points outside the curve", func(t *testing.T) {
+		// Arrange
+		jwk := JWK{
+			Kty: "EC",
+			Crv: "P-256",
+			X:   "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+			Y:   "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE",
+		}
+
+		// Act
+		publicKey, err := jwk.PublicKey()
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, publicKey)
+	})
+}
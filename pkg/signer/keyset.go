@@ -0,0 +1,137 @@
+package signer
+
+import (
+	"crypto"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Key é uma chave já parseada, pronta para assinar e/ou verificar.
+type Key struct {
+	KID       string
+	Algorithm string
+	// Signer é nil para chaves que apenas verificam.
+	Signer    crypto.Signer
+	PublicKey crypto.PublicKey
+	// ExpiresAt zero indica que a chave verifica por tempo indeterminado.
+	ExpiresAt time.Time
+}
+
+func (k *Key) IsExpired() bool {
+	return !k.ExpiresAt.IsZero() && !k.ExpiresAt.After(time.Now())
+}
+
+// KeyMaterial descreve uma chave em PEM a ser carregada no KeySet.
+type KeyMaterial struct {
+	KID           string
+	Algorithm     string
+	PrivateKeyPEM string
+	PublicKeyPEM  string
+	Signing       bool
+	ExpiresAt     time.Time
+}
+
+type keySnapshot struct {
+	// signing guarda a chave de assinatura de cada algoritmo.
+	signing map[string]*Key
+	keys    map[string]*Key
+	ordered []*Key
+}
+
+// KeySet mantém as chaves parseadas em memória. Leituras não usam lock; Reload
+// parseia o novo conjunto por completo antes de trocá-lo atomicamente, de modo
+// que uma chave malformada nunca substitui um conjunto válido.
+type KeySet struct {
+	snapshot atomic.Pointer[keySnapshot]
+}
+
+func NewKeySet() *KeySet {
+	keySet := &KeySet{}
+	keySet.snapshot.Store(&keySnapshot{signing: map[string]*Key{}, keys: map[string]*Key{}})
+	return keySet
+}
+
+func (s *KeySet) Reload(materials []KeyMaterial) error {
+	snapshot := &keySnapshot{
+		signing: make(map[string]*Key, len(SupportedAlgorithms)),
+		keys:    make(map[string]*Key, len(materials)),
+		ordered: make([]*Key, 0, len(materials)),
+	}
+
+	for _, material := range materials {
+		key, err := parseKeyMaterial(material)
+		if err != nil {
+			return fmt.Errorf("parse key %s: %w", material.KID, err)
+		}
+
+		if _, ok := snapshot.signing[key.Algorithm]; material.Signing && !ok {
+			snapshot.signing[key.Algorithm] = key
+		}
+
+		snapshot.keys[key.KID] = key
+		snapshot.ordered = append(snapshot.ordered, key)
+	}
+
+	s.snapshot.Store(snapshot)
+	return nil
+}
+
+// SigningKey retorna a chave usada para assinar novos tokens com o algoritmo.
+func (s *KeySet) SigningKey(alg string) (*Key, bool) {
+	key, ok := s.snapshot.Load().signing[alg]
+	return key, ok
+}
+
+// VerificationKey retorna a chave identificada pelo kid, se ainda não expirou.
+func (s *KeySet) VerificationKey(kid string) (*Key, bool) {
+	key, ok := s.snapshot.Load().keys[kid]
+	if !ok || key.IsExpired() {
+		return nil, false
+	}
+
+	return key, true
+}
+
+// VerificationKeys retorna as chaves não expiradas na ordem em que foram carregadas.
+func (s *KeySet) VerificationKeys() []*Key {
+	ordered := s.snapshot.Load().ordered
+
+	keys := make([]*Key, 0, len(ordered))
+	for _, key := range ordered {
+		if !key.IsExpired() {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func parseKeyMaterial(material KeyMaterial) (*Key, error) {
+	publicKey, err := ParsePublicKeyPEM(material.PublicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateKey(material.Algorithm, publicKey); err != nil {
+		return nil, err
+	}
+
+	key := &Key{
+		KID:       material.KID,
+		Algorithm: material.Algorithm,
+		PublicKey: publicKey,
+		ExpiresAt: material.ExpiresAt,
+	}
+
+	if material.Signing {
+		privateKey, err := ParsePrivateKeyPEM(material.PrivateKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		key.Signer = privateKey
+	}
+
+	return key, nil
+}
@@ -0,0 +1,149 @@
+package signer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyMaterial(t testing.TB, kid string, signing bool) KeyMaterial {
+	t.Helper()
+
+	return newTestKeyMaterialWithAlgorithm(t, kid, ES256, signing)
+}
+
+func newTestKeyMaterialWithAlgorithm(t testing.TB, kid, alg string, signing bool) KeyMaterial {
+	t.Helper()
+
+	privateKeyPEM, publicKeyPEM, err := GenerateKeyPEM(alg)
+	require.NoError(t, err)
+
+	return KeyMaterial{
+		KID:           kid,
+		Algorithm:     alg,
+		PrivateKeyPEM: privateKeyPEM,
+		PublicKeyPEM:  publicKeyPEM,
+		Signing:       signing,
+	}
+}
+
+func TestKeySet_Reload(t *testing.T) {
+	t.Run("should use the first signing key and verify with every key", func(t *testing.T) {
+		// Arrange
+		keySet := NewKeySet()
+		materials := []KeyMaterial{
+			newTestKeyMaterial(t, "pending", false),
+			newTestKeyMaterial(t, "active", true),
+		}
+
+		// Act
+		err := keySet.Reload(materials)
+
+		// Assert
+		require.NoError(t, err)
+
+		signingKey, ok := keySet.SigningKey(ES256)
+		require.True(t, ok)
+		assert.Equal(t, "active", signingKey.KID)
+
+		pendingKey, ok := keySet.VerificationKey("pending")
+		require.True(t, ok)
+		assert.Nil(t, pendingKey.Signer)
+		assert.Len(t, keySet.VerificationKeys(), 2)
+	})
+
+	t.Run("should keep one signing key per algorithm", func(t *testing.T) {
+		// Arrange
+		keySet := NewKeySet()
+		materials := []KeyMaterial{
+			newTestKeyMaterialWithAlgorithm(t, "es256", ES256, true),
+			newTestKeyMaterialWithAlgorithm(t, "eddsa", EdDSA, true),
+		}
+
+		// Act
+		err := keySet.Reload(materials)
+
+		// Assert
+		require.NoError(t, err)
+
+		eddsaKey, ok := keySet.SigningKey(EdDSA)
+		require.True(t, ok)
+		assert.Equal(t, "eddsa", eddsaKey.KID)
+
+		_, ok = keySet.SigningKey(RS256)
+		assert.False(t, ok)
+	})
+
+	t.Run("should reject a key that does not match its algorithm", func(t *testing.T) {
+		// Arrange
+		keySet := NewKeySet()
+		material := newTestKeyMaterialWithAlgorithm(t, "eddsa", EdDSA, true)
+		material.Algorithm = ES256
+
+		// Act
+		err := keySet.Reload([]KeyMaterial{material})
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires a P-256 key")
+	})
+
+	t.Run("should not verify with expired keys", func(t *testing.T) {
+		// Arrange
+		keySet := NewKeySet()
+		material := newTestKeyMaterial(t, "retired", false)
+		material.ExpiresAt = time.Now().Add(-time.Minute)
+
+		// Act
+		err := keySet.Reload([]KeyMaterial{material})
+
+		// Assert
+		require.NoError(t, err)
+		_, ok := keySet.VerificationKey("retired")
+		assert.False(t, ok)
+		assert.Empty(t, keySet.VerificationKeys())
+	})
+
+	t.Run("should keep previous keys when a key is malformed", func(t *testing.T) {
+		// Arrange
+		keySet := NewKeySet()
+		require.NoError(t, keySet.Reload([]KeyMaterial{newTestKeyMaterial(t, "active", true)}))
+
+		malformed := newTestKeyMaterial(t, "malformed", true)
+		malformed.PrivateKeyPEM = "invalid"
+
+		// Act
+		err := keySet.Reload([]KeyMaterial{malformed})
+
+		// Assert
+		require.Error(t, err)
+		signingKey, ok := keySet.SigningKey(ES256)
+		require.True(t, ok)
+		assert.Equal(t, "active", signingKey.KID)
+	})
+}
+
+func BenchmarkParsePrivateKeyPEM(b *testing.B) {
+	material := newTestKeyMaterial(b, "kid", true)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ParsePrivateKeyPEM(material.PrivateKeyPEM); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkKeySet_SigningKey(b *testing.B) {
+	keySet := NewKeySet()
+	require.NoError(b, keySet.Reload([]KeyMaterial{newTestKeyMaterial(b, "kid", true)}))
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, ok := keySet.SigningKey(ES256); !ok {
+			b.Fatal("signing key not loaded")
+		}
+	}
+}
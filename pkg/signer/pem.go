@@ -0,0 +1,129 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ParsePrivateKeyPEM aceita chaves RSA, EC e Ed25519 em PKCS#8 ("PRIVATE KEY"),
+// PKCS#1 ("RSA PRIVATE KEY") ou SEC1 ("EC PRIVATE KEY").
+func ParsePrivateKeyPEM(privateKeyPEM string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errors.New("failed to decode private key pem")
+	}
+
+	var (
+		privateKey any
+		err        error
+	)
+
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported private key pem type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+
+	return signer, nil
+}
+
+// ParsePublicKeyPEM aceita chaves em PKIX ("PUBLIC KEY") ou PKCS#1 ("RSA PUBLIC KEY").
+func ParsePublicKeyPEM(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, errors.New("failed to decode public key pem")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		switch publicKey.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+			return publicKey, nil
+		default:
+			return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+		}
+	case "RSA PUBLIC KEY":
+		publicKey, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key pem type %q", block.Type)
+	}
+}
+
+// GenerateKeyPEM gera um novo par para o algoritmo. Chaves EC são codificadas em
+// SEC1, mantendo o formato das chaves já existentes; RSA e Ed25519 em PKCS#8.
+func GenerateKeyPEM(alg string) (privateKeyPEM string, publicKeyPEM string, err error) {
+	var (
+		privateKey crypto.Signer
+		privateDER []byte
+		blockType  string
+	)
+
+	switch alg {
+	case ES256:
+		ecKey, genErr := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if genErr != nil {
+			return "", "", fmt.Errorf("generate key: %w", genErr)
+		}
+
+		privateKey, blockType = ecKey, "EC PRIVATE KEY"
+		privateDER, err = x509.MarshalECPrivateKey(ecKey)
+	case RS256, PS256, EdDSA:
+		if alg == EdDSA {
+			_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+		} else {
+			privateKey, err = rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("generate key: %w", err)
+		}
+
+		blockType = "PRIVATE KEY"
+		privateDER, err = x509.MarshalPKCS8PrivateKey(privateKey)
+	default:
+		return "", "", fmt.Errorf("unsupported algorithm %s", alg)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("marshal private key: %w", err)
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return "", "", fmt.Errorf("marshal public key: %w", err)
+	}
+
+	privateKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: privateDER}))
+	publicKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+
+	return privateKeyPEM, publicKeyPEM, nil
+}
@@ -0,0 +1,97 @@
+package signer
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKeyPEM(t *testing.T) {
+	for _, alg := range SupportedAlgorithms {
+		t.Run("should generate a parseable key pair for "+alg, func(t *testing.T) {
+			// Act
+			privateKeyPEM, publicKeyPEM, err := GenerateKeyPEM(alg)
+			require.NoError(t, err)
+
+			privateKey, err := ParsePrivateKeyPEM(privateKeyPEM)
+			require.NoError(t, err)
+			publicKey, err := ParsePublicKeyPEM(publicKeyPEM)
+			require.NoError(t, err)
+
+			// Assert
+			assert.NoError(t, ValidateKey(alg, publicKey))
+			assert.NoError(t, ValidateKey(alg, privateKey.Public()))
+		})
+	}
+
+	t.Run("should return error for unsupported algorithm", func(t *testing.T) {
+		// Act
+		_, _, err := GenerateKeyPEM("HS256")
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported algorithm")
+	})
+}
+
+func TestParsePrivateKeyPEM(t *testing.T) {
+	t.Run("should parse PKCS#1 RSA keys", func(t *testing.T) {
+		// Arrange
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+		publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)})
+
+		// Act
+		privateKey, err := ParsePrivateKeyPEM(string(privateKeyPEM))
+		require.NoError(t, err)
+		publicKey, err := ParsePublicKeyPEM(string(publicKeyPEM))
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, rsaKey.Equal(privateKey))
+		assert.True(t, rsaKey.PublicKey.Equal(publicKey))
+	})
+
+	t.Run("should return error when pem is malformed", func(t *testing.T) {
+		// Act
+		privateKey, err := ParsePrivateKeyPEM("invalid")
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, privateKey)
+	})
+
+	t.Run("should return error when pem type is unsupported", func(t *testing.T) {
+		// Arrange
+		privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("data")})
+
+		// Act
+		privateKey, err := ParsePrivateKeyPEM(string(privateKeyPEM))
+
+		// Assert
+		require.Error(t, err)
+		assert.Nil(t, privateKey)
+		assert.Contains(t, err.Error(), "unsupported private key pem type")
+	})
+}
+
+func TestValidateKey(t *testing.T) {
+	t.Run("should reject RSA keys shorter than 2048 bits", func(t *testing.T) {
+		// Arrange
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+
+		// Act
+		err = ValidateKey(PS256, &rsaKey.PublicKey)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least 2048 bits")
+	})
+}
@@ -0,0 +1,54 @@
+package ttlcache
+
+import (
+	"sync"
+	"time"
+)
+
+// Set guarda chaves em memória até expirarem. As entradas vencidas são removidas
+// durante as inserções, sem goroutine de limpeza.
+type Set struct {
+	mu        sync.Mutex
+	entries   map[string]time.Time
+	nextSweep time.Time
+	now       func() time.Time
+}
+
+func NewSet() *Set {
+	return &Set{
+		entries: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// Add insere a chave por ttl e retorna false se ela já estava presente e válida.
+func (s *Set) Add(key string, ttl time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now, ttl)
+
+	if expiresAt, ok := s.entries[key]; ok && expiresAt.After(now) {
+		return false
+	}
+
+	s.entries[key] = now.Add(ttl)
+
+	return true
+}
+
+// sweep percorre o mapa no máximo uma vez a cada ttl.
+func (s *Set) sweep(now time.Time, ttl time.Duration) {
+	if now.Before(s.nextSweep) {
+		return
+	}
+
+	for key, expiresAt := range s.entries {
+		if !expiresAt.After(now) {
+			delete(s.entries, key)
+		}
+	}
+
+	s.nextSweep = now.Add(ttl)
+}
@@ -0,0 +1,55 @@
+package ttlcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet_Add(t *testing.T) {
+	t.Run("should reject a key that is still valid", func(t *testing.T) {
+		// Arrange
+		set := NewSet()
+		set.Add("jti", time.Minute)
+
+		// Act
+		added := set.Add("jti", time.Minute)
+
+		// Assert
+		assert.False(t, added)
+	})
+
+	t.Run("should accept a key again after it expires", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+		set := NewSet()
+		set.now = func() time.Time { return now }
+		set.Add("jti", time.Minute)
+
+		set.now = func() time.Time { return now.Add(2 * time.Minute) }
+
+		// Act
+		added := set.Add("jti", time.Minute)
+
+		// Assert
+		assert.True(t, added)
+	})
+
+	t.Run("should remove expired keys when sweeping", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+		set := NewSet()
+		set.now = func() time.Time { return now }
+		set.Add("old", time.Minute)
+
+		set.now = func() time.Time { return now.Add(2 * time.Minute) }
+
+		// Act
+		set.Add("new", time.Minute)
+
+		// Assert
+		assert.NotContains(t, set.entries, "old")
+		assert.Contains(t, set.entries, "new")
+	})
+}